@@ -0,0 +1,99 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	re "regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAiModelInvalidState tests that state must be on or off.
+func TestAccAiModelInvalidState(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_ai_model_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_ai_model" "%[3]s" {
+  organization_id    = "%[2]s"
+  name               = "%[3]s"
+  catalog_model_name = "nvidia/nv-embedqa-e5-v5"
+  state              = "paused"
+
+  cloud_config = {
+    provider = "aws"
+    region   = "us-east-1"
+    compute = {
+      cpu        = 4
+      gpu_memory = 24
+    }
+  }
+}
+`, globalProviderBlock, globalOrgId, resourceName),
+				ExpectError: re.MustCompile(`(?s)state.*value must be one of`),
+			},
+		},
+	})
+}
+
+// TestAccAiModelInvalidCompute tests that the GPU memory must be one of the supported sizes.
+func TestAccAiModelInvalidCompute(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_ai_model_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_ai_model" "%[3]s" {
+  organization_id    = "%[2]s"
+  name               = "%[3]s"
+  catalog_model_name = "nvidia/nv-embedqa-e5-v5"
+
+  cloud_config = {
+    provider = "aws"
+    region   = "us-east-1"
+    compute = {
+      cpu        = 4
+      gpu_memory = 16
+    }
+  }
+}
+`, globalProviderBlock, globalOrgId, resourceName),
+				ExpectError: re.MustCompile(`(?s)gpu_memory.*value must be one of`),
+			},
+		},
+	})
+}
+
+// TestAccAiModelAPIKeyInvalidExpiry tests that the expiry cannot exceed 365 days.
+func TestAccAiModelAPIKeyInvalidExpiry(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_ai_model_api_key_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_ai_model_api_key" "%[3]s" {
+  organization_id = "%[2]s"
+  name            = "%[3]s"
+  region          = "us-east-1"
+  expiry          = 400
+  allowed_cidrs   = ["0.0.0.0/0"]
+}
+`, globalProviderBlock, globalOrgId, resourceName),
+				ExpectError: re.MustCompile(`(?s)expiry.*must be between 1 and 365`),
+			},
+		},
+	})
+}
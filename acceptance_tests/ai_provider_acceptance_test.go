@@ -0,0 +1,58 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAiProviderMissingCredentials verifies that the AI provider resource requires the
+// credentials of the configured provider type at plan time, so a dummy ID is sufficient.
+func TestAccAiProviderMissingCredentials(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_ai_provider_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_ai_provider" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  name            = "%[2]s"
+  type            = "openAI"
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`api_key_wo is required when type is openAI`),
+			},
+		},
+	})
+}
+
+// TestAccAiProviderS3AttributesOnBedrock verifies that S3 location attributes are rejected
+// for providers that are not awsS3.
+func TestAccAiProviderS3AttributesOnBedrock(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_ai_provider_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_ai_provider" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  name            = "%[2]s"
+  type            = "awsBedrock"
+  bucket          = "documents"
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`bucket cannot be set when type is awsBedrock`),
+			},
+		},
+	})
+}
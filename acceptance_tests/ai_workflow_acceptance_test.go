@@ -0,0 +1,127 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	re "regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAiWorkflowInvalidExternalModel tests that the external model must be a supported embedding model.
+func TestAccAiWorkflowInvalidExternalModel(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_ai_workflow_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_ai_workflow" "%[5]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  cluster_id      = "%[4]s"
+  name            = "%[5]s"
+
+  target_couchbase_keyspace = {
+    bucket     = "docs"
+    scope      = "_default"
+    collection = "_default"
+  }
+
+  vectorization_config = {
+    embedding_model = {
+      external = {
+        model_name  = "text-embedding-4"
+        provider_id = "%[2]s"
+      }
+    }
+    embedding_field_mappings = {
+      text_vector = {
+        source_fields = ["body"]
+      }
+    }
+  }
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, globalClusterId, resourceName),
+				ExpectError: re.MustCompile(`(?s)model_name.*value must be one of`),
+			},
+		},
+	})
+}
+
+// TestAccAiWorkflowTwoEmbeddingModels tests that only one embedding model can be configured.
+func TestAccAiWorkflowTwoEmbeddingModels(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_ai_workflow_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_ai_workflow" "%[5]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  cluster_id      = "%[4]s"
+  name            = "%[5]s"
+
+  target_couchbase_keyspace = {
+    bucket     = "docs"
+    scope      = "_default"
+    collection = "_default"
+  }
+
+  vectorization_config = {
+    embedding_model = {
+      external = {
+        model_name  = "text-embedding-3-small"
+        provider_id = "%[2]s"
+      }
+      capella_hosted_model = {
+        id            = "%[2]s"
+        model_name    = "nvidia/nv-embedqa-e5-v5"
+        api_key_id    = "%[2]s"
+        api_key_token = "secret"
+      }
+    }
+    embedding_field_mappings = {
+      text_vector = {
+        source_fields = ["body"]
+      }
+    }
+  }
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, globalClusterId, resourceName),
+				ExpectError: re.MustCompile(`(?s)embedding_model`),
+			},
+		},
+	})
+}
+
+// TestAccAiWorkflowProcessedFilesInvalidFileStatus tests that processed files can only be filtered by success or failed.
+func TestAccAiWorkflowProcessedFilesInvalidFileStatus(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+data "couchbase-capella_ai_workflow_processed_files" "files" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  cluster_id      = "%[4]s"
+  workflow_id     = "%[2]s"
+  run_id          = "%[2]s"
+  file_status     = "skipped"
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, globalClusterId),
+				ExpectError: re.MustCompile(`(?s)file_status.*value must be one of`),
+			},
+		},
+	})
+}
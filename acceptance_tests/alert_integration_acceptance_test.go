@@ -0,0 +1,89 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	re "regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAlertIntegrationInvalidMethod tests that the webhook method must be POST or PUT.
+func TestAccAlertIntegrationInvalidMethod(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_alert_integration_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_alert_integration" "%[4]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  name            = "%[4]s"
+
+  config = {
+    webhook = {
+      url    = "https://example.com/alerts"
+      method = "GET"
+    }
+  }
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, resourceName),
+				ExpectError: re.MustCompile(`(?s)method.*value must be one of`),
+			},
+		},
+	})
+}
+
+// TestAccAlertIntegrationMissingUrl tests that the webhook url is required.
+func TestAccAlertIntegrationMissingUrl(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_alert_integration_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_alert_integration" "%[4]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  name            = "%[4]s"
+  test_on_create  = true
+
+  config = {
+    webhook = {
+      method = "POST"
+    }
+  }
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, resourceName),
+				ExpectError: re.MustCompile(`(?s)Incorrect attribute value type.*attribute "url".*is required`),
+			},
+		},
+	})
+}
+
+// TestAccDatasourceAlertIntegrationsInvalidProjectId tests that project_id must be a UUID.
+func TestAccDatasourceAlertIntegrationsInvalidProjectId(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+data "couchbase-capella_alert_integrations" "list" {
+  organization_id = "%[2]s"
+  project_id      = "not-a-uuid"
+}
+`, globalProviderBlock, globalOrgId),
+				ExpectError: re.MustCompile(`(?s)project_id.*must be a valid UUID`),
+			},
+		},
+	})
+}
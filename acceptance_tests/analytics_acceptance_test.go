@@ -0,0 +1,116 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	re "regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAnalyticsClusterInvalidState tests that state only accepts "on" or "off".
+func TestAccAnalyticsClusterInvalidState(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_analytics_cluster_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalyticsClusterInvalidStateConfig(resourceName),
+				ExpectError: re.MustCompile(`(?s)state.*value must be one of`),
+			},
+		},
+	})
+}
+
+// TestAccAnalyticsAllowListInvalidClusterId tests that analytics_cluster_id must be a UUID.
+func TestAccAnalyticsAllowListInvalidClusterId(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_analytics_allowlist_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_analytics_allowlist" "%[4]s" {
+  organization_id      = "%[2]s"
+  project_id           = "%[3]s"
+  analytics_cluster_id = "not-a-uuid"
+  cidr                 = "10.0.0.0/16"
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, resourceName),
+				ExpectError: re.MustCompile(`(?s)analytics_cluster_id.*must be a valid UUID`),
+			},
+		},
+	})
+}
+
+// TestAccAnalyticsOnOffScheduleMissingDay tests that a schedule with fewer than seven days
+// is rejected before any request is sent.
+func TestAccAnalyticsOnOffScheduleMissingDay(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_analytics_onoff_schedule_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalyticsOnOffScheduleMissingDayConfig(resourceName),
+				ExpectError: re.MustCompile(`(?s)requires exactly 7 days`),
+			},
+		},
+	})
+}
+
+func testAccAnalyticsClusterInvalidStateConfig(resourceName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_analytics_cluster" "%[4]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  name            = "%[4]s"
+  cloud_provider  = "aws"
+  region          = "us-east-1"
+  nodes           = 1
+  state           = "paused"
+
+  compute = {
+    cpu = 4
+    ram = 32
+  }
+
+  availability = {
+    type = "single"
+  }
+
+  support = {
+    plan     = "developer pro"
+    timezone = "PT"
+  }
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, resourceName)
+}
+
+func testAccAnalyticsOnOffScheduleMissingDayConfig(resourceName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_analytics_onoff_schedule" "%[4]s" {
+  organization_id      = "%[2]s"
+  project_id           = "%[3]s"
+  analytics_cluster_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  timezone             = "US/Pacific"
+
+  days = [
+    { day = "monday", state = "on" },
+    { day = "tuesday", state = "on" },
+    { day = "wednesday", state = "on" },
+    { day = "thursday", state = "on" },
+    { day = "friday", state = "on" },
+    { day = "saturday", state = "off" },
+  ]
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, resourceName)
+}
@@ -0,0 +1,85 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	re "regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAnalyticsBackupInvalidClusterId tests that analytics_cluster_id must be a UUID.
+func TestAccAnalyticsBackupInvalidClusterId(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_analytics_backup_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_analytics_backup" "%[4]s" {
+  organization_id      = "%[2]s"
+  project_id           = "%[3]s"
+  analytics_cluster_id = "not-a-uuid"
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, resourceName),
+				ExpectError: re.MustCompile(`(?s)analytics_cluster_id.*must be a valid UUID`),
+			},
+		},
+	})
+}
+
+// TestAccAnalyticsBackupScheduleInvalidStartTime tests that start_time must be an RFC3339 timestamp.
+func TestAccAnalyticsBackupScheduleInvalidStartTime(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_analytics_backup_schedule_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_analytics_backup_schedule" "%[4]s" {
+  organization_id      = "%[2]s"
+  project_id           = "%[3]s"
+  analytics_cluster_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  interval             = 6
+  retention            = 168
+  start_time           = "tomorrow"
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, resourceName),
+				ExpectError: re.MustCompile(`(?s)Invalid RFC3339 String Value`),
+			},
+		},
+	})
+}
+
+// TestAccDatasourceAnalyticsRestoresInvalidFilter tests that a filter must set both name and values.
+func TestAccDatasourceAnalyticsRestoresInvalidFilter(t *testing.T) {
+	dsName := randomStringWithPrefix("tf_acc_analytics_restores_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+data "couchbase-capella_analytics_restores" "%[4]s" {
+  organization_id      = "%[2]s"
+  project_id           = "%[3]s"
+  analytics_cluster_id = "ffffffff-aaaa-1414-eeee-000000000000"
+
+  filter {
+    name = "status"
+  }
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, dsName),
+				ExpectError: re.MustCompile(`Both 'name' and 'values' in filter block must be configured`),
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAnalyticsPrivateEndpointServiceCreateDisabled verifies that the analytics private endpoint
+// service cannot be created with enabled set to false. This is caught at plan time, so a dummy ID is sufficient.
+func TestAccAnalyticsPrivateEndpointServiceCreateDisabled(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_analytics_pe_service_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_analytics_private_endpoint_service" "%[2]s" {
+  organization_id      = "00000000-0000-0000-0000-000000000000"
+  project_id           = "00000000-0000-0000-0000-000000000000"
+  analytics_cluster_id = "00000000-0000-0000-0000-000000000000"
+  enabled              = false
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`Cannot set enabled to false when first enabling private endpoint service`),
+			},
+		},
+	})
+}
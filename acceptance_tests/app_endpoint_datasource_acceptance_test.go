@@ -122,6 +122,34 @@ func TestAccAppEndpointsDataSourceFiltered(t *testing.T) {
 	})
 }
 
+// TestAccAppEndpointCollectionsDataSource verifies the
+// couchbase-capella_app_endpoint_collections data source lists the collections
+// synced by the common pre-created endpoint.
+func TestAccAppEndpointCollectionsDataSource(t *testing.T) {
+	ensureAppEndpointTestEnvironment(t)
+
+	dataSourceName := randomStringWithPrefix("tf_acc_ds_app_endpoint_collections_")
+	dataSourceReference := "data.couchbase-capella_app_endpoint_collections." + dataSourceName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppEndpointCollectionsDataSourceConfig(dataSourceName, appEndpointCommonEndpointName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceReference, "organization_id", globalOrgId),
+					resource.TestCheckResourceAttr(dataSourceReference, "project_id", globalProjectId),
+					resource.TestCheckResourceAttr(dataSourceReference, "cluster_id", appEndpointClusterId),
+					resource.TestCheckResourceAttr(dataSourceReference, "app_service_id", appEndpointAppServiceId),
+					resource.TestCheckResourceAttr(dataSourceReference, "app_endpoint_name", appEndpointCommonEndpointName),
+					resource.TestCheckResourceAttrSet(dataSourceReference, "data.0.scope"),
+					resource.TestCheckResourceAttrSet(dataSourceReference, "data.0.name"),
+				),
+			},
+		},
+	})
+}
+
 // ─────────────────────────────────────────────────────────────────────────────
 // Config helpers
 // ─────────────────────────────────────────────────────────────────────────────
@@ -215,3 +243,25 @@ data "couchbase-capella_app_endpoints" "%[2]s" {
 		endpointName,
 	)
 }
+
+func testAccAppEndpointCollectionsDataSourceConfig(dataSourceName, endpointName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "couchbase-capella_app_endpoint_collections" "%[2]s" {
+  organization_id   = "%[3]s"
+  project_id        = "%[4]s"
+  cluster_id        = "%[5]s"
+  app_service_id    = "%[6]s"
+  app_endpoint_name = "%[7]s"
+}
+`,
+		globalProviderBlock,
+		dataSourceName,
+		globalOrgId,
+		globalProjectId,
+		appEndpointClusterId,
+		appEndpointAppServiceId,
+		endpointName,
+	)
+}
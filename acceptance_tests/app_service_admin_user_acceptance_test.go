@@ -0,0 +1,63 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	re "regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAppServiceAdminUserEndpointsWithAccessAll tests that endpoints cannot be listed when the user can access all of them.
+func TestAccAppServiceAdminUserEndpointsWithAccessAll(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_admin_user_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_app_service_admin_user" "%[6]s" {
+  organization_id      = "%[2]s"
+  project_id           = "%[3]s"
+  cluster_id           = "%[4]s"
+  app_service_id       = "%[5]s"
+  name                 = "%[6]s"
+  password             = "Secret-Password-123"
+  access_all_endpoints = true
+  endpoints            = ["endpoint"]
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, globalClusterId, globalAppServiceId, resourceName),
+				ExpectError: re.MustCompile(`endpoints cannot be configured when access_all_endpoints is true`),
+			},
+		},
+	})
+}
+
+// TestAccAppServiceAdminUserMissingEndpoints tests that endpoints are required unless the user can access all of them.
+func TestAccAppServiceAdminUserMissingEndpoints(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_admin_user_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_app_service_admin_user" "%[6]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  cluster_id      = "%[4]s"
+  app_service_id  = "%[5]s"
+  name            = "%[6]s"
+  password        = "Secret-Password-123"
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, globalClusterId, globalAppServiceId, resourceName),
+				ExpectError: re.MustCompile(`endpoints must be configured unless access_all_endpoints is true`),
+			},
+		},
+	})
+}
@@ -0,0 +1,69 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAppServiceAuditLogStreamingCredentialsMismatch verifies that the App Service audit log
+// streaming resource rejects credentials which do not match the output_type. ValidateConfig
+// fires before any API call, so dummy IDs are sufficient.
+func TestAccAppServiceAuditLogStreamingCredentialsMismatch(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_app_service_audit_streaming_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_app_service_audit_log_streaming" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  project_id      = "11111111-1111-1111-1111-111111111111"
+  cluster_id      = "22222222-2222-2222-2222-222222222222"
+  app_service_id  = "33333333-3333-3333-3333-333333333333"
+  output_type     = "datadog"
+  credentials = {
+    splunk = {
+      url          = "https://splunk.example.com"
+      splunk_token = "token"
+    }
+  }
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`credentials.datadog must be configured when output_type is "datadog"`),
+			},
+		},
+	})
+}
+
+// TestAccAppServiceAuditLogExportEndBeforeStart verifies that the App Service audit log export
+// resource rejects an export window which ends before it starts.
+func TestAccAppServiceAuditLogExportEndBeforeStart(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_app_service_audit_export_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_app_service_audit_log_export" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  project_id      = "11111111-1111-1111-1111-111111111111"
+  cluster_id      = "22222222-2222-2222-2222-222222222222"
+  app_service_id  = "33333333-3333-3333-3333-333333333333"
+  start           = "2026-10-02T00:00:00Z"
+  end             = "2026-10-01T00:00:00Z"
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`end must not be earlier than start`),
+			},
+		},
+	})
+}
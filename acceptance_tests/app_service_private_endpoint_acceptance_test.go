@@ -0,0 +1,89 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAppServicePrivateEndpointServiceCreateDisabled verifies that the App Service private
+// endpoint service cannot be created with enabled set to false. The plan modifier fires before
+// any API call, so dummy IDs are sufficient.
+func TestAccAppServicePrivateEndpointServiceCreateDisabled(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_app_service_pe_service_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_app_service_private_endpoint_service" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  project_id      = "11111111-1111-1111-1111-111111111111"
+  cluster_id      = "22222222-2222-2222-2222-222222222222"
+  app_service_id  = "33333333-3333-3333-3333-333333333333"
+  enabled         = false
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`Cannot set enabled to false when first enabling private endpoint service`),
+			},
+		},
+	})
+}
+
+// TestAccAppServicePrivateEndpointsInvalidEndpointID verifies that the App Service private
+// endpoints resource rejects an empty endpoint_id at plan time.
+func TestAccAppServicePrivateEndpointsInvalidEndpointID(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_app_service_pe_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_app_service_private_endpoints" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  project_id      = "11111111-1111-1111-1111-111111111111"
+  cluster_id      = "22222222-2222-2222-2222-222222222222"
+  app_service_id  = "33333333-3333-3333-3333-333333333333"
+  endpoint_id     = ""
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`(?s)endpoint_id.*string length must be at least 1`),
+			},
+		},
+	})
+}
+
+// TestAccAppServiceAWSPrivateEndpointCommandInvalidVPCID verifies that the App Service AWS
+// private endpoint command data source rejects a vpc_id shorter than the OpenAPI minimum length.
+func TestAccAppServiceAWSPrivateEndpointCommandInvalidVPCID(t *testing.T) {
+	dataSourceName := randomStringWithPrefix("tf_acc_app_service_aws_pe_command_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+data "couchbase-capella_app_service_aws_private_endpoint_command" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  project_id      = "11111111-1111-1111-1111-111111111111"
+  cluster_id      = "22222222-2222-2222-2222-222222222222"
+  app_service_id  = "33333333-3333-3333-3333-333333333333"
+  vpc_id          = "vpc-short"
+  subnet_ids      = ["subnet-1234567890abcdef0"]
+}
+`, globalProviderBlock, dataSourceName),
+				ExpectError: regexp.MustCompile(`(?s)vpc_id.*string length must be between 12 and 21`),
+			},
+		},
+	})
+}
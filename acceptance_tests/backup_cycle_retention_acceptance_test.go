@@ -0,0 +1,35 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccBackupCycleRetentionInvalidMaxAge verifies that the backup cycle retention resource
+// rejects a max_age_days below one day at plan time, so dummy IDs are sufficient.
+func TestAccBackupCycleRetentionInvalidMaxAge(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_backup_cycle_retention_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_backup_cycle_retention" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  project_id      = "11111111-1111-1111-1111-111111111111"
+  cluster_id      = "22222222-2222-2222-2222-222222222222"
+  bucket_id       = "YnVja2V0"
+  max_age_days    = 0
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`(?s)max_age_days.*value must be at least 1`),
+			},
+		},
+	})
+}
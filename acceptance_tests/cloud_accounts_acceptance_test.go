@@ -0,0 +1,55 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccCloudAccountsInvalidOrganizationID verifies that the cloud accounts data source
+// rejects an organization_id that is not a UUID before making any request.
+func TestAccCloudAccountsInvalidOrganizationID(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_cloud_accounts_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+data "couchbase-capella_cloud_accounts" "%[2]s" {
+  organization_id = "not-a-uuid"
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`(?s)organization_id.*must be a valid UUID`),
+			},
+		},
+	})
+}
+
+// TestAccSnapshotBackupRegionsInvalidClusterID verifies that the snapshot backup regions
+// data source rejects a cluster_id that is not a UUID before making any request.
+func TestAccSnapshotBackupRegionsInvalidClusterID(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_snapshot_backup_regions_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+data "couchbase-capella_snapshot_backup_regions" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  project_id      = "11111111-1111-1111-1111-111111111111"
+  cluster_id      = "not-a-uuid"
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`(?s)cluster_id.*must be a valid UUID`),
+			},
+		},
+	})
+}
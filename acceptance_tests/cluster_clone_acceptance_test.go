@@ -0,0 +1,44 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccClusterCloneInvalidSourceSnapshotID verifies that the cluster clone resource rejects
+// a source_snapshot_id which is not a UUID at plan time, so dummy IDs are sufficient.
+func TestAccClusterCloneInvalidSourceSnapshotID(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_cluster_clone_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_cluster_clone" "%[2]s" {
+  organization_id    = "00000000-0000-0000-0000-000000000000"
+  project_id         = "11111111-1111-1111-1111-111111111111"
+  source_snapshot_id = "not-a-snapshot"
+  name               = "%[2]s"
+  cloud_provider = {
+    type   = "aws"
+    region = "us-east-1"
+  }
+  availability = {
+    type = "single"
+  }
+  support = {
+    plan = "developer pro"
+  }
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`(?s)source_snapshot_id.*must be a valid UUID`),
+			},
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	re "regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccCmekMultipleConfigs tests that a validation error is returned when more than one
+// cloud provider key config is set.
+func TestAccCmekMultipleConfigs(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_cmek_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCmekMultipleConfigsConfig(resourceName),
+				ExpectError: re.MustCompile(`(?s)Exactly one of.*aws_config.*gcp_config`),
+			},
+		},
+	})
+}
+
+// TestAccCmekMissingConfig tests that a validation error is returned when no cloud provider
+// key config is set.
+func TestAccCmekMissingConfig(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_cmek_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCmekMissingConfigConfig(resourceName),
+				ExpectError: re.MustCompile(`(?s)Exactly one of.*but none were provided`),
+			},
+		},
+	})
+}
+
+// TestAccDatasourceCmekHistoryInvalidId tests that cmek_id must be a UUID.
+func TestAccDatasourceCmekHistoryInvalidId(t *testing.T) {
+	dsName := randomStringWithPrefix("tf_acc_cmek_history_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+data "couchbase-capella_cmek_history" "%[3]s" {
+  organization_id = "%[2]s"
+  cmek_id         = "not-a-uuid"
+}
+`, globalProviderBlock, globalOrgId, dsName),
+				ExpectError: re.MustCompile(`(?s)cmek_id.*must be a valid UUID`),
+			},
+		},
+	})
+}
+
+func testAccCmekMultipleConfigsConfig(resourceName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_cmek" "%[3]s" {
+  organization_id = "%[2]s"
+  name            = "%[3]s"
+
+  config = {
+    aws_config = {
+      arn = "arn:aws:kms:us-east-1:123456789012:key/ffffffff-aaaa-1414-eeee-000000000000"
+    }
+    gcp_config = {
+      resource_name = "projects/p/locations/global/keyRings/r/cryptoKeys/k"
+    }
+  }
+}
+`, globalProviderBlock, globalOrgId, resourceName)
+}
+
+func testAccCmekMissingConfigConfig(resourceName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_cmek" "%[3]s" {
+  organization_id = "%[2]s"
+  name            = "%[3]s"
+  config          = {}
+}
+`, globalProviderBlock, globalOrgId, resourceName)
+}
@@ -0,0 +1,34 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDataAPIPrivateEndpointInvalidEndpointID verifies that the Data API private endpoint
+// resource rejects an empty endpoint_id at plan time, so dummy IDs are sufficient.
+func TestAccDataAPIPrivateEndpointInvalidEndpointID(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_data_api_pe_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_data_api_private_endpoint" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  project_id      = "11111111-1111-1111-1111-111111111111"
+  cluster_id      = "22222222-2222-2222-2222-222222222222"
+  endpoint_id     = ""
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`(?s)endpoint_id.*string length must be at least 1`),
+			},
+		},
+	})
+}
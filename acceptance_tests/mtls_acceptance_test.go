@@ -0,0 +1,58 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	re "regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccMtlsCertificateInvalidPEM tests that a certificate which is not PEM fails at plan time.
+func TestAccMtlsCertificateInvalidPEM(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_mtls_certificate_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_mtls_certificate" "%[5]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  cluster_id      = "%[4]s"
+  name            = "%[5]s"
+  certificate     = "not a certificate"
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, globalClusterId, resourceName),
+				ExpectError: re.MustCompile(`(?s)Invalid PEM Certificate.*no PEM block found`),
+			},
+		},
+	})
+}
+
+// TestAccMtlsConfigurationInvalidState tests that state must be disable, hybrid or mandatory.
+func TestAccMtlsConfigurationInvalidState(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_mtls_configuration_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_mtls_configuration" "%[5]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  cluster_id      = "%[4]s"
+  state           = "enabled"
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, globalClusterId, resourceName),
+				ExpectError: re.MustCompile(`(?s)state.*value must be one of`),
+			},
+		},
+	})
+}
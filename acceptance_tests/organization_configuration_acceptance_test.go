@@ -0,0 +1,32 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccOrganizationConfigurationSubdomainTooLong verifies that the organization configuration
+// resource rejects a subdomain longer than 30 characters at plan time, so a dummy ID is sufficient.
+func TestAccOrganizationConfigurationSubdomainTooLong(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_org_config_invalid_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_organization_configuration" "%[2]s" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+  subdomain       = "this-subdomain-is-far-too-long-for-capella"
+}
+`, globalProviderBlock, resourceName),
+				ExpectError: regexp.MustCompile(`(?s)subdomain.*string length must be between 1 and 30`),
+			},
+		},
+	})
+}
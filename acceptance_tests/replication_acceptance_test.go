@@ -0,0 +1,90 @@
+package acceptance_tests
+
+import (
+	"fmt"
+	re "regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDatasourceReplications lists the replications of the shared cluster and of the whole project.
+// The shared test environment has a single cluster, so only the list shape is verified.
+func TestAccDatasourceReplications(t *testing.T) {
+	clusterDsName := randomStringWithPrefix("tf_acc_replications_cluster_ds_")
+	clusterDsReference := "data.couchbase-capella_replications." + clusterDsName
+
+	projectDsName := randomStringWithPrefix("tf_acc_replications_project_ds_")
+	projectDsReference := "data.couchbase-capella_replications." + projectDsName
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationsDataSourceConfig(clusterDsName, projectDsName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(clusterDsReference, "organization_id", globalOrgId),
+					resource.TestCheckResourceAttr(clusterDsReference, "project_id", globalProjectId),
+					resource.TestCheckResourceAttr(clusterDsReference, "cluster_id", globalClusterId),
+					resource.TestCheckResourceAttrSet(clusterDsReference, "data.#"),
+
+					resource.TestCheckResourceAttr(projectDsReference, "organization_id", globalOrgId),
+					resource.TestCheckResourceAttr(projectDsReference, "project_id", globalProjectId),
+					resource.TestCheckNoResourceAttr(projectDsReference, "cluster_id"),
+					resource.TestCheckResourceAttrSet(projectDsReference, "data.#"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccReplicationInvalidDirection tests that direction is rejected when it is not oneWay or twoWay.
+func TestAccReplicationInvalidDirection(t *testing.T) {
+	resourceName := randomStringWithPrefix("tf_acc_replication_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: globalProtoV6ProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReplicationResourceConfig(resourceName, "sideways"),
+				ExpectError: re.MustCompile(`(?s)Attribute direction value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccReplicationsDataSourceConfig(clusterDsName, projectDsName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "couchbase-capella_replications" "%[5]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  cluster_id      = "%[4]s"
+}
+
+data "couchbase-capella_replications" "%[6]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, globalClusterId, clusterDsName, projectDsName)
+}
+
+func testAccReplicationResourceConfig(resourceName, direction string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "couchbase-capella_replication" "%[5]s" {
+  organization_id = "%[2]s"
+  project_id      = "%[3]s"
+  cluster_id      = "%[4]s"
+  source_bucket   = "%[6]s"
+  direction       = "%[7]s"
+
+  target = {
+    cluster = "%[4]s"
+    bucket  = "%[6]s"
+  }
+}
+`, globalProviderBlock, globalOrgId, globalProjectId, globalClusterId, resourceName, globalBucketId, direction)
+}
@@ -0,0 +1,82 @@
+// Generates Terraform configuration for the projects, clusters, buckets, scopes,
+// collections, allowlists, database credentials, app services and app endpoints that
+// already exist in a Capella organization. The output has an import block for each
+// resource, so that running terraform plan and apply brings the organization under
+// Terraform without recreating anything.
+//
+// Usage:
+//
+//	CAPELLA_AUTHENTICATION_TOKEN=<token> go run ./cmd/generate-hcl -organization-id <uuid> -output imported.tf
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/hclgen"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+const defaultHost = "https://cloudapi.cloud.couchbase.com"
+
+func main() {
+	host := os.Getenv("CAPELLA_HOST")
+	if host == "" {
+		host = defaultHost
+	}
+
+	var organizationId, output string
+	var timeout time.Duration
+	flag.StringVar(&organizationId, "organization-id", "", "ID of the organization to generate the configuration for")
+	flag.StringVar(&host, "host", host, "Capella Public API HTTPS host URL, defaults to CAPELLA_HOST")
+	flag.StringVar(&output, "output", "", "file to write the configuration to, defaults to stdout")
+	flag.DurationVar(&timeout, "timeout", 60*time.Second, "timeout of each API request")
+	flag.Parse()
+
+	token := os.Getenv("CAPELLA_AUTHENTICATION_TOKEN")
+	if organizationId == "" || token == "" {
+		fmt.Fprintln(os.Stderr, "-organization-id and the CAPELLA_AUTHENTICATION_TOKEN environment variable are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(host, token, organizationId, output, timeout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(host, token, organizationId, output string, timeout time.Duration) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	generator := hclgen.Generator{
+		Data: &providerschema.Data{
+			HostURL:  host,
+			Token:    token,
+			ClientV1: api.NewClient(timeout),
+		},
+		OrganizationId: organizationId,
+	}
+	config, err := generator.Generate(ctx)
+	if err != nil {
+		return fmt.Errorf("generate failed: %w", err)
+	}
+
+	if output == "" {
+		if _, err := os.Stdout.Write(config); err != nil {
+			return fmt.Errorf("write failed: %w", err)
+		}
+		return nil
+	}
+	if err := os.WriteFile(output, config, 0o600); err != nil {
+		return fmt.Errorf("write failed: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "wrote the configuration to %s\n", output)
+	return nil
+}
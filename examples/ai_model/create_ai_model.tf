@@ -0,0 +1,25 @@
+resource "couchbase-capella_ai_model" "new_ai_model" {
+  organization_id    = var.organization_id
+  name               = var.ai_model.name
+  catalog_model_name = var.ai_model.catalog_model_name
+  state              = var.ai_model.state
+
+  cloud_config = {
+    provider = var.ai_model.provider
+    region   = var.ai_model.region
+    compute = {
+      cpu        = var.ai_model.cpu
+      gpu_memory = var.ai_model.gpu_memory
+    }
+  }
+
+  keyword_filtering = ["password"]
+
+  jailbreak = {
+    score_threshold = 0.8
+  }
+}
+
+output "new_ai_model" {
+  value = couchbase-capella_ai_model.new_ai_model
+}
@@ -0,0 +1,19 @@
+resource "couchbase-capella_ai_model_api_key" "new_ai_model_api_key" {
+  organization_id = var.organization_id
+  name            = var.ai_model_api_key.name
+  description     = var.ai_model_api_key.description
+  region          = var.ai_model.region
+  expiry          = var.ai_model_api_key.expiry
+  allowed_cidrs   = var.ai_model_api_key.allowed_cidrs
+  allowed_models  = [couchbase-capella_ai_model.new_ai_model.id]
+}
+
+output "new_ai_model_api_key" {
+  value     = couchbase-capella_ai_model_api_key.new_ai_model_api_key
+  sensitive = true
+}
+
+output "ai_model_api_key_token" {
+  value     = couchbase-capella_ai_model_api_key.new_ai_model_api_key.token
+  sensitive = true
+}
@@ -0,0 +1,8 @@
+data "couchbase-capella_ai_model" "existing_ai_model" {
+  organization_id = var.organization_id
+  id              = couchbase-capella_ai_model.new_ai_model.id
+}
+
+output "ai_model_connection_string" {
+  value = data.couchbase-capella_ai_model.existing_ai_model.connection_string
+}
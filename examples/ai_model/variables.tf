@@ -0,0 +1,33 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "ai_model" {
+  description = "AI model details useful for deployment"
+
+  type = object({
+    name               = string
+    catalog_model_name = string
+    provider           = string
+    region             = string
+    cpu                = number
+    gpu_memory         = number
+    state              = optional(string, "on")
+  })
+}
+
+variable "ai_model_api_key" {
+  description = "AI model API key details useful for creation"
+
+  type = object({
+    name          = string
+    description   = optional(string)
+    expiry        = number
+    allowed_cidrs = list(string)
+  })
+}
@@ -0,0 +1,16 @@
+resource "couchbase-capella_ai_provider" "new_ai_provider" {
+  organization_id = var.organization_id
+  name            = var.ai_provider.name
+  type            = "awsS3"
+  aws_region      = var.ai_provider.aws_region
+  bucket          = var.ai_provider.bucket
+  folder_path     = var.ai_provider.folder_path
+
+  access_key_id_wo       = var.aws_access_key_id
+  secret_access_key_wo   = var.aws_secret_access_key
+  credentials_wo_version = var.credentials_version
+}
+
+output "new_ai_provider" {
+  value = couchbase-capella_ai_provider.new_ai_provider
+}
@@ -0,0 +1,8 @@
+data "couchbase-capella_ai_providers" "existing_s3_providers" {
+  organization_id = var.organization_id
+  provider_type   = "awsS3"
+}
+
+output "existing_s3_providers" {
+  value = data.couchbase-capella_ai_providers.existing_s3_providers
+}
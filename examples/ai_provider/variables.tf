@@ -0,0 +1,35 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "ai_provider" {
+  description = "S3 provider details useful for registration"
+
+  type = object({
+    name        = string
+    aws_region  = string
+    bucket      = string
+    folder_path = optional(string)
+  })
+}
+
+variable "aws_access_key_id" {
+  description = "AWS access key ID used by Capella to read the S3 bucket"
+  sensitive   = true
+}
+
+variable "aws_secret_access_key" {
+  description = "AWS secret access key used by Capella to read the S3 bucket"
+  sensitive   = true
+}
+
+variable "credentials_version" {
+  description = "Increment to send the AWS credentials to Capella again after rotating them"
+  type        = number
+  default     = 1
+}
@@ -0,0 +1,37 @@
+resource "couchbase-capella_ai_workflow" "new_ai_workflow" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  name            = var.ai_workflow.name
+
+  target_couchbase_keyspace = {
+    bucket     = var.ai_workflow.bucket
+    scope      = var.ai_workflow.scope
+    collection = var.ai_workflow.collection
+  }
+
+  vectorization_config = {
+    embedding_model = {
+      external = {
+        model_name  = var.ai_workflow.model_name
+        provider_id = var.ai_workflow.provider_id
+      }
+    }
+    embedding_field_mappings = {
+      text_vector = {
+        source_fields = var.ai_workflow.source_fields
+      }
+    }
+    create_indexes = true
+  }
+
+  run_trigger = var.ai_workflow.run_trigger
+}
+
+output "new_ai_workflow" {
+  value = couchbase-capella_ai_workflow.new_ai_workflow
+}
+
+output "ai_workflow_last_run" {
+  value = couchbase-capella_ai_workflow.new_ai_workflow.last_run
+}
@@ -0,0 +1,25 @@
+data "couchbase-capella_ai_workflow_runs" "existing_ai_workflow_runs" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  workflow_id     = couchbase-capella_ai_workflow.new_ai_workflow.id
+}
+
+data "couchbase-capella_ai_workflow_processed_files" "failed_files" {
+  count = var.ai_workflow.run_trigger == null ? 0 : 1
+
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  workflow_id     = couchbase-capella_ai_workflow.new_ai_workflow.id
+  run_id          = couchbase-capella_ai_workflow.new_ai_workflow.last_run.id
+  file_status     = "failed"
+}
+
+output "ai_workflow_runs" {
+  value = data.couchbase-capella_ai_workflow_runs.existing_ai_workflow_runs
+}
+
+output "ai_workflow_failed_files" {
+  value = one(data.couchbase-capella_ai_workflow_processed_files.failed_files[*].data)
+}
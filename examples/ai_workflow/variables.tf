@@ -0,0 +1,31 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "cluster_id" {
+  description = "Capella Cluster ID"
+}
+
+variable "ai_workflow" {
+  description = "AI workflow details useful for creation"
+
+  type = object({
+    name          = string
+    bucket        = string
+    scope         = string
+    collection    = string
+    model_name    = string
+    provider_id   = string
+    source_fields = list(string)
+    run_trigger   = optional(string)
+  })
+}
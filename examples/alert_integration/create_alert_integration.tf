@@ -0,0 +1,19 @@
+resource "couchbase-capella_alert_integration" "new_alert_integration" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  name            = var.alert_integration.name
+  test_on_create  = var.alert_integration.test_on_create
+
+  config = {
+    webhook = {
+      url    = var.webhook_url
+      method = var.alert_integration.method
+      token  = var.webhook_token
+    }
+  }
+}
+
+output "new_alert_integration" {
+  value     = couchbase-capella_alert_integration.new_alert_integration
+  sensitive = true
+}
@@ -0,0 +1,9 @@
+data "couchbase-capella_alert_integrations" "existing_alert_integrations" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+}
+
+output "alert_integrations_list" {
+  value     = data.couchbase-capella_alert_integrations.existing_alert_integrations
+  sensitive = true
+}
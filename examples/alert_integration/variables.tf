@@ -0,0 +1,33 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "alert_integration" {
+  description = "Alert integration configuration details useful for creation"
+
+  type = object({
+    name           = string
+    method         = optional(string, "POST")
+    test_on_create = optional(bool, false)
+  })
+}
+
+variable "webhook_url" {
+  description = "URL the alerts are delivered to"
+  sensitive   = true
+}
+
+variable "webhook_token" {
+  description = "Bearer token sent with each alert"
+  sensitive   = true
+  default     = null
+}
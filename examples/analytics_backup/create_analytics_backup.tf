@@ -0,0 +1,11 @@
+resource "couchbase-capella_analytics_backup" "new_analytics_backup" {
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = var.analytics_cluster_id
+  retention            = var.analytics_backup.retention
+  restore_times        = var.analytics_backup.restore_times
+}
+
+output "new_analytics_backup" {
+  value = couchbase-capella_analytics_backup.new_analytics_backup
+}
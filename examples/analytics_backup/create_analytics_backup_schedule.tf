@@ -0,0 +1,12 @@
+resource "couchbase-capella_analytics_backup_schedule" "new_analytics_backup_schedule" {
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = var.analytics_cluster_id
+  interval             = var.analytics_backup_schedule.interval
+  retention            = var.analytics_backup_schedule.retention
+  start_time           = var.analytics_backup_schedule.start_time
+}
+
+output "new_analytics_backup_schedule" {
+  value = couchbase-capella_analytics_backup_schedule.new_analytics_backup_schedule
+}
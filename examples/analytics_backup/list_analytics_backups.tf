@@ -0,0 +1,21 @@
+data "couchbase-capella_analytics_backups" "existing_analytics_backups" {
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = var.analytics_cluster_id
+
+  depends_on = [couchbase-capella_analytics_backup.new_analytics_backup]
+}
+
+output "analytics_backups_list" {
+  value = data.couchbase-capella_analytics_backups.existing_analytics_backups
+}
+
+data "couchbase-capella_analytics_restores" "existing_analytics_restores" {
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = var.analytics_cluster_id
+}
+
+output "analytics_restores_list" {
+  value = data.couchbase-capella_analytics_restores.existing_analytics_restores
+}
@@ -0,0 +1,35 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "analytics_cluster_id" {
+  description = "Capella Analytics Cluster ID"
+}
+
+variable "analytics_backup" {
+  description = "Analytics backup configuration details useful for creation"
+
+  type = object({
+    retention     = optional(number)
+    restore_times = optional(number)
+  })
+}
+
+variable "analytics_backup_schedule" {
+  description = "Analytics backup schedule configuration details"
+
+  type = object({
+    interval   = number
+    retention  = number
+    start_time = optional(string)
+  })
+}
@@ -0,0 +1,12 @@
+resource "couchbase-capella_analytics_allowlist" "new_analytics_allowlist" {
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = couchbase-capella_analytics_cluster.new_analytics_cluster.id
+  cidr                 = var.analytics_allowlist.cidr
+  comment              = var.analytics_allowlist.comment
+  expires_at           = var.analytics_allowlist.expires_at
+}
+
+output "new_analytics_allowlist" {
+  value = couchbase-capella_analytics_allowlist.new_analytics_allowlist
+}
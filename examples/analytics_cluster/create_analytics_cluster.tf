@@ -0,0 +1,28 @@
+resource "couchbase-capella_analytics_cluster" "new_analytics_cluster" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  name            = var.analytics_cluster.name
+  description     = var.analytics_cluster.description
+  cloud_provider  = var.analytics_cluster.cloud_provider
+  region          = var.analytics_cluster.region
+  nodes           = var.analytics_cluster.nodes
+  state           = var.analytics_cluster.state
+
+  compute = {
+    cpu = var.analytics_cluster.cpu
+    ram = var.analytics_cluster.ram
+  }
+
+  availability = {
+    type = var.analytics_cluster.availability
+  }
+
+  support = {
+    plan     = var.analytics_cluster.support_plan
+    timezone = var.analytics_cluster.timezone
+  }
+}
+
+output "new_analytics_cluster" {
+  value = couchbase-capella_analytics_cluster.new_analytics_cluster
+}
@@ -0,0 +1,20 @@
+resource "couchbase-capella_analytics_onoff_schedule" "new_analytics_onoff_schedule" {
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = couchbase-capella_analytics_cluster.new_analytics_cluster.id
+  timezone             = var.schedule_timezone
+
+  days = [
+    { day = "monday", state = "on" },
+    { day = "tuesday", state = "on" },
+    { day = "wednesday", state = "on" },
+    { day = "thursday", state = "on" },
+    { day = "friday", state = "custom", from = { hour = 8 }, to = { hour = 18 } },
+    { day = "saturday", state = "off" },
+    { day = "sunday", state = "off" },
+  ]
+}
+
+output "new_analytics_onoff_schedule" {
+  value = couchbase-capella_analytics_onoff_schedule.new_analytics_onoff_schedule
+}
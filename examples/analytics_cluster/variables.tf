@@ -0,0 +1,45 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "analytics_cluster" {
+  description = "Analytics cluster configuration details useful for creation"
+
+  type = object({
+    name           = string
+    description    = optional(string)
+    cloud_provider = string
+    region         = string
+    nodes          = number
+    cpu            = number
+    ram            = number
+    availability   = string
+    support_plan   = string
+    timezone       = string
+    state          = optional(string)
+  })
+}
+
+variable "analytics_allowlist" {
+  description = "Allowed CIDR configuration details useful for creation"
+
+  type = object({
+    cidr       = string
+    comment    = optional(string)
+    expires_at = optional(string)
+  })
+}
+
+variable "schedule_timezone" {
+  description = "Timezone of the analytics cluster on/off schedule"
+  default     = "US/Pacific"
+}
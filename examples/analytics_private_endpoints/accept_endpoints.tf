@@ -0,0 +1,12 @@
+resource "couchbase-capella_analytics_private_endpoints" "accepted_endpoints" {
+  for_each = var.endpoint_ids
+
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = couchbase-capella_analytics_private_endpoint_service.new_service.analytics_cluster_id
+  endpoint_id          = each.value
+}
+
+output "accepted_endpoints" {
+  value = couchbase-capella_analytics_private_endpoints.accepted_endpoints
+}
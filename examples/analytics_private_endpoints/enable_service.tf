@@ -0,0 +1,10 @@
+resource "couchbase-capella_analytics_private_endpoint_service" "new_service" {
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = var.analytics_cluster_id
+  enabled              = true
+}
+
+output "analytics_private_endpoint_service" {
+  value = couchbase-capella_analytics_private_endpoint_service.new_service
+}
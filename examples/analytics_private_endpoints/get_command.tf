@@ -0,0 +1,13 @@
+data "couchbase-capella_analytics_private_endpoint_command" "command" {
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = var.analytics_cluster_id
+  vpc_id               = var.vpc_id
+  subnet_ids           = var.subnet_ids
+
+  depends_on = [couchbase-capella_analytics_private_endpoint_service.new_service]
+}
+
+output "command" {
+  value = data.couchbase-capella_analytics_private_endpoint_command.command.command
+}
@@ -0,0 +1,11 @@
+data "couchbase-capella_analytics_private_endpoints" "existing_endpoints" {
+  organization_id      = var.organization_id
+  project_id           = var.project_id
+  analytics_cluster_id = var.analytics_cluster_id
+
+  depends_on = [couchbase-capella_analytics_private_endpoint_service.new_service]
+}
+
+output "pending_endpoint_ids" {
+  value = [for endpoint in data.couchbase-capella_analytics_private_endpoints.existing_endpoints.data : endpoint.id if endpoint.status == "pendingAcceptance"]
+}
@@ -0,0 +1,31 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "analytics_cluster_id" {
+  description = "Capella Analytics Cluster ID"
+}
+
+variable "vpc_id" {
+  description = "VPC ID"
+}
+
+variable "subnet_ids" {
+  description = "subnet IDs"
+  type        = list(string)
+}
+
+variable "endpoint_ids" {
+  description = "IDs of the private endpoints to accept. Removing an ID rejects its endpoint."
+  type        = set(string)
+  default     = []
+}
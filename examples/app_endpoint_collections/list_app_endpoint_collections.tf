@@ -0,0 +1,15 @@
+output "app_endpoint_collections" {
+  value = data.couchbase-capella_app_endpoint_collections.app_endpoint_collections
+}
+
+output "synced_collections" {
+  value = [for c in data.couchbase-capella_app_endpoint_collections.app_endpoint_collections.data : "${c.scope}.${c.name}"]
+}
+
+data "couchbase-capella_app_endpoint_collections" "app_endpoint_collections" {
+  organization_id   = var.organization_id
+  project_id        = var.project_id
+  cluster_id        = var.cluster_id
+  app_service_id    = var.app_service_id
+  app_endpoint_name = var.app_endpoint_name
+}
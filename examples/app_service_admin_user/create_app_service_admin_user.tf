@@ -0,0 +1,18 @@
+resource "couchbase-capella_app_service_admin_user" "new_admin_user" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  app_service_id  = var.app_service_id
+  name            = var.admin_user.name
+  password        = var.admin_user_password
+  endpoints       = var.admin_user.endpoints
+}
+
+output "new_admin_user" {
+  value     = couchbase-capella_app_service_admin_user.new_admin_user
+  sensitive = true
+}
+
+output "admin_user_id" {
+  value = couchbase-capella_app_service_admin_user.new_admin_user.id
+}
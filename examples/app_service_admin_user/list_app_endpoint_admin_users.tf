@@ -0,0 +1,13 @@
+data "couchbase-capella_app_endpoint_admin_users" "existing_admin_users" {
+  organization_id   = var.organization_id
+  project_id        = var.project_id
+  cluster_id        = var.cluster_id
+  app_service_id    = var.app_service_id
+  app_endpoint_name = var.admin_user.endpoints[0]
+
+  depends_on = [couchbase-capella_app_service_admin_user.new_admin_user]
+}
+
+output "app_endpoint_admin_users" {
+  value = data.couchbase-capella_app_endpoint_admin_users.existing_admin_users
+}
@@ -0,0 +1,34 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "cluster_id" {
+  description = "Capella Cluster ID"
+}
+
+variable "app_service_id" {
+  description = "Capella App Service ID"
+}
+
+variable "admin_user" {
+  description = "App Service admin user details useful for creation"
+
+  type = object({
+    name      = string
+    endpoints = list(string)
+  })
+}
+
+variable "admin_user_password" {
+  description = "Password of the App Service admin user"
+  sensitive   = true
+}
@@ -0,0 +1,25 @@
+resource "couchbase-capella_app_service_audit_log_export" "export" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  app_service_id  = var.app_service_id
+  start           = var.export_start
+  end             = var.export_end
+}
+
+data "couchbase-capella_app_service_audit_log_exports" "exports" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  app_service_id  = var.app_service_id
+
+  depends_on = [couchbase-capella_app_service_audit_log_export.export]
+}
+
+output "app_service_audit_log_export" {
+  value = couchbase-capella_app_service_audit_log_export.export
+}
+
+output "app_service_audit_log_exports" {
+  value = data.couchbase-capella_app_service_audit_log_exports.exports.data
+}
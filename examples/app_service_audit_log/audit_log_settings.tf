@@ -0,0 +1,42 @@
+resource "couchbase-capella_app_service_audit_log_settings" "app_service_audit" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  app_service_id  = var.app_service_id
+  audit_enabled   = true
+}
+
+data "couchbase-capella_app_service_audit_log_event_ids" "event_ids" {
+  organization_id   = var.organization_id
+  project_id        = var.project_id
+  cluster_id        = var.cluster_id
+  app_service_id    = var.app_service_id
+  app_endpoint_name = var.app_endpoint_name
+}
+
+resource "couchbase-capella_app_endpoint_audit_log_settings" "app_endpoint_audit" {
+  organization_id   = var.organization_id
+  project_id        = var.project_id
+  cluster_id        = var.cluster_id
+  app_service_id    = var.app_service_id
+  app_endpoint_name = var.app_endpoint_name
+  audit_enabled     = true
+  enabled_event_ids = [for event in data.couchbase-capella_app_service_audit_log_event_ids.event_ids.data : event.id]
+
+  disabled_users = [
+    {
+      domain = "sgw"
+      name   = "service-account"
+    }
+  ]
+
+  depends_on = [couchbase-capella_app_service_audit_log_settings.app_service_audit]
+}
+
+output "app_service_audit_log_settings" {
+  value = couchbase-capella_app_service_audit_log_settings.app_service_audit
+}
+
+output "app_endpoint_audit_log_settings" {
+  value = couchbase-capella_app_endpoint_audit_log_settings.app_endpoint_audit
+}
@@ -0,0 +1,20 @@
+resource "couchbase-capella_app_service_audit_log_streaming" "splunk" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  app_service_id  = var.app_service_id
+
+  output_type = "splunk"
+  credentials = {
+    splunk = {
+      url          = var.splunk_url
+      splunk_token = var.splunk_token
+    }
+  }
+
+  depends_on = [couchbase-capella_app_service_audit_log_settings.app_service_audit]
+}
+
+output "app_service_audit_log_streaming_state" {
+  value = couchbase-capella_app_service_audit_log_streaming.splunk.log_streaming_state
+}
@@ -0,0 +1,41 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "cluster_id" {
+  description = "Capella Cluster ID"
+}
+
+variable "app_service_id" {
+  description = "Capella App Service ID"
+}
+
+variable "app_endpoint_name" {
+  description = "App Endpoint name"
+}
+
+variable "splunk_url" {
+  description = "Splunk HTTP Event Collector URL"
+}
+
+variable "splunk_token" {
+  description = "Splunk HTTP Event Collector token"
+  sensitive   = true
+}
+
+variable "export_start" {
+  description = "Start of the audit log export window in RFC3339 format"
+}
+
+variable "export_end" {
+  description = "End of the audit log export window in RFC3339 format"
+}
@@ -0,0 +1,13 @@
+resource "couchbase-capella_app_service_private_endpoints" "accept_endpoint" {
+  count = var.endpoint_id == null ? 0 : 1
+
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  app_service_id  = couchbase-capella_app_service_private_endpoint_service.new_service.app_service_id
+  endpoint_id     = var.endpoint_id
+}
+
+output "accepted_endpoint" {
+  value = one(couchbase-capella_app_service_private_endpoints.accept_endpoint[*])
+}
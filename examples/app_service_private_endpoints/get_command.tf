@@ -0,0 +1,14 @@
+data "couchbase-capella_app_service_aws_private_endpoint_command" "aws_command" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  app_service_id  = var.app_service_id
+  vpc_id          = var.vpc_id
+  subnet_ids      = var.subnet_ids
+
+  depends_on = [couchbase-capella_app_service_private_endpoint_service.new_service]
+}
+
+output "aws_command" {
+  value = data.couchbase-capella_app_service_aws_private_endpoint_command.aws_command.command
+}
@@ -0,0 +1,11 @@
+output "backup_cycle_retention" {
+  value = couchbase-capella_backup_cycle_retention.new_retention
+}
+
+resource "couchbase-capella_backup_cycle_retention" "new_retention" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  bucket_id       = var.bucket_id
+  max_age_days    = var.max_age_days
+}
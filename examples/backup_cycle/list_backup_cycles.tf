@@ -0,0 +1,10 @@
+output "backup_cycles" {
+  value = data.couchbase-capella_backup_cycles.existing_backup_cycles
+}
+
+data "couchbase-capella_backup_cycles" "existing_backup_cycles" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  bucket_id       = var.bucket_id
+}
@@ -0,0 +1,25 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "cluster_id" {
+  description = "Capella Cluster ID"
+}
+
+variable "bucket_id" {
+  description = "Capella Bucket ID"
+}
+
+variable "max_age_days" {
+  description = "Number of days after its latest backup that a backup cycle is deleted"
+  type        = number
+}
@@ -0,0 +1,7 @@
+output "cloud_accounts" {
+  value = data.couchbase-capella_cloud_accounts.existing_cloud_accounts
+}
+
+data "couchbase-capella_cloud_accounts" "existing_cloud_accounts" {
+  organization_id = var.organization_id
+}
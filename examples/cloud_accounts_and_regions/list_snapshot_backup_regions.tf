@@ -0,0 +1,9 @@
+output "snapshot_backup_regions" {
+  value = data.couchbase-capella_snapshot_backup_regions.existing_regions
+}
+
+data "couchbase-capella_snapshot_backup_regions" "existing_regions" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+}
@@ -0,0 +1,26 @@
+output "cluster_clone" {
+  value = couchbase-capella_cluster_clone.staging
+}
+
+resource "couchbase-capella_cluster_clone" "staging" {
+  organization_id    = var.organization_id
+  project_id         = var.project_id
+  source_snapshot_id = var.source_snapshot_id
+  name               = var.cluster_clone.name
+  description        = var.cluster_clone.description
+
+  cloud_provider = {
+    type   = var.cluster_clone.cloud_provider
+    region = var.cluster_clone.region
+    cidr   = var.cluster_clone.cidr
+  }
+
+  availability = {
+    type = var.cluster_clone.availability
+  }
+
+  support = {
+    plan     = var.cluster_clone.support_plan
+    timezone = var.cluster_clone.timezone
+  }
+}
@@ -0,0 +1,31 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "source_snapshot_id" {
+  description = "ID of the cloud snapshot backup to clone the cluster from"
+}
+
+variable "cluster_clone" {
+  description = "Cloned cluster configuration details useful for creation"
+
+  type = object({
+    name           = string
+    description    = optional(string)
+    cloud_provider = string
+    region         = string
+    cidr           = optional(string)
+    availability   = string
+    support_plan   = string
+    timezone       = optional(string)
+  })
+}
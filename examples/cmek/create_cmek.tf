@@ -0,0 +1,23 @@
+resource "couchbase-capella_cmek" "new_cmek" {
+  organization_id = var.organization_id
+  name            = var.cmek.name
+  description     = var.cmek.description
+  rotate          = var.cmek.rotate
+
+  config = {
+    aws_config = {
+      arn = var.cmek.aws_key_arn
+    }
+  }
+}
+
+output "new_cmek" {
+  value = couchbase-capella_cmek.new_cmek
+}
+
+resource "couchbase-capella_cmek_cluster_association" "new_association" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  cmek_id         = couchbase-capella_cmek.new_cmek.id
+}
@@ -0,0 +1,8 @@
+data "couchbase-capella_cmek_history" "existing_cmek_history" {
+  organization_id = var.organization_id
+  cmek_id         = couchbase-capella_cmek.new_cmek.id
+}
+
+output "existing_cmek_history" {
+  value = data.couchbase-capella_cmek_history.existing_cmek_history
+}
@@ -0,0 +1,27 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "cluster_id" {
+  description = "Capella Cluster ID"
+}
+
+variable "cmek" {
+  description = "CMEK configuration details useful for creation"
+
+  type = object({
+    name        = string
+    description = optional(string)
+    aws_key_arn = string
+    rotate      = optional(number)
+  })
+}
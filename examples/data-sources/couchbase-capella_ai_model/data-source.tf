@@ -0,0 +1,4 @@
+data "couchbase-capella_ai_model" "existing_ai_model" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  id              = "ffffffff-aaaa-1414-eeee-000000000000"
+}
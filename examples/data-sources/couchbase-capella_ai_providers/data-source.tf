@@ -0,0 +1,4 @@
+data "couchbase-capella_ai_providers" "existing_ai_providers" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  provider_type   = "awsS3"
+}
@@ -0,0 +1,8 @@
+data "couchbase-capella_ai_workflow_processed_files" "failed_files" {
+  organization_id = "<organization_id>"
+  project_id      = "<project_id>"
+  cluster_id      = "<cluster_id>"
+  workflow_id     = "<workflow_id>"
+  run_id          = "<run_id>"
+  file_status     = "failed"
+}
@@ -0,0 +1,6 @@
+data "couchbase-capella_ai_workflow_runs" "existing_ai_workflow_runs" {
+  organization_id = "<organization_id>"
+  project_id      = "<project_id>"
+  cluster_id      = "<cluster_id>"
+  workflow_id     = "<workflow_id>"
+}
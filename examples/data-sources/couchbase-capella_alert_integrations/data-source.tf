@@ -0,0 +1,4 @@
+data "couchbase-capella_alert_integrations" "existing_alert_integrations" {
+  organization_id = "<organization_id>"
+  project_id      = "<project_id>"
+}
@@ -0,0 +1,5 @@
+data "couchbase-capella_analytics_backups" "existing_analytics_backups" {
+  organization_id      = "<organization_id>"
+  project_id           = "<project_id>"
+  analytics_cluster_id = "<analytics_cluster_id>"
+}
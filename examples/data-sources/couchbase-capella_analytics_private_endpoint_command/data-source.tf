@@ -0,0 +1,7 @@
+data "couchbase-capella_analytics_private_endpoint_command" "command" {
+  organization_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id           = "ffffffff-aaaa-1414-eeee-000000000000"
+  analytics_cluster_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  vpc_id               = "vpc-1"
+  subnet_ids           = ["subnet-1"]
+}
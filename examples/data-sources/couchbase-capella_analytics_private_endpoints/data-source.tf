@@ -0,0 +1,5 @@
+data "couchbase-capella_analytics_private_endpoints" "existing_endpoints" {
+  organization_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id           = "ffffffff-aaaa-1414-eeee-000000000000"
+  analytics_cluster_id = "ffffffff-aaaa-1414-eeee-000000000000"
+}
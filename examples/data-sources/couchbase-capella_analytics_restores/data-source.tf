@@ -0,0 +1,10 @@
+data "couchbase-capella_analytics_restores" "existing_analytics_restores" {
+  organization_id      = "<organization_id>"
+  project_id           = "<project_id>"
+  analytics_cluster_id = "<analytics_cluster_id>"
+
+  filter {
+    name   = "status"
+    values = ["complete"]
+  }
+}
@@ -0,0 +1,7 @@
+data "couchbase-capella_app_endpoint_collections" "app_endpoint_collections" {
+  organization_id   = "aaaaa-bbbb-cccc-dddd-eeee"
+  project_id        = "aaaaa-bbbb-cccc-dddd-eeee"
+  cluster_id        = "aaaaa-bbbb-cccc-dddd-eeee"
+  app_service_id    = "aaaaa-bbbb-cccc-dddd-eeee"
+  app_endpoint_name = "app_endpoint_name"
+}
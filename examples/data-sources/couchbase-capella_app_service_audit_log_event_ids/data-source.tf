@@ -0,0 +1,7 @@
+data "couchbase-capella_app_service_audit_log_event_ids" "event_ids" {
+  organization_id   = "<organization_id>"
+  project_id        = "<project_id>"
+  cluster_id        = "<cluster_id>"
+  app_service_id    = "<app_service_id>"
+  app_endpoint_name = "<app_endpoint_name>"
+}
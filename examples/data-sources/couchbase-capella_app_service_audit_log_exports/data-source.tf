@@ -0,0 +1,6 @@
+data "couchbase-capella_app_service_audit_log_exports" "exports" {
+  organization_id = "<organization_id>"
+  project_id      = "<project_id>"
+  cluster_id      = "<cluster_id>"
+  app_service_id  = "<app_service_id>"
+}
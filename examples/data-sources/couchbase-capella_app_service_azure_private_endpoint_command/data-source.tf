@@ -0,0 +1,8 @@
+data "couchbase-capella_app_service_azure_private_endpoint_command" "azure_command" {
+  organization_id     = "<organization_id>"
+  project_id          = "<project_id>"
+  cluster_id          = "<cluster_id>"
+  app_service_id      = "<app_service_id>"
+  resource_group_name = "test-rg"
+  virtual_network     = "vnet-1/subnet-1"
+}
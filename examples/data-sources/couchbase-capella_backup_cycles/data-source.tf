@@ -0,0 +1,6 @@
+data "couchbase-capella_backup_cycles" "existing_backup_cycles" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  bucket_id       = "YjE="
+}
@@ -0,0 +1,3 @@
+data "couchbase-capella_cloud_accounts" "existing_cloud_accounts" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+}
@@ -0,0 +1,4 @@
+data "couchbase-capella_cmek_history" "existing_cmek_history" {
+  organization_id = "<organization_id>"
+  cmek_id         = "<cmek_id>"
+}
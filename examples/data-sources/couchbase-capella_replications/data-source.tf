@@ -0,0 +1,6 @@
+# Lists the replications of a single cluster. Omit cluster_id to list every replication in the project.
+data "couchbase-capella_replications" "existing_replications" {
+  organization_id = "<organization_id>"
+  project_id      = "<project_id>"
+  cluster_id      = "<cluster_id>"
+}
@@ -0,0 +1,5 @@
+data "couchbase-capella_snapshot_backup_regions" "existing_regions" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+}
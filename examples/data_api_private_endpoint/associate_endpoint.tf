@@ -0,0 +1,14 @@
+resource "couchbase-capella_data_api_private_endpoint" "associate_endpoint" {
+  count = var.endpoint_id == null ? 0 : 1
+
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  endpoint_id     = var.endpoint_id
+
+  depends_on = [couchbase-capella_data_api.data_api]
+}
+
+output "associated_endpoint" {
+  value = couchbase-capella_data_api_private_endpoint.associate_endpoint
+}
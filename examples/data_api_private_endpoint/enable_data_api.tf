@@ -0,0 +1,6 @@
+resource "couchbase-capella_data_api" "data_api" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  enable_data_api = true
+}
@@ -0,0 +1,13 @@
+data "couchbase-capella_data_api_private_endpoint_command" "aws_command" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  vpc_id          = var.vpc_id
+  subnet_ids      = var.subnet_ids
+
+  depends_on = [couchbase-capella_data_api.data_api]
+}
+
+output "aws_command" {
+  value = data.couchbase-capella_data_api_private_endpoint_command.aws_command.command
+}
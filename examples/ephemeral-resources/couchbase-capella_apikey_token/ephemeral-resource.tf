@@ -0,0 +1,7 @@
+# Rotate the API key by increasing rotate on the couchbase-capella_apikey resource, then
+# pass its token to other providers through the ephemeral resource.
+ephemeral "couchbase-capella_apikey_token" "current_token" {
+  organization_id = "<organization_id>"
+  api_key_id      = couchbase-capella_apikey.new_apikey.id
+  token           = couchbase-capella_apikey.new_apikey.token
+}
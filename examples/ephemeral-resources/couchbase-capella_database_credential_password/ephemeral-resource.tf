@@ -0,0 +1,20 @@
+ephemeral "couchbase-capella_database_credential_password" "new_password" {}
+
+# Send the generated password to Capella as a write-only value. Change password_wo_version
+# to rotate the password; the other providers that receive the password should be updated
+# in the same apply.
+resource "couchbase-capella_database_credential" "new_database_credential" {
+  name                = "ReadOnlyWithGeneratedPassword"
+  organization_id     = "<organization_id>"
+  project_id          = "<project_id>"
+  cluster_id          = "<cluster_id>"
+  password_wo         = ephemeral.couchbase-capella_database_credential_password.new_password.password
+  password_wo_version = 1
+  access = [
+    {
+      "privileges" : [
+        "data_reader"
+      ]
+    }
+  ]
+}
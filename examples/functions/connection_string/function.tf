@@ -0,0 +1,7 @@
+output "connection_string" {
+  value = provider::couchbase-capella::connection_string(couchbase-capella_cluster.new_cluster)
+}
+
+output "non_tls_connection_string" {
+  value = provider::couchbase-capella::connection_string("cb.xxxxxxxxxxxxxx.cloud.couchbase.com", false)
+}
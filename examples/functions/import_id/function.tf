@@ -0,0 +1,8 @@
+import {
+  to = couchbase-capella_cluster.new_cluster
+  id = provider::couchbase-capella::import_id({
+    id              = "<cluster_id>"
+    project_id      = "<project_id>"
+    organization_id = "<organization_id>"
+  })
+}
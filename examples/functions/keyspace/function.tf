@@ -0,0 +1,4 @@
+output "keyspace" {
+  # `travel-sample`.`inventory`.`airline`
+  value = provider::couchbase-capella::keyspace("travel-sample", "inventory", "airline")
+}
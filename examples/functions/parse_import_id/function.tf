@@ -0,0 +1,7 @@
+locals {
+  cluster_ids = provider::couchbase-capella::parse_import_id("id=<cluster_id>,project_id=<project_id>,organization_id=<organization_id>")
+}
+
+output "project_id" {
+  value = local.cluster_ids["project_id"]
+}
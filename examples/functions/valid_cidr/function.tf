@@ -0,0 +1,8 @@
+variable "allowlist_cidr" {
+  type = string
+
+  validation {
+    condition     = provider::couchbase-capella::valid_cidr(var.allowlist_cidr)
+    error_message = "allowlist_cidr must be an IP address range in CIDR notation, such as 10.0.0.0/16."
+  }
+}
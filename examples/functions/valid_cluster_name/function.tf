@@ -0,0 +1,8 @@
+variable "cluster_name" {
+  type = string
+
+  validation {
+    condition     = provider::couchbase-capella::valid_cluster_name(var.cluster_name)
+    error_message = "cluster_name must not be empty, have surrounding whitespace or be longer than 256 characters."
+  }
+}
@@ -0,0 +1,12 @@
+resource "couchbase-capella_mtls_certificate" "new_mtls_certificate" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  name            = var.mtls_certificate.name
+  certificate     = file(var.mtls_certificate.certificate_file)
+  intermediates   = var.mtls_certificate.intermediates_file == null ? null : file(var.mtls_certificate.intermediates_file)
+}
+
+output "new_mtls_certificate" {
+  value = couchbase-capella_mtls_certificate.new_mtls_certificate
+}
@@ -0,0 +1,14 @@
+resource "couchbase-capella_mtls_configuration" "mtls_configuration" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  state           = var.mtls_configuration.state
+  prefixes        = var.mtls_configuration.prefixes
+
+  # Client certificates can only be verified once a trusted CA is loaded.
+  depends_on = [couchbase-capella_mtls_certificate.new_mtls_certificate]
+}
+
+output "mtls_configuration" {
+  value = couchbase-capella_mtls_configuration.mtls_configuration
+}
@@ -0,0 +1,39 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "cluster_id" {
+  description = "Capella Cluster ID"
+}
+
+variable "mtls_certificate" {
+  description = "Client CA certificate details useful for creation"
+
+  type = object({
+    name               = string
+    certificate_file   = string
+    intermediates_file = optional(string)
+  })
+}
+
+variable "mtls_configuration" {
+  description = "mTLS configuration of the cluster"
+
+  type = object({
+    state = string
+    prefixes = optional(list(object({
+      path      = string
+      prefix    = optional(string)
+      delimiter = optional(string)
+    })))
+  })
+}
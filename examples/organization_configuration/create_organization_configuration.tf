@@ -0,0 +1,8 @@
+output "organization_configuration" {
+  value = couchbase-capella_organization_configuration.new_configuration
+}
+
+resource "couchbase-capella_organization_configuration" "new_configuration" {
+  organization_id = var.organization_id
+  subdomain       = var.subdomain
+}
@@ -0,0 +1,12 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "subdomain" {
+  description = "Subdomain of the organization, up to 30 characters"
+}
@@ -0,0 +1,18 @@
+resource "couchbase-capella_replication" "new_replication" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+  source_bucket   = var.replication.source_bucket
+  direction       = var.replication.direction
+  priority        = var.replication.priority
+  paused          = var.replication.paused
+
+  target = {
+    cluster = var.replication.target_cluster
+    bucket  = var.replication.target_bucket
+  }
+}
+
+output "new_replication" {
+  value = couchbase-capella_replication.new_replication
+}
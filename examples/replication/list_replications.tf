@@ -0,0 +1,11 @@
+# Retrieve all replications of the source cluster.
+# Omit cluster_id to list the replications of every cluster in the project.
+data "couchbase-capella_replications" "existing_replications" {
+  organization_id = var.organization_id
+  project_id      = var.project_id
+  cluster_id      = var.cluster_id
+}
+
+output "existing_replications" {
+  value = data.couchbase-capella_replications.existing_replications
+}
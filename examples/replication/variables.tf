@@ -0,0 +1,29 @@
+variable "auth_token" {
+  description = "Authentication API Key"
+  sensitive   = true
+}
+
+variable "organization_id" {
+  description = "Capella Organization ID"
+}
+
+variable "project_id" {
+  description = "Capella Project ID"
+}
+
+variable "cluster_id" {
+  description = "Capella source Cluster ID"
+}
+
+variable "replication" {
+  description = "Replication configuration details useful for creation"
+
+  type = object({
+    source_bucket  = string
+    target_cluster = string
+    target_bucket  = string
+    direction      = optional(string)
+    priority       = optional(string)
+    paused         = optional(bool)
+  })
+}
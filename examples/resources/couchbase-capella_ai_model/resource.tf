@@ -0,0 +1,15 @@
+resource "couchbase-capella_ai_model" "new_ai_model" {
+  organization_id    = "ffffffff-aaaa-1414-eeee-000000000000"
+  name               = "embedder"
+  catalog_model_name = "nvidia/nv-embedqa-e5-v5"
+  state              = "on"
+
+  cloud_config = {
+    provider = "aws"
+    region   = "us-east-1"
+    compute = {
+      cpu        = 4
+      gpu_memory = 24
+    }
+  }
+}
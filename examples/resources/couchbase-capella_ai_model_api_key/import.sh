@@ -0,0 +1 @@
+terraform import couchbase-capella_ai_model_api_key.new_ai_model_api_key id=ffffffff-aaaa-1414-eeee-000000000000,organization_id=ffffffff-aaaa-1414-eeee-000000000000
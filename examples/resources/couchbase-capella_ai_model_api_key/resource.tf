@@ -0,0 +1,8 @@
+resource "couchbase-capella_ai_model_api_key" "new_ai_model_api_key" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  name            = "inference"
+  region          = "us-east-1"
+  expiry          = 180
+  allowed_cidrs   = ["10.0.0.0/16"]
+  allowed_models  = ["ffffffff-aaaa-1414-eeee-000000000000"]
+}
@@ -0,0 +1 @@
+terraform import couchbase-capella_ai_provider.new_ai_provider id=ffffffff-aaaa-1414-eeee-000000000000,organization_id=ffffffff-aaaa-1414-eeee-000000000000
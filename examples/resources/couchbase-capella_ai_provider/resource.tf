@@ -0,0 +1,12 @@
+resource "couchbase-capella_ai_provider" "new_ai_provider" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  name            = "product-docs"
+  type            = "awsS3"
+  aws_region      = "us-east-1"
+  bucket          = "product-docs"
+  folder_path     = "manuals/"
+
+  access_key_id_wo       = var.aws_access_key_id
+  secret_access_key_wo   = var.aws_secret_access_key
+  credentials_wo_version = 1
+}
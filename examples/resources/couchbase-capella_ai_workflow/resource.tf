@@ -0,0 +1,30 @@
+resource "couchbase-capella_ai_workflow" "new_ai_workflow" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  name            = "docs-vectorization"
+
+  target_couchbase_keyspace = {
+    bucket     = "docs"
+    scope      = "_default"
+    collection = "_default"
+  }
+
+  vectorization_config = {
+    embedding_model = {
+      capella_hosted_model = {
+        id            = "ffffffff-aaaa-1414-eeee-000000000000"
+        model_name    = "nvidia/nv-embedqa-e5-v5"
+        api_key_id    = "ffffffff-aaaa-1414-eeee-000000000000"
+        api_key_token = "<model_api_key_token>"
+      }
+    }
+    embedding_field_mappings = {
+      text_vector = {
+        source_fields = ["title", "body"]
+      }
+    }
+  }
+
+  run_trigger = "2026-10-18"
+}
@@ -0,0 +1 @@
+terraform import couchbase-capella_alert_integration.new_alert_integration id=ffffffff-aaaa-1414-eeee-000000000000,project_id=ffffffff-aaaa-1414-eeee-000000000000,organization_id=ffffffff-aaaa-1414-eeee-000000000000
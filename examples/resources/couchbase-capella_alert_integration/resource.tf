@@ -0,0 +1,16 @@
+resource "couchbase-capella_alert_integration" "new_alert_integration" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  name            = "pagerduty"
+  test_on_create  = true
+
+  config = {
+    webhook = {
+      url    = "https://events.pagerduty.com/integration/<integration_key>/enqueue"
+      method = "POST"
+      exclude = {
+        clusters = ["ffffffff-aaaa-1414-eeee-000000000000"]
+      }
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import couchbase-capella_analytics_allowlist.new_analytics_allowlist id=<allowlist_id>,analytics_cluster_id=<analytics_cluster_id>,project_id=<project_id>,organization_id=<organization_id>
@@ -0,0 +1,8 @@
+resource "couchbase-capella_analytics_allowlist" "new_analytics_allowlist" {
+  organization_id      = "<organization_id>"
+  project_id           = "<project_id>"
+  analytics_cluster_id = "<analytics_cluster_id>"
+  cidr                 = "10.0.0.0/16"
+  comment              = "Allow access from the application subnet"
+  expires_at           = "2030-12-30T23:59:59.465Z"
+}
@@ -0,0 +1 @@
+terraform import couchbase-capella_analytics_backup.new_analytics_backup id=<backup_id>,analytics_cluster_id=<analytics_cluster_id>,project_id=<project_id>,organization_id=<organization_id>
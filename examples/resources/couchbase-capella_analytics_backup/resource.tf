@@ -0,0 +1,6 @@
+resource "couchbase-capella_analytics_backup" "new_analytics_backup" {
+  organization_id      = "<organization_id>"
+  project_id           = "<project_id>"
+  analytics_cluster_id = "<analytics_cluster_id>"
+  retention            = 24
+}
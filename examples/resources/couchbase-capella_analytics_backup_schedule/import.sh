@@ -0,0 +1 @@
+terraform import couchbase-capella_analytics_backup_schedule.new_analytics_backup_schedule analytics_cluster_id=<analytics_cluster_id>,project_id=<project_id>,organization_id=<organization_id>
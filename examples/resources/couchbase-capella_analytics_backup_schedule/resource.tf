@@ -0,0 +1,8 @@
+resource "couchbase-capella_analytics_backup_schedule" "new_analytics_backup_schedule" {
+  organization_id      = "<organization_id>"
+  project_id           = "<project_id>"
+  analytics_cluster_id = "<analytics_cluster_id>"
+  interval             = 6
+  retention            = 168
+  start_time           = "2030-01-01T00:00:00Z"
+}
@@ -0,0 +1 @@
+terraform import couchbase-capella_analytics_cluster.new_analytics_cluster id=<analytics_cluster_id>,project_id=<project_id>,organization_id=<organization_id>
@@ -0,0 +1,20 @@
+resource "couchbase-capella_analytics_cluster" "new_analytics_cluster" {
+  organization_id = "<organization_id>"
+  project_id      = "<project_id>"
+  name            = "Terraform Analytics Cluster"
+  description     = "Analytics cluster created with Terraform"
+  cloud_provider  = "aws"
+  region          = "us-east-1"
+  nodes           = 2
+  compute = {
+    cpu = 4
+    ram = 32
+  }
+  availability = {
+    type = "single"
+  }
+  support = {
+    plan     = "developer pro"
+    timezone = "PT"
+  }
+}
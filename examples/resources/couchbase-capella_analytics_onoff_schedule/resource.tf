@@ -0,0 +1,43 @@
+resource "couchbase-capella_analytics_onoff_schedule" "new_analytics_onoff_schedule" {
+  organization_id      = "<organization_id>"
+  project_id           = "<project_id>"
+  analytics_cluster_id = "<analytics_cluster_id>"
+  timezone             = "US/Pacific"
+  days = [
+    {
+      day   = "monday"
+      state = "custom"
+      from = {
+        hour   = 8
+        minute = 30
+      }
+      to = {
+        hour = 18
+      }
+    },
+    {
+      day   = "tuesday"
+      state = "on"
+    },
+    {
+      day   = "wednesday"
+      state = "on"
+    },
+    {
+      day   = "thursday"
+      state = "on"
+    },
+    {
+      day   = "friday"
+      state = "on"
+    },
+    {
+      day   = "saturday"
+      state = "off"
+    },
+    {
+      day   = "sunday"
+      state = "off"
+    }
+  ]
+}
@@ -0,0 +1 @@
+terraform import couchbase-capella_analytics_private_endpoint_service.new_service analytics_cluster_id=ffffffff-aaaa-1414-eeee-000000000000,project_id=ffffffff-aaaa-1414-eeee-000000000000,organization_id=ffffffff-aaaa-1414-eeee-000000000000
@@ -0,0 +1,6 @@
+resource "couchbase-capella_analytics_private_endpoint_service" "new_service" {
+  organization_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id           = "ffffffff-aaaa-1414-eeee-000000000000"
+  analytics_cluster_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  enabled              = true
+}
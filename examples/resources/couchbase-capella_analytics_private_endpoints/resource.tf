@@ -0,0 +1,6 @@
+resource "couchbase-capella_analytics_private_endpoints" "accept_endpoint" {
+  organization_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id           = "ffffffff-aaaa-1414-eeee-000000000000"
+  analytics_cluster_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  endpoint_id          = "vpce-7"
+}
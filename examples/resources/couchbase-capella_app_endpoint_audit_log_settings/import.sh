@@ -0,0 +1 @@
+terraform import couchbase-capella_app_endpoint_audit_log_settings.app_endpoint_audit app_endpoint_name=my-endpoint,app_service_id=ffffffff-aaaa-1414-eeee-000000000000,organization_id=ffffffff-aaaa-1414-eeee-000000000000,project_id=ffffffff-aaaa-1414-eeee-000000000000,cluster_id=ffffffff-aaaa-1414-eeee-000000000000
@@ -0,0 +1,17 @@
+resource "couchbase-capella_app_endpoint_audit_log_settings" "app_endpoint_audit" {
+  organization_id   = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id        = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id        = "ffffffff-aaaa-1414-eeee-000000000000"
+  app_service_id    = "ffffffff-aaaa-1414-eeee-000000000000"
+  app_endpoint_name = "my-endpoint"
+  audit_enabled     = true
+  enabled_event_ids = [53280, 53281]
+
+  disabled_users = [
+    {
+      domain = "sgw"
+      name   = "service-account"
+    }
+  ]
+  disabled_roles = []
+}
@@ -1 +1,4 @@
-terraform import couchbase-capella_app_service.new_app_service id=<appservice_id>,cluster_id=<cluster_id>,project_id=<project_id>,organization_id=<organization_id>
\ No newline at end of file
+terraform import couchbase-capella_app_service.new_app_service id=<appservice_id>,cluster_id=<cluster_id>,project_id=<project_id>,organization_id=<organization_id>
+
+# Names can be given instead of IDs. The import fails if a name matches more than one app service.
+terraform import couchbase-capella_app_service.new_app_service app_service_name=<app_service_name>,cluster_name=<cluster_name>,project_name=<project_name>,organization_id=<organization_id>
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "couchbase-capella_app_service_admin_user" "new_admin_user" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  app_service_id  = "ffffffff-aaaa-1414-eeee-000000000000"
+  name            = "endpoint-admin"
+  password        = "<admin_user_password>"
+  endpoints       = ["my-app-endpoint"]
+}
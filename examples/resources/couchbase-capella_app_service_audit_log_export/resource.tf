@@ -0,0 +1,8 @@
+resource "couchbase-capella_app_service_audit_log_export" "export" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  app_service_id  = "ffffffff-aaaa-1414-eeee-000000000000"
+  start           = "2026-10-01T00:00:00Z"
+  end             = "2026-10-01T06:00:00Z"
+}
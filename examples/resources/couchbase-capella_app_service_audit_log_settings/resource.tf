@@ -0,0 +1,7 @@
+resource "couchbase-capella_app_service_audit_log_settings" "app_service_audit" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  app_service_id  = "ffffffff-aaaa-1414-eeee-000000000000"
+  audit_enabled   = true
+}
@@ -0,0 +1,16 @@
+resource "couchbase-capella_app_service_audit_log_streaming" "splunk" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  app_service_id  = "ffffffff-aaaa-1414-eeee-000000000000"
+
+  output_type = "splunk"
+  credentials = {
+    splunk = {
+      url          = "https://splunk.example.com:8088"
+      splunk_token = "<splunk_token>"
+    }
+  }
+
+  disabled_app_endpoints = ["my-other-endpoint"]
+}
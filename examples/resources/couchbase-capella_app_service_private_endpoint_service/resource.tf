@@ -0,0 +1,7 @@
+resource "couchbase-capella_app_service_private_endpoint_service" "new_service" {
+  organization_id = "<organization_id>"
+  project_id      = "<project_id>"
+  cluster_id      = "<cluster_id>"
+  app_service_id  = "<app_service_id>"
+  enabled         = true
+}
@@ -0,0 +1 @@
+terraform import couchbase-capella_app_service_private_endpoints.accept_endpoint endpoint_id=vpce-7,organization_id=ffffffff-aaaa-1414-eeee-000000000000,project_id=ffffffff-aaaa-1414-eeee-000000000000,cluster_id=ffffffff-aaaa-1414-eeee-000000000000,app_service_id=ffffffff-aaaa-1414-eeee-000000000000
@@ -0,0 +1 @@
+terraform import couchbase-capella_backup_cycle_retention.new_retention bucket_id=YjE=,organization_id=ffffffff-aaaa-1414-eeee-000000000000,project_id=ffffffff-aaaa-1414-eeee-000000000000,cluster_id=ffffffff-aaaa-1414-eeee-000000000000
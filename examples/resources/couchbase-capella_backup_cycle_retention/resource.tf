@@ -0,0 +1,7 @@
+resource "couchbase-capella_backup_cycle_retention" "new_retention" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  bucket_id       = "YjE="
+  max_age_days    = 30
+}
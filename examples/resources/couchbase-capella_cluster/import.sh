@@ -1 +1,4 @@
-terraform import couchbase-capella_cluster.new_cluster id=test_id,cluster_id=test_id,project_id=test_id,organization_id=test_id
\ No newline at end of file
+terraform import couchbase-capella_cluster.new_cluster id=test_id,cluster_id=test_id,project_id=test_id,organization_id=test_id
+
+# Names can be given instead of IDs. The import fails if a name matches more than one cluster.
+terraform import couchbase-capella_cluster.new_cluster cluster_name=<cluster_name>,project_name=<project_name>,organization_id=<organization_id>
\ No newline at end of file
@@ -35,4 +35,9 @@ resource "couchbase-capella_cluster" "new_cluster" {
     plan     = "enterprise"
     timezone = "PT"
   }
+
+  timeouts {
+    create = "90m"
+    update = "90m"
+  }
 }
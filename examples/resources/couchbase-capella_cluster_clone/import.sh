@@ -0,0 +1 @@
+terraform import couchbase-capella_cluster_clone.staging id=ffffffff-aaaa-1414-eeee-000000000000,organization_id=ffffffff-aaaa-1414-eeee-000000000000,project_id=ffffffff-aaaa-1414-eeee-000000000000,source_snapshot_id=ffffffff-aaaa-1414-eeee-000000000000
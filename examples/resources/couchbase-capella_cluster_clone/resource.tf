@@ -0,0 +1,20 @@
+resource "couchbase-capella_cluster_clone" "staging" {
+  organization_id    = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id         = "ffffffff-aaaa-1414-eeee-000000000000"
+  source_snapshot_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  name               = "staging"
+
+  cloud_provider = {
+    type   = "aws"
+    region = "us-east-1"
+  }
+
+  availability = {
+    type = "single"
+  }
+
+  support = {
+    plan     = "developer pro"
+    timezone = "PT"
+  }
+}
@@ -0,0 +1,11 @@
+resource "couchbase-capella_cmek" "new_cmek" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  name            = "production-key"
+  description     = "Key used to encrypt production clusters"
+
+  config = {
+    aws_config = {
+      arn = "arn:aws:kms:us-east-1:123456789012:key/ffffffff-aaaa-1414-eeee-000000000000"
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import couchbase-capella_cmek_cluster_association.new_association cluster_id=ffffffff-aaaa-1414-eeee-000000000000,cmek_id=ffffffff-aaaa-1414-eeee-000000000000,project_id=ffffffff-aaaa-1414-eeee-000000000000,organization_id=ffffffff-aaaa-1414-eeee-000000000000
@@ -0,0 +1,6 @@
+resource "couchbase-capella_cmek_cluster_association" "new_association" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cmek_id         = "ffffffff-aaaa-1414-eeee-000000000000"
+}
@@ -0,0 +1,6 @@
+resource "couchbase-capella_data_api_private_endpoint" "associate_endpoint" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  endpoint_id     = "vpce-7"
+}
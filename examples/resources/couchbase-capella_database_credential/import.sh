@@ -1 +1,4 @@
-terraform import couchbase-capella_database_credential.new_database_credential id=<database_credential_id>,cluster_id=<cluster_id>,project_id=<project_id>,organization_id=<organization_id>
\ No newline at end of file
+terraform import couchbase-capella_database_credential.new_database_credential id=<database_credential_id>,cluster_id=<cluster_id>,project_id=<project_id>,organization_id=<organization_id>
+
+# Names can be given instead of IDs. The import fails if a name matches more than one database credential.
+terraform import couchbase-capella_database_credential.new_database_credential database_credential_name=<database_credential_name>,cluster_name=<cluster_name>,project_name=<project_name>,organization_id=<organization_id>
\ No newline at end of file
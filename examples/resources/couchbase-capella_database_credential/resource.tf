@@ -13,3 +13,21 @@ resource "couchbase-capella_database_credential" "new_database_credential" {
     }
   ]
 }
+
+# The password can also be passed as a write-only value, so it is never stored in state.
+# Requires Terraform 1.11 or later. Change password_wo_version to send a new password.
+resource "couchbase-capella_database_credential" "write_only_password" {
+  name                = "ReadOnlyWithWriteOnlyPassword"
+  organization_id     = "<organization_id>"
+  project_id          = "<project_id>"
+  cluster_id          = "<cluster_id>"
+  password_wo         = var.database_credential_password
+  password_wo_version = 1
+  access = [
+    {
+      "privileges" : [
+        "data_reader"
+      ]
+    }
+  ]
+}
@@ -0,0 +1,7 @@
+resource "couchbase-capella_mtls_certificate" "new_mtls_certificate" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  name            = "client-ca"
+  certificate     = file("${path.module}/client-ca.pem")
+}
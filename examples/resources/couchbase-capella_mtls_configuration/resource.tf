@@ -0,0 +1,17 @@
+resource "couchbase-capella_mtls_configuration" "mtls_configuration" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  state           = "hybrid"
+
+  prefixes = [
+    {
+      path      = "san.email"
+      delimiter = "@"
+    },
+    {
+      path   = "subject.cn"
+      prefix = "app-"
+    }
+  ]
+}
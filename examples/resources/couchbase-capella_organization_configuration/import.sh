@@ -0,0 +1 @@
+terraform import couchbase-capella_organization_configuration.new_configuration ffffffff-aaaa-1414-eeee-000000000000
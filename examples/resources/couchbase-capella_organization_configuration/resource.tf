@@ -0,0 +1,4 @@
+resource "couchbase-capella_organization_configuration" "new_configuration" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  subdomain       = "acme"
+}
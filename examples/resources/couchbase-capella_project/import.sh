@@ -1 +1,4 @@
-terraform import couchbase-capella_project.new_project id=<project_id>,organization_id=<organization_id>
\ No newline at end of file
+terraform import couchbase-capella_project.new_project id=<project_id>,organization_id=<organization_id>
+
+# Names can be given instead of IDs. The import fails if a name matches more than one project.
+terraform import couchbase-capella_project.new_project project_name=<project_name>,organization_id=<organization_id>
\ No newline at end of file
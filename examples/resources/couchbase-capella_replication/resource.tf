@@ -0,0 +1,38 @@
+resource "couchbase-capella_replication" "new_replication" {
+  organization_id = "ffffffff-aaaa-1414-eeee-000000000000"
+  project_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  cluster_id      = "ffffffff-aaaa-1414-eeee-000000000000"
+  source_bucket   = "dHJhdmVsLXNhbXBsZQ=="
+  direction       = "oneWay"
+  priority        = "medium"
+
+  target = {
+    cluster = "ffffffff-aaaa-1414-eeee-000000000000"
+    bucket  = "dHJhdmVsLXNhbXBsZQ=="
+  }
+
+  mappings = [
+    {
+      source_scope = "inventory"
+      target_scope = "inventory"
+      collections = [
+        {
+          source_collection = "airline"
+          target_collection = "airline"
+        }
+      ]
+    }
+  ]
+
+  filter = {
+    document_exclude_options = {
+      deletion   = false
+      expiration = true
+      ttl        = false
+      binary     = true
+    }
+    expressions = {
+      regex = "^airline_.*"
+    }
+  }
+}
@@ -1 +1,4 @@
-terraform import couchbase-capella_user.new_user id=<user_id>,organization_id=<organization_id>
\ No newline at end of file
+terraform import couchbase-capella_user.new_user id=<user_id>,organization_id=<organization_id>
+
+# Names can be given instead of IDs. The import fails if a name matches more than one user.
+terraform import couchbase-capella_user.new_user user_email=<user_email>,organization_id=<organization_id>
\ No newline at end of file
@@ -57,6 +57,9 @@ type EndpointCfg struct {
 // defaultWaitAttempt re-attempt http request after 2 seconds.
 const defaultWaitAttempt = time.Second * 2
 
+// defaultRequestTimeout caps the retries of a request whose context has no deadline.
+const defaultRequestTimeout = time.Minute * 10
+
 // ExecuteWithRetry is used to construct and execute a HTTP request with retry.
 // It then returns the response.
 func (c *Client) ExecuteWithRetry(
@@ -184,11 +187,13 @@ func exec(
 		response *Response
 	)
 
-	const timeout = time.Minute * 10
-
-	var cancel context.CancelFunc
-	ctx, cancel = context.WithTimeout(ctx, timeout)
-	defer cancel()
+	// Resources with a timeouts block bound the whole operation through ctx, so a
+	// request only gets the default cap when the caller has not set a deadline.
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultRequestTimeout)
+		defer cancel()
+	}
 
 	for {
 		select {
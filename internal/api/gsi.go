@@ -90,8 +90,13 @@ func WatchIndexes(
 		timeout     = time.Minute * 60 // 60 min is arbitrary
 	)
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
+	// resources bound the watch by their timeouts block, so the default
+	// only applies when the caller has not set a deadline.
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
 
 	const maxConsecutive404s = 3
 
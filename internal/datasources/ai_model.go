@@ -0,0 +1,117 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &AiModel{}
+	_ datasource.DataSourceWithConfigure = &AiModel{}
+)
+
+// AiModel is the data source implementation.
+type AiModel struct {
+	*providerschema.Data
+}
+
+// NewAiModel is a helper function to simplify the provider implementation.
+func NewAiModel() datasource.DataSource {
+	return &AiModel{}
+}
+
+// Metadata returns the AI model data source type name.
+func (a *AiModel) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_model"
+}
+
+// Schema defines the schema for the AI model data source.
+func (a *AiModel) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AiModelSchema()
+}
+
+// Read refreshes the Terraform state with the model and its connection string.
+func (a *AiModel) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AiModelData
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = state.OrganizationId.ValueString()
+		modelId        = state.Id.ValueString()
+	)
+
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "id", Value: modelId},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	modelResp, err := a.ClientV2.GetModelWithResponse(ctx, uuids[0], uuids[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella AI Model",
+			"Could not read AI model "+modelId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if modelResp.StatusCode() != http.StatusOK || modelResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella AI Model",
+			fmt.Sprintf("Could not read AI model %s, unexpected response status %d: %s", modelId, modelResp.StatusCode(), string(modelResp.Body)),
+		)
+		return
+	}
+
+	connResp, err := a.ClientV2.GetConnectionStringWithResponse(ctx, uuids[0], uuids[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella AI Model",
+			"Could not read connection string of AI model "+modelId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if connResp.StatusCode() != http.StatusOK || connResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella AI Model",
+			fmt.Sprintf("Could not read connection string of AI model %s, unexpected response status %d: %s", modelId, connResp.StatusCode(), string(connResp.Body)),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, providerschema.NewAiModelData(*modelResp.JSON200, organizationId, modelId, connResp.JSON200.ConnectionString))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the AI model data source.
+func (a *AiModel) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
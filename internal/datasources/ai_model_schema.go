@@ -0,0 +1,27 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var aiModelBuilder = capellaschema.NewSchemaBuilder("aiModel", "GetLanguageModelResponse")
+
+// AiModelSchema returns the schema for the AiModel data source.
+func AiModelSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", aiModelBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "id", aiModelBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "name", aiModelBuilder, computedString())
+	capellaschema.AddAttr(attrs, "catalog_model_name", aiModelBuilder, computedString(), "Config")
+	capellaschema.AddAttr(attrs, "status", aiModelBuilder, computedString())
+	capellaschema.AddAttr(attrs, "connection_string", aiModelBuilder, computedString(), "GetConnectionStringResponse")
+
+	return schema.Schema{
+		MarkdownDescription: "The AI model data source retrieves a model hosted in Capella AI Services, " +
+			"including the connection string used to call it.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,92 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var (
+	_ datasource.DataSource              = &AiProviders{}
+	_ datasource.DataSourceWithConfigure = &AiProviders{}
+)
+
+// AiProviders is the data source implementation.
+type AiProviders struct {
+	*providerschema.Data
+}
+
+// NewAiProviders is a helper function to simplify the provider implementation.
+func NewAiProviders() datasource.DataSource {
+	return &AiProviders{}
+}
+
+// Metadata returns the AI providers data source type name.
+func (a *AiProviders) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_providers"
+}
+
+// Schema defines the schema for the AI providers data source.
+func (a *AiProviders) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AiProvidersSchema()
+}
+
+// Read refreshes the Terraform state with the providers registered in the organization.
+func (a *AiProviders) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AiProviders
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := state.OrganizationId.ValueString()
+
+	endpoint := fmt.Sprintf("%s/v4/organizations/%s/aiServices/providers", a.HostURL, organizationId)
+	if !state.ProviderType.IsNull() {
+		endpoint += "?providerType=" + url.QueryEscape(state.ProviderType.ValueString())
+	}
+	cfg := api.EndpointCfg{Url: endpoint, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	providers, err := api.GetPaginated[[]providerschema.AiProviderResponse](ctx, a.ClientV1, a.Token, cfg, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella AI Providers",
+			fmt.Sprintf("Could not read AI providers in organization %s, unexpected error: %s", organizationId, api.ParseError(err)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.AiProviderData, 0, len(providers))
+	for _, provider := range providers {
+		state.Data = append(state.Data, providerschema.NewAiProviderData(provider))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the AI providers data source.
+func (a *AiProviders) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
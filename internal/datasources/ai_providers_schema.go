@@ -0,0 +1,46 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var aiProvidersBuilder = capellaschema.NewSchemaBuilder("aiProviders", "GetProviderResponse")
+
+// AiProvidersSchema returns the schema for the AiProviders data source.
+func AiProvidersSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", aiProvidersBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "provider_type", aiProvidersBuilder, &schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("awsS3", "openAI", "awsBedrock"),
+		},
+	})
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", aiProvidersBuilder, computedString(), "CreateProviderResponse")
+	capellaschema.AddAttr(dataAttrs, "name", aiProvidersBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "type", aiProvidersBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "aws_region", aiProvidersBuilder, computedString(), "GetS3ConfigurationResponse")
+	capellaschema.AddAttr(dataAttrs, "bucket", aiProvidersBuilder, computedString(), "GetS3ConfigurationResponse")
+	capellaschema.AddAttr(dataAttrs, "folder_path", aiProvidersBuilder, computedString(), "GetS3ConfigurationResponse")
+	capellaschema.AddAttr(dataAttrs, "audit", aiProvidersBuilder, computedAudit())
+
+	capellaschema.AddAttr(attrs, "data", aiProvidersBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The AI providers data source retrieves the external providers registered with Capella AI Services in an organization. " +
+			"Provider credentials are never returned.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,99 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AiWorkflowProcessedFiles{}
+	_ datasource.DataSourceWithConfigure = &AiWorkflowProcessedFiles{}
+)
+
+// AiWorkflowProcessedFiles is the AI workflow processed files data source implementation.
+type AiWorkflowProcessedFiles struct {
+	*providerschema.Data
+}
+
+// NewAiWorkflowProcessedFiles is a helper function to simplify the provider implementation.
+func NewAiWorkflowProcessedFiles() datasource.DataSource {
+	return &AiWorkflowProcessedFiles{}
+}
+
+// Metadata returns the AI workflow processed files data source type name.
+func (a *AiWorkflowProcessedFiles) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_workflow_processed_files"
+}
+
+// Schema defines the schema for the AI workflow processed files data source.
+func (a *AiWorkflowProcessedFiles) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AiWorkflowProcessedFilesSchema()
+}
+
+// Read refreshes the Terraform state with the files processed by the workflow run.
+func (a *AiWorkflowProcessedFiles) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AiWorkflowProcessedFiles
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = state.OrganizationId.ValueString()
+		projectId      = state.ProjectId.ValueString()
+		clusterId      = state.ClusterId.ValueString()
+		workflowId     = state.WorkflowId.ValueString()
+		runId          = state.RunId.ValueString()
+	)
+
+	endpoint := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters/%s/aiServices/workflows/%s/runs/%s/processedFiles", a.HostURL, organizationId, projectId, clusterId, workflowId, runId)
+	if !state.FileStatus.IsNull() {
+		endpoint += "?fileStatus=" + url.QueryEscape(state.FileStatus.ValueString())
+	}
+	cfg := api.EndpointCfg{Url: endpoint, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	files, err := api.GetPaginated[[]providerschema.AiWorkflowProcessedFileResponse](ctx, a.ClientV1, a.Token, cfg, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella AI Workflow Processed Files",
+			fmt.Sprintf("Could not read files processed by run %s of AI workflow %s, unexpected error: %s", runId, workflowId, api.ParseError(err)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.AiWorkflowProcessedFile, 0, len(files))
+	for _, file := range files {
+		state.Data = append(state.Data, providerschema.NewAiWorkflowProcessedFile(file))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the AI workflow processed files data source.
+func (a *AiWorkflowProcessedFiles) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
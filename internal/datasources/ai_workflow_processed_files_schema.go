@@ -0,0 +1,55 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var aiWorkflowProcessedFilesBuilder = capellaschema.NewSchemaBuilder("aiWorkflowProcessedFiles", "GetWorkflowRunProcessedFilesResponse")
+
+func AiWorkflowProcessedFilesSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", aiWorkflowProcessedFilesBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", aiWorkflowProcessedFilesBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "cluster_id", aiWorkflowProcessedFilesBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "workflow_id", aiWorkflowProcessedFilesBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "run_id", aiWorkflowProcessedFilesBuilder, requiredUUIDString())
+
+	// file_status is the fileStatus query parameter of the processed files endpoint.
+	fileStatusAttr := optionalString()
+	fileStatusAttr.Validators = []validator.String{stringvalidator.OneOf(
+		string(apigen.GetAiWorkflowRunProcessedFilesParamsFileStatusFailed),
+		string(apigen.GetAiWorkflowRunProcessedFilesParamsFileStatusSuccess),
+	)}
+	fileStatusAttr.MarkdownDescription = "Only return the files with this status, either `success` or `failed`. By default, all files are returned."
+	capellaschema.AddAttr(attrs, "file_status", aiWorkflowProcessedFilesBuilder, fileStatusAttr)
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "file_name", aiWorkflowProcessedFilesBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "file_path", aiWorkflowProcessedFilesBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "file_status", aiWorkflowProcessedFilesBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "s3_url", aiWorkflowProcessedFilesBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "file_metadata", aiWorkflowProcessedFilesBuilder, &schema.MapAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+	})
+
+	capellaschema.AddAttr(attrs, "data", aiWorkflowProcessedFilesBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The AI workflow processed files data source retrieves the files processed by a run of a workflow " +
+			"in Capella AI Services, which can be filtered to the files that failed.",
+		Attributes: attrs,
+	}
+}
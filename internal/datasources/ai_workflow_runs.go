@@ -0,0 +1,95 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AiWorkflowRuns{}
+	_ datasource.DataSourceWithConfigure = &AiWorkflowRuns{}
+)
+
+// AiWorkflowRuns is the AI workflow runs data source implementation.
+type AiWorkflowRuns struct {
+	*providerschema.Data
+}
+
+// NewAiWorkflowRuns is a helper function to simplify the provider implementation.
+func NewAiWorkflowRuns() datasource.DataSource {
+	return &AiWorkflowRuns{}
+}
+
+// Metadata returns the AI workflow runs data source type name.
+func (a *AiWorkflowRuns) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_workflow_runs"
+}
+
+// Schema defines the schema for the AI workflow runs data source.
+func (a *AiWorkflowRuns) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AiWorkflowRunsSchema()
+}
+
+// Read refreshes the Terraform state with the latest runs of the workflow.
+func (a *AiWorkflowRuns) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AiWorkflowRuns
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = state.OrganizationId.ValueString()
+		projectId      = state.ProjectId.ValueString()
+		clusterId      = state.ClusterId.ValueString()
+		workflowId     = state.WorkflowId.ValueString()
+	)
+
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters/%s/aiServices/workflows/%s/runs", a.HostURL, organizationId, projectId, clusterId, workflowId)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	runs, err := api.GetPaginated[[]apigen.GetWorkflowRunResponse](ctx, a.ClientV1, a.Token, cfg, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella AI Workflow Runs",
+			fmt.Sprintf("Could not read runs of AI workflow %s, unexpected error: %s", workflowId, api.ParseError(err)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.AiWorkflowRun, 0, len(runs))
+	for _, run := range runs {
+		state.Data = append(state.Data, providerschema.NewAiWorkflowRun(run))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the AI workflow runs data source.
+func (a *AiWorkflowRuns) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
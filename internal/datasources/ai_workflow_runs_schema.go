@@ -0,0 +1,40 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var aiWorkflowRunsBuilder = capellaschema.NewSchemaBuilder("aiWorkflowRuns", "GetWorkflowRunResponse")
+
+func AiWorkflowRunsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", aiWorkflowRunsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", aiWorkflowRunsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "cluster_id", aiWorkflowRunsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "workflow_id", aiWorkflowRunsBuilder, requiredUUIDString())
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", aiWorkflowRunsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "status", aiWorkflowRunsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "created_at", aiWorkflowRunsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "created_by_user_id", aiWorkflowRunsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "total_files", aiWorkflowRunsBuilder, computedInt64())
+	capellaschema.AddAttr(dataAttrs, "processed_files", aiWorkflowRunsBuilder, computedInt64())
+	capellaschema.AddAttr(dataAttrs, "errored_files", aiWorkflowRunsBuilder, computedInt64())
+
+	capellaschema.AddAttr(attrs, "data", aiWorkflowRunsBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The AI workflow runs data source retrieves the runs of a workflow in Capella AI Services, " +
+			"including how many files each run processed.",
+		Attributes: attrs,
+	}
+}
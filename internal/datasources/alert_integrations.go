@@ -0,0 +1,109 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AlertIntegrations{}
+	_ datasource.DataSourceWithConfigure = &AlertIntegrations{}
+)
+
+// AlertIntegrations is the alert integrations data source implementation.
+type AlertIntegrations struct {
+	*providerschema.Data
+}
+
+// NewAlertIntegrations is a helper function to simplify the provider implementation.
+func NewAlertIntegrations() datasource.DataSource {
+	return &AlertIntegrations{}
+}
+
+// Metadata returns the alert integrations data source type name.
+func (a *AlertIntegrations) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_integrations"
+}
+
+// Schema defines the schema for the alert integrations data source.
+func (a *AlertIntegrations) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AlertIntegrationsSchema()
+}
+
+// Read refreshes the Terraform state with the latest data of alert integrations.
+func (a *AlertIntegrations) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AlertIntegrations
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId, projectId, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Integrations in Capella",
+			"Could not read Capella alert integrations in project "+projectId+": "+err.Error(),
+		)
+		return
+	}
+
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/alertIntegrations", a.HostURL, organizationId, projectId)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	response, err := api.GetPaginated[[]apigen.GetAlertResponse](ctx, a.ClientV1, a.Token, cfg, api.SortById)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Integrations",
+			fmt.Sprintf("Could not read alert integrations in organization %s and project %s, unexpected error: %s", organizationId, projectId, api.ParseError(err)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.AlertIntegrationData, 0, len(response))
+	for _, alert := range response {
+		audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(alert.Audit))
+		auditObj, diags := types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+		if diags.HasError() {
+			resp.Diagnostics.AddError(
+				"Error Reading Alert Integrations",
+				fmt.Sprintf("Could not read alert integrations in organization %s and project %s, unexpected error: %s", organizationId, projectId, errors.ErrUnableToConvertAuditData),
+			)
+			return
+		}
+
+		state.Data = append(state.Data, providerschema.NewAlertIntegrationData(alert, auditObj))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the alert integrations data source.
+func (a *AlertIntegrations) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
@@ -0,0 +1,69 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var alertIntegrationsBuilder = capellaschema.NewSchemaBuilder("alertIntegrations", "GetAlertResponse")
+
+func AlertIntegrationsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", alertIntegrationsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", alertIntegrationsBuilder, requiredUUIDString())
+
+	excludeAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(excludeAttrs, "clusters", alertIntegrationsBuilder, computedStringSet(), "Exclude")
+	capellaschema.AddAttr(excludeAttrs, "app_services", alertIntegrationsBuilder, computedStringSet(), "Exclude")
+
+	webhookAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(webhookAttrs, "url", alertIntegrationsBuilder, &schema.StringAttribute{
+		Computed:  true,
+		Sensitive: true,
+	}, "ResponseWebhook")
+	capellaschema.AddAttr(webhookAttrs, "method", alertIntegrationsBuilder, computedString(), "ResponseWebhook")
+	capellaschema.AddAttr(webhookAttrs, "headers", alertIntegrationsBuilder, &schema.MapAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Sensitive:   true,
+	}, "ResponseWebhook")
+	capellaschema.AddAttr(webhookAttrs, "exclude", alertIntegrationsBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: excludeAttrs,
+	}, "ResponseWebhook")
+
+	configAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(configAttrs, "webhook", alertIntegrationsBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: webhookAttrs,
+	}, "ResponseConfig")
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", alertIntegrationsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "name", alertIntegrationsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "kind", alertIntegrationsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "config_key", alertIntegrationsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "status", alertIntegrationsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "enabled", alertIntegrationsBuilder, computedBool())
+	capellaschema.AddAttr(dataAttrs, "config", alertIntegrationsBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: configAttrs,
+	})
+	capellaschema.AddAttr(dataAttrs, "audit", alertIntegrationsBuilder, computedAudit())
+
+	capellaschema.AddAttr(attrs, "data", alertIntegrationsBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The alert integrations data source retrieves the alert integrations of a project. " +
+			"Webhook tokens and basic auth credentials are not returned by Capella.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,105 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AnalyticsBackups{}
+	_ datasource.DataSourceWithConfigure = &AnalyticsBackups{}
+)
+
+// AnalyticsBackups is the analytics backups data source implementation.
+type AnalyticsBackups struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsBackups is a helper function to simplify the provider implementation.
+func NewAnalyticsBackups() datasource.DataSource {
+	return &AnalyticsBackups{}
+}
+
+// Metadata returns the analytics backups data source type name.
+func (a *AnalyticsBackups) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_backups"
+}
+
+// Schema defines the schema for the analytics backups data source.
+func (a *AnalyticsBackups) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AnalyticsBackupsSchema()
+}
+
+// Read refreshes the Terraform state with the latest backups of the analytics cluster.
+func (a *AnalyticsBackups) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AnalyticsBackups
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId     = state.OrganizationId.ValueString()
+		projectId          = state.ProjectId.ValueString()
+		analyticsClusterId = state.AnalyticsClusterId.ValueString()
+	)
+
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/analyticsClusters/%s/cloudSnapshotBackups", a.HostURL, organizationId, projectId, analyticsClusterId)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	backups, err := api.GetPaginated[[]apigen.GetColumnarAnalyticsBackupResponse](ctx, a.ClientV1, a.Token, cfg, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Analytics Backups",
+			fmt.Sprintf("Could not read backups of analytics cluster %s, unexpected error: %s", analyticsClusterId, api.ParseError(err)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.AnalyticsBackupData, 0, len(backups))
+	for _, backup := range backups {
+		progress := providerschema.NewAnalyticsBackupProgress(backup.Progress)
+		progressObj, diags := types.ObjectValueFrom(ctx, progress.AttributeTypes(), progress)
+		if diags.HasError() {
+			resp.Diagnostics.AddError(
+				"Error Reading Capella Analytics Backups",
+				fmt.Sprintf("Could not read backups of analytics cluster %s, unexpected error: error during progress conversion", analyticsClusterId),
+			)
+			return
+		}
+
+		state.Data = append(state.Data, providerschema.NewAnalyticsBackupData(backup, progressObj))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the analytics backups data source.
+func (a *AnalyticsBackups) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
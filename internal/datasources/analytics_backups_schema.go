@@ -0,0 +1,46 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsBackupsBuilder = capellaschema.NewSchemaBuilder("analyticsBackups", "GetColumnarAnalyticsBackupResponse")
+
+func AnalyticsBackupsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", analyticsBackupsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", analyticsBackupsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsBackupsBuilder, requiredUUIDString())
+
+	progressAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(progressAttrs, "status", analyticsBackupsBuilder, computedString(), "ColumnarAnalyticsBackupProgress")
+	capellaschema.AddAttr(progressAttrs, "time", analyticsBackupsBuilder, computedString(), "ColumnarAnalyticsBackupProgress")
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", analyticsBackupsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "retention", analyticsBackupsBuilder, computedInt64())
+	capellaschema.AddAttr(dataAttrs, "created_at", analyticsBackupsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "expiration", analyticsBackupsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "database_size", analyticsBackupsBuilder, computedInt64())
+	capellaschema.AddAttr(dataAttrs, "region", analyticsBackupsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "type", analyticsBackupsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "progress", analyticsBackupsBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: progressAttrs,
+	})
+
+	capellaschema.AddAttr(attrs, "data", analyticsBackupsBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to retrieve the backups of a Capella Columnar analytics cluster.",
+		Attributes:          attrs,
+	}
+}
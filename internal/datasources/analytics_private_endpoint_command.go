@@ -0,0 +1,106 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &AnalyticsPrivateEndpointCommand{}
+	_ datasource.DataSourceWithConfigure = &AnalyticsPrivateEndpointCommand{}
+)
+
+// AnalyticsPrivateEndpointCommand is the data source implementation.
+type AnalyticsPrivateEndpointCommand struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsPrivateEndpointCommand is a helper function to simplify the provider implementation.
+func NewAnalyticsPrivateEndpointCommand() datasource.DataSource {
+	return &AnalyticsPrivateEndpointCommand{}
+}
+
+// Metadata returns the data source type name.
+func (a *AnalyticsPrivateEndpointCommand) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_private_endpoint_command"
+}
+
+// Schema defines the schema for the analytics private endpoint command data source.
+func (a *AnalyticsPrivateEndpointCommand) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AnalyticsPrivateEndpointCommandSchema()
+}
+
+// Read refreshes the Terraform state with the command to create a private endpoint to the analytics cluster.
+func (a *AnalyticsPrivateEndpointCommand) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AnalyticsAWSCommandRequest
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analyticsClusterId := state.AnalyticsClusterId.ValueString()
+
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: state.OrganizationId.ValueString()},
+		utils.IDField{Name: "project_id", Value: state.ProjectId.ValueString()},
+		utils.IDField{Name: "analytics_cluster_id", Value: analyticsClusterId},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	commandRequest := apigen.CreatePrivateEndpointServiceCommandRequest{
+		VpcID:     state.VpcID.ValueString(),
+		SubnetIDs: *convertSubnetIDs(state.SubnetIDs),
+	}
+
+	commandResp, err := a.ClientV2.GetColumnarPrivateEndpointServiceCommandWithResponse(ctx, uuids[0], uuids[1], uuids[2], commandRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading analytics private endpoint command",
+			"Could not read private endpoint command of analytics cluster "+analyticsClusterId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if commandResp.StatusCode() != http.StatusOK || commandResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading analytics private endpoint command",
+			fmt.Sprintf("Could not read private endpoint command of analytics cluster %s, unexpected response status %d: %s", analyticsClusterId, commandResp.StatusCode(), string(commandResp.Body)),
+		)
+		return
+	}
+
+	state.Command = types.StringValue(commandResp.JSON200.Command)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the analytics private endpoint command data source.
+func (a *AnalyticsPrivateEndpointCommand) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
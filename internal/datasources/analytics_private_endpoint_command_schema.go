@@ -0,0 +1,30 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsPrivateEndpointCommandBuilder = capellaschema.NewSchemaBuilder("analyticsPrivateEndpointCommand", "CreatePrivateEndpointServiceCommandRequest")
+
+// AnalyticsPrivateEndpointCommandSchema returns the schema for the AnalyticsPrivateEndpointCommand data source.
+func AnalyticsPrivateEndpointCommandSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", analyticsPrivateEndpointCommandBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", analyticsPrivateEndpointCommandBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsPrivateEndpointCommandBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "vpc_id", analyticsPrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "subnet_ids", analyticsPrivateEndpointCommandBuilder, &schema.SetAttribute{
+		Required:    true,
+		ElementType: types.StringType,
+	})
+	capellaschema.AddAttr(attrs, "command", analyticsPrivateEndpointCommandBuilder, computedString(), "CreatePrivateEndpointServiceCommandResponse")
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to generate the CLI command for setting up a private endpoint connection to an analytics cluster.",
+		Attributes:          attrs,
+	}
+}
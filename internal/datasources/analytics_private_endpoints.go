@@ -0,0 +1,107 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &AnalyticsPrivateEndpoints{}
+	_ datasource.DataSourceWithConfigure = &AnalyticsPrivateEndpoints{}
+)
+
+// AnalyticsPrivateEndpoints is the data source implementation.
+type AnalyticsPrivateEndpoints struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsPrivateEndpoints is a helper function to simplify the provider implementation.
+func NewAnalyticsPrivateEndpoints() datasource.DataSource {
+	return &AnalyticsPrivateEndpoints{}
+}
+
+// Metadata returns the analytics private endpoints data source type name.
+func (a *AnalyticsPrivateEndpoints) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_private_endpoints"
+}
+
+// Schema defines the schema for the analytics private endpoints data source.
+func (a *AnalyticsPrivateEndpoints) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AnalyticsPrivateEndpointsSchema()
+}
+
+// Read refreshes the Terraform state with the private endpoint connections of the analytics cluster.
+func (a *AnalyticsPrivateEndpoints) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AnalyticsPrivateEndpoints
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analyticsClusterId := state.AnalyticsClusterId.ValueString()
+
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: state.OrganizationId.ValueString()},
+		utils.IDField{Name: "project_id", Value: state.ProjectId.ValueString()},
+		utils.IDField{Name: "analytics_cluster_id", Value: analyticsClusterId},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	listResp, err := a.ClientV2.ListColumnarPrivateEndpointServiceConnectionWithResponse(ctx, uuids[0], uuids[1], uuids[2])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Analytics Private Endpoints",
+			"Could not read private endpoints of analytics cluster "+analyticsClusterId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if listResp.StatusCode() != http.StatusOK || listResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Analytics Private Endpoints",
+			fmt.Sprintf("Could not read private endpoints of analytics cluster %s, unexpected response status %d: %s", analyticsClusterId, listResp.StatusCode(), string(listResp.Body)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.AnalyticsPrivateEndpointData, 0, len(listResp.JSON200.Endpoints))
+	for _, endpoint := range listResp.JSON200.Endpoints {
+		state.Data = append(state.Data, providerschema.AnalyticsPrivateEndpointData{
+			Id:     types.StringValue(endpoint.EndpointId),
+			Status: types.StringValue(string(endpoint.Status)),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the analytics private endpoints data source.
+func (a *AnalyticsPrivateEndpoints) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
@@ -0,0 +1,34 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsPrivateEndpointsBuilder = capellaschema.NewSchemaBuilder("analyticsPrivateEndpoints", "GetPrivateEndpointServiceConnectionResponse")
+
+// AnalyticsPrivateEndpointsSchema returns the schema for the AnalyticsPrivateEndpoints data source.
+func AnalyticsPrivateEndpointsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", analyticsPrivateEndpointsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", analyticsPrivateEndpointsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsPrivateEndpointsBuilder, requiredUUIDString())
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", analyticsPrivateEndpointsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "status", analyticsPrivateEndpointsBuilder, computedString())
+
+	capellaschema.AddAttr(attrs, "data", analyticsPrivateEndpointsBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to retrieve the private endpoint connections of an analytics cluster, including connections pending acceptance.",
+		Attributes:          attrs,
+	}
+}
@@ -0,0 +1,137 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &AnalyticsRestores{}
+	_ datasource.DataSourceWithConfigure      = &AnalyticsRestores{}
+	_ datasource.DataSourceWithValidateConfig = &AnalyticsRestores{}
+)
+
+// AnalyticsRestores is the analytics restores data source implementation.
+type AnalyticsRestores struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsRestores is a helper function to simplify the provider implementation.
+func NewAnalyticsRestores() datasource.DataSource {
+	return &AnalyticsRestores{}
+}
+
+// Metadata returns the analytics restores data source type name.
+func (a *AnalyticsRestores) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_restores"
+}
+
+// Schema defines the schema for the analytics restores data source.
+func (a *AnalyticsRestores) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AnalyticsRestoresSchema()
+}
+
+// Read refreshes the Terraform state with the latest restores of the analytics cluster.
+func (a *AnalyticsRestores) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AnalyticsRestores
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId     = state.OrganizationId.ValueString()
+		projectId          = state.ProjectId.ValueString()
+		analyticsClusterId = state.AnalyticsClusterId.ValueString()
+	)
+
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/analyticsClusters/%s/cloudSnapshotBackups/restores", a.HostURL, organizationId, projectId, analyticsClusterId)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	restores, err := api.GetPaginated[[]apigen.GetColumnarAnalyticsRestoreResponse](ctx, a.ClientV1, a.Token, cfg, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Analytics Restores",
+			fmt.Sprintf("Could not read restores of analytics cluster %s, unexpected error: %s", analyticsClusterId, api.ParseError(err)),
+		)
+		return
+	}
+
+	var statuses []string
+
+	// Since the list API doesn't implement query parameters useful for filtering,
+	// filtering is done by provider.
+	if state.Filters != nil {
+		diags := state.Filters.Values.ElementsAs(ctx, &statuses, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state.Data = make([]providerschema.AnalyticsRestoreData, 0, len(restores))
+	for _, restore := range restores {
+		status := ""
+		if restore.Status != nil {
+			status = *restore.Status
+		}
+
+		if len(statuses) == 0 || slices.Contains(statuses, status) {
+			state.Data = append(state.Data, providerschema.NewAnalyticsRestoreData(restore))
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the analytics restores data source.
+func (a *AnalyticsRestores) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
+
+// ValidateConfig checks that if 'name' or 'values' is set in filter block, then both are set.
+func (a *AnalyticsRestores) ValidateConfig(
+	ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse,
+) {
+	var config providerschema.AnalyticsRestores
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Filters != nil {
+		if (config.Filters.Name.IsNull() && !config.Filters.Values.IsNull()) ||
+			(!config.Filters.Name.IsNull() && config.Filters.Values.IsNull()) {
+			resp.Diagnostics.AddError(
+				"Invalid Filters Configuration",
+				"Both 'name' and 'values' in filter block must be configured.",
+			)
+		}
+	}
+}
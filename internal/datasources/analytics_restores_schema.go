@@ -0,0 +1,43 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsRestoresBuilder = capellaschema.NewSchemaBuilder("analyticsRestores", "GetColumnarAnalyticsRestoreResponse")
+
+func AnalyticsRestoresSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", analyticsRestoresBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", analyticsRestoresBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsRestoresBuilder, requiredUUIDString())
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", analyticsRestoresBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "created_at", analyticsRestoresBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "restore_end", analyticsRestoresBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "restore_to", analyticsRestoresBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "snapshot", analyticsRestoresBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "status", analyticsRestoresBuilder, computedString())
+
+	capellaschema.AddAttr(attrs, "data", analyticsRestoresBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to retrieve the restores of a Capella Columnar analytics cluster.",
+		Attributes:          attrs,
+
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: getFilterAttrs(),
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AppEndpointAdminUsers{}
+	_ datasource.DataSourceWithConfigure = &AppEndpointAdminUsers{}
+)
+
+// AppEndpointAdminUsers is the App Endpoint admin users data source implementation.
+type AppEndpointAdminUsers struct {
+	*providerschema.Data
+}
+
+// NewAppEndpointAdminUsers is a helper function to simplify the provider implementation.
+func NewAppEndpointAdminUsers() datasource.DataSource {
+	return &AppEndpointAdminUsers{}
+}
+
+// Metadata returns the App Endpoint admin users data source type name.
+func (a *AppEndpointAdminUsers) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_endpoint_admin_users"
+}
+
+// Schema defines the schema for the App Endpoint admin users data source.
+func (a *AppEndpointAdminUsers) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AppEndpointAdminUsersSchema()
+}
+
+// Read refreshes the Terraform state with the latest admin users of the App Endpoint.
+func (a *AppEndpointAdminUsers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AppEndpointAdminUsers
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId  = state.OrganizationId.ValueString()
+		projectId       = state.ProjectId.ValueString()
+		clusterId       = state.ClusterId.ValueString()
+		appServiceId    = state.AppServiceId.ValueString()
+		appEndpointName = state.AppEndpointName.ValueString()
+	)
+
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters/%s/appservices/%s/appEndpoints/%s/adminUsers", a.HostURL, organizationId, projectId, clusterId, appServiceId, appEndpointName)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	users, err := api.GetPaginated[[]apigen.AppServiceAdminUser](ctx, a.ClientV1, a.Token, cfg, api.SortById)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella App Endpoint Admin Users",
+			fmt.Sprintf("Could not read admin users of App Endpoint %s, unexpected error: %s", appEndpointName, api.ParseError(err)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.AppServiceAdminUserData, 0, len(users))
+	for _, user := range users {
+		auditObj := types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+		if user.Audit != nil {
+			audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(*user.Audit))
+			auditObj, diags = types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+			if diags.HasError() {
+				resp.Diagnostics.AddError(
+					"Error Reading Capella App Endpoint Admin Users",
+					fmt.Sprintf("Could not read admin users of App Endpoint %s, unexpected error: %s", appEndpointName, errors.ErrUnableToConvertAuditData),
+				)
+				return
+			}
+		}
+
+		state.Data = append(state.Data, providerschema.NewAppServiceAdminUserData(user, auditObj))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the App Endpoint admin users data source.
+func (a *AppEndpointAdminUsers) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
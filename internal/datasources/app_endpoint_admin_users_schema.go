@@ -0,0 +1,38 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appEndpointAdminUsersBuilder = capellaschema.NewSchemaBuilder("appEndpointAdminUsers", "AppServiceAdminUser")
+
+func AppEndpointAdminUsersSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appEndpointAdminUsersBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", appEndpointAdminUsersBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "cluster_id", appEndpointAdminUsersBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "app_service_id", appEndpointAdminUsersBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "app_endpoint_name", appEndpointAdminUsersBuilder, requiredString())
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", appEndpointAdminUsersBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "name", appEndpointAdminUsersBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "access_all_endpoints", appEndpointAdminUsersBuilder, computedBool())
+	capellaschema.AddAttr(dataAttrs, "endpoints", appEndpointAdminUsersBuilder, computedStringSet())
+	capellaschema.AddAttr(dataAttrs, "audit", appEndpointAdminUsersBuilder, computedAudit())
+
+	capellaschema.AddAttr(attrs, "data", appEndpointAdminUsersBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The App Endpoint admin users data source retrieves the admin users that can administer an App Endpoint.",
+		Attributes:          attrs,
+	}
+}
@@ -0,0 +1,158 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// appEndpointCollectionsPerPage is the number of scopes requested per page.
+const appEndpointCollectionsPerPage = 25
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AppEndpointCollections{}
+	_ datasource.DataSourceWithConfigure = &AppEndpointCollections{}
+)
+
+// AppEndpointCollections is the App Endpoint collections data source implementation.
+type AppEndpointCollections struct {
+	*providerschema.Data
+}
+
+// NewAppEndpointCollections is a helper function to simplify the provider implementation.
+func NewAppEndpointCollections() datasource.DataSource {
+	return &AppEndpointCollections{}
+}
+
+// Metadata returns the App Endpoint collections data source type name.
+func (a *AppEndpointCollections) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_endpoint_collections"
+}
+
+// Schema defines the schema for the App Endpoint collections data source.
+func (a *AppEndpointCollections) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AppEndpointCollectionsSchema()
+}
+
+// Read refreshes the Terraform state with the collections synced by the App Endpoint.
+func (a *AppEndpointCollections) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AppEndpointCollections
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella App Endpoint Collections",
+			"Could not read App Endpoint collections: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId  = state.OrganizationId.ValueString()
+		projectId       = state.ProjectId.ValueString()
+		clusterId       = state.ClusterId.ValueString()
+		appServiceId    = state.AppServiceId.ValueString()
+		appEndpointName = state.AppEndpointName.ValueString()
+	)
+
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+		utils.IDField{Name: "app_service_id", Value: appServiceId},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing IDs",
+			fmt.Sprintf("Could not parse resource IDs: %s", err.Error()),
+		)
+		return
+	}
+	organizationUUID, projectUUID, clusterUUID, appServiceUUID := uuids[0], uuids[1], uuids[2], uuids[3]
+
+	// The API pages over scopes but returns them as a map without a cursor, so pages are
+	// requested until one comes back short or adds no scope that has not been seen yet.
+	scopes := apigen.ScopesConfig{}
+	perPage := appEndpointCollectionsPerPage
+	for page := 1; ; page++ {
+		params := &apigen.ListAppEndpointCollectionsParams{
+			Page:    &page,
+			PerPage: &perPage,
+		}
+
+		listResp, err := a.ClientV2.ListAppEndpointCollectionsWithResponse(
+			ctx, organizationUUID, projectUUID, clusterUUID, appServiceUUID, appEndpointName, params,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Capella App Endpoint Collections",
+				fmt.Sprintf("Could not read the collections of app endpoint %s, unexpected error: %s", appEndpointName, err.Error()),
+			)
+			tflog.Debug(ctx, "error listing App Endpoint collections", map[string]interface{}{
+				"organizationId":  organizationId,
+				"projectId":       projectId,
+				"clusterId":       clusterId,
+				"appServiceId":    appServiceId,
+				"appEndpointName": appEndpointName,
+				"err":             err.Error(),
+			})
+			return
+		}
+
+		if listResp.StatusCode() != http.StatusOK || listResp.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Capella App Endpoint Collections",
+				fmt.Sprintf("Could not read the collections of app endpoint %s, unexpected response status %d: %s", appEndpointName, listResp.StatusCode(), string(listResp.Body)),
+			)
+			return
+		}
+
+		added := 0
+		for scopeName, scope := range *listResp.JSON200 {
+			if _, ok := scopes[scopeName]; !ok {
+				added++
+			}
+			scopes[scopeName] = scope
+		}
+
+		if added == 0 || len(*listResp.JSON200) < appEndpointCollectionsPerPage {
+			break
+		}
+	}
+
+	state.Data = providerschema.NewAppEndpointCollectionsData(scopes)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the App Endpoint collections data source.
+func (a *AppEndpointCollections) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.Data = data
+}
@@ -0,0 +1,46 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appEndpointCollectionsBuilder = capellaschema.NewSchemaBuilder("appEndpointCollections", "CollectionConfig")
+
+// AppEndpointCollectionsSchema returns the schema for the AppEndpointCollections data source.
+func AppEndpointCollectionsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appEndpointCollectionsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", appEndpointCollectionsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "cluster_id", appEndpointCollectionsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "app_service_id", appEndpointCollectionsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "app_endpoint_name", appEndpointCollectionsBuilder, requiredString())
+
+	// Build data attributes
+	dataAttrs := make(map[string]schema.Attribute)
+	// The scopes config is keyed by scope and collection name, so the spec has no description for them.
+	scopeName := computedString()
+	scopeName.MarkdownDescription = "The name of the scope the collection belongs to."
+	capellaschema.AddAttr(dataAttrs, "scope", appEndpointCollectionsBuilder, scopeName)
+
+	collectionName := computedString()
+	collectionName.MarkdownDescription = "The name of the collection."
+	capellaschema.AddAttr(dataAttrs, "name", appEndpointCollectionsBuilder, collectionName)
+	capellaschema.AddAttr(dataAttrs, "access_control_function", appEndpointCollectionsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "import_filter", appEndpointCollectionsBuilder, computedString())
+
+	capellaschema.AddAttr(attrs, "data", appEndpointCollectionsBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to retrieve the collections an App Endpoint is syncing, " +
+			"with the access control function and import filter configured for each collection.",
+		Attributes: attrs,
+	}
+}
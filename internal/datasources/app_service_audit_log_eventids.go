@@ -0,0 +1,135 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AppServiceAuditLogEventIDs{}
+	_ datasource.DataSourceWithConfigure = &AppServiceAuditLogEventIDs{}
+)
+
+// AppServiceAuditLogEventIDs is a list of audit log event ids of an App Endpoint.
+type AppServiceAuditLogEventIDs struct {
+	*providerschema.Data
+}
+
+// NewAppServiceAuditLogEventIDs is a helper function to simplify the provider implementation.
+func NewAppServiceAuditLogEventIDs() datasource.DataSource {
+	return &AppServiceAuditLogEventIDs{}
+}
+
+// Metadata returns the App Service audit log event ids data source type name.
+func (a *AppServiceAuditLogEventIDs) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_audit_log_event_ids"
+}
+
+// Schema defines the schema for the App Service audit log event ids data source.
+func (a *AppServiceAuditLogEventIDs) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AppServiceAuditLogEventIDsSchema()
+}
+
+// Read refreshes the Terraform state with the latest audit log event ids of the App Endpoint.
+func (a *AppServiceAuditLogEventIDs) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AppServiceAuditLogEventIDs
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service audit log event ids",
+			"Could not read App Service audit log event ids: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId  = state.OrganizationId.ValueString()
+		projectId       = state.ProjectId.ValueString()
+		clusterId       = state.ClusterId.ValueString()
+		appServiceId    = state.AppServiceId.ValueString()
+		appEndpointName = state.AppEndpointName.ValueString()
+	)
+
+	url := fmt.Sprintf(
+		"%s/v4/organizations/%s/projects/%s/clusters/%s/appservices/%s/appEndpoints/%s/auditLogEvents",
+		a.HostURL,
+		organizationId,
+		projectId,
+		clusterId,
+		appServiceId,
+		appEndpointName,
+	)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+	response, err := a.ClientV1.ExecuteWithRetry(
+		ctx,
+		cfg,
+		nil,
+		a.Token,
+		nil,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service audit log event ids",
+			"Could not read App Service audit log event ids: "+api.ParseError(err),
+		)
+		return
+	}
+
+	eventsResponse := apigen.AuditLogEventsResponse{}
+	err = json.Unmarshal(response.Body, &eventsResponse)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service audit log event ids",
+			"Could not read App Service audit log event ids: "+api.ParseError(err),
+		)
+		return
+	}
+
+	state.Data = []providerschema.AppServiceAuditLogEventID{}
+	if eventsResponse.Events != nil {
+		state.Data, err = providerschema.NewAppServiceAuditLogEventIDs(*eventsResponse.Events)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading App Service audit log event ids",
+				"Could not read App Service audit log event ids: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the App Service audit log event ids data source.
+func (a *AppServiceAuditLogEventIDs) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.Data = data
+}
@@ -0,0 +1,39 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServiceAuditLogEventIDsBuilder = capellaschema.NewSchemaBuilder("appServiceAuditLogEventIDs", "AuditLogEventsResponse")
+
+// AppServiceAuditLogEventIDsSchema returns the schema for the AppServiceAuditLogEventIDs data source.
+func AppServiceAuditLogEventIDsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appServiceAuditLogEventIDsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", appServiceAuditLogEventIDsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "cluster_id", appServiceAuditLogEventIDsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "app_service_id", appServiceAuditLogEventIDsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "app_endpoint_name", appServiceAuditLogEventIDsBuilder, requiredString())
+
+	// Build data attributes
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", appServiceAuditLogEventIDsBuilder, computedInt64())
+	capellaschema.AddAttr(dataAttrs, "name", appServiceAuditLogEventIDsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "description", appServiceAuditLogEventIDsBuilder, computedString())
+
+	capellaschema.AddAttr(attrs, "data", appServiceAuditLogEventIDsBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to retrieve the audit log event IDs of an App Endpoint on an App Service. " +
+			"These event IDs can be used to configure which events are audited with the `couchbase-capella_app_endpoint_audit_log_settings` resource.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,153 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AppServiceAuditLogExports{}
+	_ datasource.DataSourceWithConfigure = &AppServiceAuditLogExports{}
+)
+
+// AppServiceAuditLogExports is the App Service audit log exports data source implementation.
+type AppServiceAuditLogExports struct {
+	*providerschema.Data
+}
+
+// NewAppServiceAuditLogExports is a helper function to simplify the provider implementation.
+func NewAppServiceAuditLogExports() datasource.DataSource {
+	return &AppServiceAuditLogExports{}
+}
+
+// Metadata returns the App Service audit log exports data source type name.
+func (a *AppServiceAuditLogExports) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_audit_log_exports"
+}
+
+// Schema defines the schema for the App Service audit log exports data source.
+func (a *AppServiceAuditLogExports) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AppServiceAuditLogExportsSchema()
+}
+
+// Configure adds the provider configured client to the App Service audit log exports data source.
+func (a *AppServiceAuditLogExports) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	a.Data = data
+}
+
+// Read refreshes the Terraform state with the latest audit log export jobs of the App Service.
+func (a *AppServiceAuditLogExports) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AppServiceAuditLogExports
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.validate(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Audit Log Exports",
+			"Could not read audit log exports of App Service "+state.AppServiceId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = state.OrganizationId.ValueString()
+		projectId      = state.ProjectId.ValueString()
+		clusterId      = state.ClusterId.ValueString()
+		appServiceId   = state.AppServiceId.ValueString()
+	)
+
+	exports, err := a.listAuditLogExports(ctx, organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Audit Log Exports",
+			"Could not read audit log exports of App Service "+appServiceId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.AppServiceAuditLogExportData, 0, len(exports))
+	for _, export := range exports {
+		auditObj := types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+		if export.Audit != nil {
+			audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(*export.Audit))
+			var diags diag.Diagnostics
+			auditObj, diags = types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+			if diags.HasError() {
+				resp.Diagnostics.AddError(
+					"Error Reading App Service Audit Log Exports",
+					"Could not read audit log exports of App Service "+appServiceId+": "+errors.ErrUnableToConvertAuditData.Error(),
+				)
+				return
+			}
+		}
+
+		state.Data = append(state.Data, providerschema.NewAppServiceAuditLogExportData(export, auditObj))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// listAuditLogExports executes calls to the list App Service audit log exports endpoint. It handles pagination and
+// returns a slice of individual audit log export responses retrieved from multiple pages.
+func (a *AppServiceAuditLogExports) listAuditLogExports(
+	ctx context.Context,
+	organizationId, projectId, clusterId, appServiceId string,
+) ([]apigen.GetAuditExportDocResponse, error) {
+	url := fmt.Sprintf(
+		"%s/v4/organizations/%s/projects/%s/clusters/%s/appservices/%s/auditLogExports",
+		a.HostURL,
+		organizationId,
+		projectId,
+		clusterId,
+		appServiceId,
+	)
+
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+	return api.GetPaginated[[]apigen.GetAuditExportDocResponse](ctx, a.ClientV1, a.Token, cfg, "")
+}
+
+// validate is used to verify that all the fields in the datasource have been populated.
+func (a *AppServiceAuditLogExports) validate(state providerschema.AppServiceAuditLogExports) error {
+	if state.OrganizationId.IsNull() {
+		return errors.ErrOrganizationIdMissing
+	}
+	if state.ProjectId.IsNull() {
+		return errors.ErrProjectIdMissing
+	}
+	if state.ClusterId.IsNull() {
+		return errors.ErrClusterIdMissing
+	}
+	if state.AppServiceId.IsNull() {
+		return errors.ErrAppServiceIdMissing
+	}
+	return nil
+}
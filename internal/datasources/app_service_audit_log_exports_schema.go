@@ -0,0 +1,38 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServiceAuditLogExportsBuilder = capellaschema.NewSchemaBuilder("appServiceAuditLogExports", "GetAuditExportDocResponse")
+
+// AppServiceAuditLogExportsSchema returns the schema for the AppServiceAuditLogExports data source.
+func AppServiceAuditLogExportsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appServiceAuditLogExportsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", appServiceAuditLogExportsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "cluster_id", appServiceAuditLogExportsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "app_service_id", appServiceAuditLogExportsBuilder, requiredUUIDString())
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", appServiceAuditLogExportsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "status", appServiceAuditLogExportsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "download_id", appServiceAuditLogExportsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "download_expires", appServiceAuditLogExportsBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "audit", appServiceAuditLogExportsBuilder, computedAudit())
+
+	capellaschema.AddAttr(attrs, "data", appServiceAuditLogExportsBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to retrieve the audit log export jobs of an App Service, along with their status and download details.",
+		Attributes:          attrs,
+	}
+}
@@ -0,0 +1,92 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var (
+	_ datasource.DataSource              = &AppServiceAWSPrivateEndpointCommand{}
+	_ datasource.DataSourceWithConfigure = &AppServiceAWSPrivateEndpointCommand{}
+)
+
+// AppServiceAWSPrivateEndpointCommand is the data source implementation.
+type AppServiceAWSPrivateEndpointCommand struct {
+	*providerschema.Data
+}
+
+// NewAppServiceAWSPrivateEndpointCommand is a helper function to simplify the provider implementation.
+func NewAppServiceAWSPrivateEndpointCommand() datasource.DataSource {
+	return &AppServiceAWSPrivateEndpointCommand{}
+}
+
+// Metadata returns the data source type name.
+func (a *AppServiceAWSPrivateEndpointCommand) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_aws_private_endpoint_command"
+}
+
+// Schema defines the schema for the App Service private endpoint command data source.
+func (a *AppServiceAWSPrivateEndpointCommand) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AppServiceAwsPrivateEndpointCommandSchema()
+}
+
+// Read refreshes the Terraform state with the AWS command to create a private endpoint to the App Service.
+func (a *AppServiceAWSPrivateEndpointCommand) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AppServiceAWSCommandRequest
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsCommandRequest := apigen.CreateVPCEndpointCommandRequest{
+		VpcID:     state.VpcID.ValueString(),
+		SubnetIDs: *convertSubnetIDs(state.SubnetIDs),
+	}
+
+	command, err := getAppServicePrivateEndpointCommand(
+		ctx,
+		a.Data,
+		state.OrganizationId.ValueString(),
+		state.ProjectId.ValueString(),
+		state.ClusterId.ValueString(),
+		state.AppServiceId.ValueString(),
+		awsCommandRequest,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AWS App Service private endpoint command",
+			"Could not read AWS App Service private endpoint command: "+err.Error(),
+		)
+		return
+	}
+
+	state.Command = types.StringValue(command)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the App Service private endpoint command data source.
+func (a *AppServiceAWSPrivateEndpointCommand) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
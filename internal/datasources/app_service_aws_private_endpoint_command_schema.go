@@ -0,0 +1,31 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServiceAwsPrivateEndpointCommandBuilder = capellaschema.NewSchemaBuilder("appServiceAwsPrivateEndpointCommand")
+
+// AppServiceAwsPrivateEndpointCommandSchema returns the schema for the AppServiceAWSPrivateEndpointCommand data source.
+func AppServiceAwsPrivateEndpointCommandSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appServiceAwsPrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "project_id", appServiceAwsPrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "cluster_id", appServiceAwsPrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "app_service_id", appServiceAwsPrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "vpc_id", appServiceAwsPrivateEndpointCommandBuilder, requiredString(), "CreateVPCEndpointCommandRequest")
+	capellaschema.AddAttr(attrs, "subnet_ids", appServiceAwsPrivateEndpointCommandBuilder, &schema.SetAttribute{
+		Required:    true,
+		ElementType: types.StringType,
+	})
+	capellaschema.AddAttr(attrs, "command", appServiceAwsPrivateEndpointCommandBuilder, computedString())
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to generate an AWS CLI command for setting up a private endpoint connection to an App Service.",
+		Attributes:          attrs,
+	}
+}
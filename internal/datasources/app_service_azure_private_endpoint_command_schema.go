@@ -0,0 +1,27 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServiceAzurePrivateEndpointCommandBuilder = capellaschema.NewSchemaBuilder("appServiceAzurePrivateEndpointCommand")
+
+// AppServiceAzurePrivateEndpointCommandSchema returns the schema for the AppServiceAzurePrivateEndpointCommand data source.
+func AppServiceAzurePrivateEndpointCommandSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appServiceAzurePrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "project_id", appServiceAzurePrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "cluster_id", appServiceAzurePrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "app_service_id", appServiceAzurePrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "resource_group_name", appServiceAzurePrivateEndpointCommandBuilder, requiredString(), "CreateAzurePrivateEndpointCommandRequest")
+	capellaschema.AddAttr(attrs, "virtual_network", appServiceAzurePrivateEndpointCommandBuilder, requiredString(), "CreateAzurePrivateEndpointCommandRequest")
+	capellaschema.AddAttr(attrs, "command", appServiceAzurePrivateEndpointCommandBuilder, computedString())
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to generate an Azure CLI command for setting up a private endpoint connection to an App Service.",
+		Attributes:          attrs,
+	}
+}
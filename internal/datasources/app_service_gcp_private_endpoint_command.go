@@ -0,0 +1,92 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var (
+	_ datasource.DataSource              = &AppServiceGCPPrivateEndpointCommand{}
+	_ datasource.DataSourceWithConfigure = &AppServiceGCPPrivateEndpointCommand{}
+)
+
+// AppServiceGCPPrivateEndpointCommand is the data source implementation.
+type AppServiceGCPPrivateEndpointCommand struct {
+	*providerschema.Data
+}
+
+// NewAppServiceGCPPrivateEndpointCommand is a helper function to simplify the provider implementation.
+func NewAppServiceGCPPrivateEndpointCommand() datasource.DataSource {
+	return &AppServiceGCPPrivateEndpointCommand{}
+}
+
+// Metadata returns the data source type name.
+func (a *AppServiceGCPPrivateEndpointCommand) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_gcp_private_endpoint_command"
+}
+
+// Schema defines the schema for the App Service private endpoint command data source.
+func (a *AppServiceGCPPrivateEndpointCommand) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AppServiceGcpPrivateEndpointCommandSchema()
+}
+
+// Read refreshes the Terraform state with the GCP command to create a private endpoint to the App Service.
+func (a *AppServiceGCPPrivateEndpointCommand) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.AppServiceGCPCommandRequest
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gcpCommandRequest := apigen.CreateGCPPrivateEndpointCommandRequest{
+		VpcNetworkID: state.VpcNetworkID.ValueString(),
+		SubnetIDs:    *convertSubnetIDs(state.SubnetIDs),
+	}
+
+	command, err := getAppServicePrivateEndpointCommand(
+		ctx,
+		a.Data,
+		state.OrganizationId.ValueString(),
+		state.ProjectId.ValueString(),
+		state.ClusterId.ValueString(),
+		state.AppServiceId.ValueString(),
+		gcpCommandRequest,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading GCP App Service private endpoint command",
+			"Could not read GCP App Service private endpoint command: "+err.Error(),
+		)
+		return
+	}
+
+	state.Command = types.StringValue(command)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the App Service private endpoint command data source.
+func (a *AppServiceGCPPrivateEndpointCommand) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
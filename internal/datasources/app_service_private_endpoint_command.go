@@ -0,0 +1,57 @@
+package datasources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// getAppServicePrivateEndpointCommand retrieves the CSP command that creates a private endpoint to an App Service.
+// The request body is one of the AWS, Azure or GCP command requests, which the generated client has no helpers for,
+// so it is sent as raw JSON.
+func getAppServicePrivateEndpointCommand(
+	ctx context.Context,
+	data *providerschema.Data,
+	organizationId, projectId, clusterId, appServiceId string,
+	commandRequest any,
+) (string, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+		utils.IDField{Name: "app_service_id", Value: appServiceId},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(commandRequest)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", errors.ErrMarshallingPayload, err)
+	}
+
+	response, err := data.ClientV2.GetAppServicePrivateEndpointsCommandWithBodyWithResponse(
+		ctx,
+		uuids[0],
+		uuids[1],
+		uuids[2],
+		uuids[3],
+		"application/json",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+		return "", fmt.Errorf("unexpected response status %d: %s", response.StatusCode(), string(response.Body))
+	}
+
+	return response.JSON200.Command, nil
+}
@@ -0,0 +1,126 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &BackupCycles{}
+	_ datasource.DataSourceWithConfigure = &BackupCycles{}
+)
+
+// BackupCycles is the data source implementation.
+type BackupCycles struct {
+	*providerschema.Data
+}
+
+// NewBackupCycles is a helper function to simplify the provider implementation.
+func NewBackupCycles() datasource.DataSource {
+	return &BackupCycles{}
+}
+
+// Metadata returns the backup cycles data source type name.
+func (b *BackupCycles) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_cycles"
+}
+
+// Schema defines the schema for the backup cycles data source.
+func (b *BackupCycles) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = BackupCyclesSchema()
+}
+
+// Read refreshes the Terraform state with the backup cycles of the bucket.
+func (b *BackupCycles) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.BackupCycles
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = state.OrganizationId.ValueString()
+		projectId      = state.ProjectId.ValueString()
+		clusterId      = state.ClusterId.ValueString()
+		bucketId       = state.BucketId.ValueString()
+	)
+
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	cyclesResp, err := b.ClientV2.ListCyclesWithResponse(ctx, uuids[0], uuids[1], uuids[2], bucketId, &apigen.ListCyclesParams{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Backup Cycles",
+			"Could not read backup cycles of bucket "+bucketId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if cyclesResp.StatusCode() != http.StatusOK || cyclesResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Backup Cycles",
+			fmt.Sprintf("Could not read backup cycles of bucket %s, unexpected response status %d: %s", bucketId, cyclesResp.StatusCode(), string(cyclesResp.Body)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.BackupCycle, 0, len(cyclesResp.JSON200.Data))
+	for _, cycle := range cyclesResp.JSON200.Data {
+		backupsResp, err := b.ClientV2.ListBackupsWithResponse(ctx, uuids[0], uuids[1], uuids[2], bucketId, cycle.CycleID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Capella Backup Cycles",
+				"Could not read backups of cycle "+cycle.CycleID.String()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		if backupsResp.StatusCode() != http.StatusOK || backupsResp.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Capella Backup Cycles",
+				fmt.Sprintf("Could not read backups of cycle %s, unexpected response status %d: %s", cycle.CycleID, backupsResp.StatusCode(), string(backupsResp.Body)),
+			)
+			return
+		}
+
+		state.Data = append(state.Data, providerschema.NewBackupCycle(cycle, backupsResp.JSON200.Data))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the backup cycles data source.
+func (b *BackupCycles) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	b.Data = data
+}
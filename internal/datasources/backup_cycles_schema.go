@@ -0,0 +1,39 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var backupCyclesBuilder = capellaschema.NewSchemaBuilder("backupCycles", "GetCycleResponse")
+
+// BackupCyclesSchema returns the schema for the BackupCycles data source.
+func BackupCyclesSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", backupCyclesBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", backupCyclesBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "cluster_id", backupCyclesBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "bucket_id", backupCyclesBuilder, requiredString())
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "cycle_id", backupCyclesBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "created_at", backupCyclesBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "last_backup_at", backupCyclesBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "backup_count", backupCyclesBuilder, computedInt64())
+	capellaschema.AddAttr(dataAttrs, "size_in_mb", backupCyclesBuilder, &schema.Float64Attribute{Computed: true})
+
+	capellaschema.AddAttr(attrs, "data", backupCyclesBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to retrieve the backup cycles of a bucket. A backup cycle is a full backup and the incremental backups taken after it. " +
+			"The size and latest backup time of each cycle are aggregated from the backups it contains.",
+		Attributes: attrs,
+	}
+}
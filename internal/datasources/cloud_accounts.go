@@ -0,0 +1,94 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var (
+	_ datasource.DataSource              = &CloudAccounts{}
+	_ datasource.DataSourceWithConfigure = &CloudAccounts{}
+)
+
+// CloudAccounts is the data source implementation.
+type CloudAccounts struct {
+	*providerschema.Data
+}
+
+// NewCloudAccounts is a helper function to simplify the provider implementation.
+func NewCloudAccounts() datasource.DataSource {
+	return &CloudAccounts{}
+}
+
+// Metadata returns the cloud accounts data source type name.
+func (c *CloudAccounts) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_accounts"
+}
+
+// Schema defines the schema for the cloud accounts data source.
+func (c *CloudAccounts) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = CloudAccountsSchema()
+}
+
+// Read refreshes the Terraform state with the cloud accounts used by Capella.
+func (c *CloudAccounts) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.CloudAccounts
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := state.OrganizationId.ValueString()
+	orgUUID, err := uuid.Parse(organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse organization_id: "+err.Error())
+		return
+	}
+
+	response, err := c.ClientV2.GetCloudAccountsWithResponse(ctx, orgUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Cloud Accounts",
+			"Could not read cloud accounts of organization "+organizationId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Cloud Accounts",
+			fmt.Sprintf("Could not read cloud accounts of organization %s, unexpected response status %d: %s", organizationId, response.StatusCode(), string(response.Body)),
+		)
+		return
+	}
+
+	state = providerschema.NewCloudAccounts(*response.JSON200, organizationId)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the cloud accounts data source.
+func (c *CloudAccounts) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	c.Data = data
+}
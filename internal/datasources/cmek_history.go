@@ -0,0 +1,121 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &CmekHistory{}
+	_ datasource.DataSourceWithConfigure = &CmekHistory{}
+)
+
+// CmekHistory is the CMEK rotation history data source implementation.
+type CmekHistory struct {
+	*providerschema.Data
+}
+
+// NewCmekHistory is a helper function to simplify the provider implementation.
+func NewCmekHistory() datasource.DataSource {
+	return &CmekHistory{}
+}
+
+// Metadata returns the CMEK history data source type name.
+func (c *CmekHistory) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cmek_history"
+}
+
+// Schema defines the schema for the CMEK history data source.
+func (c *CmekHistory) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = CmekHistorySchema()
+}
+
+// Read refreshes the Terraform state with the rotation history of the key.
+func (c *CmekHistory) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.CmekHistory
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId, cmekId, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading CMEK History in Capella",
+			"Could not read CMEK history: "+err.Error(),
+		)
+		return
+	}
+
+	url := fmt.Sprintf("%s/v4/organizations/%s/cmek/%s/history", c.HostURL, organizationId, cmekId)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	response, err := api.GetPaginated[[]apigen.GetCMEKHistoryMetadataResponse](ctx, c.ClientV1, c.Token, cfg, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading CMEK History",
+			fmt.Sprintf("Could not read history of CMEK %s in organization %s, unexpected error: %s", cmekId, organizationId, api.ParseError(err)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.CmekHistoryEntry, 0, len(response))
+	for _, entry := range response {
+		historyEntry := providerschema.CmekHistoryEntry{
+			Key:          types.StringPointerValue(entry.Key),
+			AssociatedBy: types.StringPointerValue(entry.AssociatedBy),
+			AssociatedAt: types.StringNull(),
+			Active:       types.BoolPointerValue(entry.Active),
+		}
+		if entry.AssociatedAt != nil {
+			historyEntry.AssociatedAt = types.StringValue(entry.AssociatedAt.Format(time.RFC3339))
+		}
+		if entry.Config != nil {
+			rawConfig, err := entry.Config.MarshalJSON()
+			if err == nil {
+				historyEntry.Config, err = providerschema.NewCmekConfig(rawConfig)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading CMEK History",
+					fmt.Sprintf("Could not read history of CMEK %s in organization %s, unexpected error: %s", cmekId, organizationId, err),
+				)
+				return
+			}
+		}
+		state.Data = append(state.Data, historyEntry)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the CMEK history data source.
+func (c *CmekHistory) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	c.Data = data
+}
@@ -0,0 +1,65 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var cmekHistoryBuilder = capellaschema.NewSchemaBuilder("cmekHistory", "GetCMEKHistoryMetadataResponse")
+
+func CmekHistorySchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", cmekHistoryBuilder, requiredUUIDString())
+
+	cmekId := requiredUUIDString()
+	cmekId.MarkdownDescription = "The ID of the customer-managed encryption key metadata."
+	capellaschema.AddAttr(attrs, "cmek_id", cmekHistoryBuilder, cmekId)
+
+	awsConfigAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(awsConfigAttrs, "arn", cmekHistoryBuilder, computedString(), "AWSConfig")
+
+	gcpConfigAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(gcpConfigAttrs, "resource_name", cmekHistoryBuilder, computedString(), "GCPConfig")
+
+	azureConfigAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(azureConfigAttrs, "key_location", cmekHistoryBuilder, computedString(), "AzureConfig")
+	capellaschema.AddAttr(azureConfigAttrs, "region", cmekHistoryBuilder, computedString(), "AzureConfig")
+
+	configAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(configAttrs, "aws_config", cmekHistoryBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: awsConfigAttrs,
+	})
+	capellaschema.AddAttr(configAttrs, "gcp_config", cmekHistoryBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: gcpConfigAttrs,
+	})
+	capellaschema.AddAttr(configAttrs, "azure_config", cmekHistoryBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: azureConfigAttrs,
+	})
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "key", cmekHistoryBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "active", cmekHistoryBuilder, computedBool())
+	capellaschema.AddAttr(dataAttrs, "associated_at", cmekHistoryBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "associated_by", cmekHistoryBuilder, computedString())
+	capellaschema.AddAttr(dataAttrs, "config", cmekHistoryBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: configAttrs,
+	})
+
+	capellaschema.AddAttr(attrs, "data", cmekHistoryBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The CMEK history data source retrieves the rotation history of a customer-managed encryption key, including which key is currently active.",
+		Attributes:          attrs,
+	}
+}
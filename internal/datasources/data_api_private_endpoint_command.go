@@ -0,0 +1,135 @@
+package datasources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &DataAPIPrivateEndpointCommand{}
+	_ datasource.DataSourceWithConfigure = &DataAPIPrivateEndpointCommand{}
+)
+
+// DataAPIPrivateEndpointCommand is the data source implementation.
+type DataAPIPrivateEndpointCommand struct {
+	*providerschema.Data
+}
+
+// NewDataAPIPrivateEndpointCommand is a helper function to simplify the provider implementation.
+func NewDataAPIPrivateEndpointCommand() datasource.DataSource {
+	return &DataAPIPrivateEndpointCommand{}
+}
+
+// Metadata returns the data source type name.
+func (d *DataAPIPrivateEndpointCommand) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_api_private_endpoint_command"
+}
+
+// Schema defines the schema for the Data API private endpoint command data source.
+func (d *DataAPIPrivateEndpointCommand) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataAPIPrivateEndpointCommandSchema()
+}
+
+// Read refreshes the Terraform state with the AWS command to create a private endpoint to the Data API.
+func (d *DataAPIPrivateEndpointCommand) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.DataAPIAWSCommandRequest
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsCommandRequest := apigen.CreateVPCEndpointCommandRequest{
+		VpcID:     state.VpcID.ValueString(),
+		SubnetIDs: *convertSubnetIDs(state.SubnetIDs),
+	}
+
+	command, err := d.getPrivateEndpointCommand(
+		ctx,
+		state.OrganizationId.ValueString(),
+		state.ProjectId.ValueString(),
+		state.ClusterId.ValueString(),
+		awsCommandRequest,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Data API private endpoint command",
+			"Could not read Data API private endpoint command: "+err.Error(),
+		)
+		return
+	}
+
+	state.Command = types.StringValue(command)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the Data API private endpoint command data source.
+func (d *DataAPIPrivateEndpointCommand) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Data = data
+}
+
+// getPrivateEndpointCommand retrieves the AWS CLI command that creates a private endpoint to the Data API.
+// The generated client has no helpers for the request body union, so it is sent as raw JSON.
+func (d *DataAPIPrivateEndpointCommand) getPrivateEndpointCommand(
+	ctx context.Context,
+	organizationId, projectId, clusterId string,
+	commandRequest apigen.CreateVPCEndpointCommandRequest,
+) (string, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(commandRequest)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", errors.ErrMarshallingPayload, err)
+	}
+
+	response, err := d.ClientV2.GetDataAPIPrivateEndpointCommandWithBodyWithResponse(
+		ctx,
+		uuids[0],
+		uuids[1],
+		uuids[2],
+		"application/json",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+		return "", fmt.Errorf("unexpected response status %d: %s", response.StatusCode(), string(response.Body))
+	}
+
+	return response.JSON200.Command, nil
+}
@@ -0,0 +1,30 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var dataAPIPrivateEndpointCommandBuilder = capellaschema.NewSchemaBuilder("dataAPIPrivateEndpointCommand")
+
+// DataAPIPrivateEndpointCommandSchema returns the schema for the DataAPIPrivateEndpointCommand data source.
+func DataAPIPrivateEndpointCommandSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", dataAPIPrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "project_id", dataAPIPrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "cluster_id", dataAPIPrivateEndpointCommandBuilder, requiredString())
+	capellaschema.AddAttr(attrs, "vpc_id", dataAPIPrivateEndpointCommandBuilder, requiredString(), "CreateVPCEndpointCommandRequest")
+	capellaschema.AddAttr(attrs, "subnet_ids", dataAPIPrivateEndpointCommandBuilder, &schema.SetAttribute{
+		Required:    true,
+		ElementType: types.StringType,
+	})
+	capellaschema.AddAttr(attrs, "command", dataAPIPrivateEndpointCommandBuilder, computedString())
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to generate an AWS CLI command for setting up a private endpoint connection to the Data API of a cluster.",
+		Attributes:          attrs,
+	}
+}
@@ -0,0 +1,112 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &Replications{}
+	_ datasource.DataSourceWithConfigure = &Replications{}
+)
+
+// Replications is the replications data source implementation.
+type Replications struct {
+	*providerschema.Data
+}
+
+// NewReplications is a helper function to simplify the provider implementation.
+func NewReplications() datasource.DataSource {
+	return &Replications{}
+}
+
+// Metadata returns the replications data source type name.
+func (r *Replications) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replications"
+}
+
+// Schema defines the schema for the replications data source.
+func (r *Replications) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ReplicationsSchema()
+}
+
+// Read refreshes the Terraform state with the latest data of replications.
+func (r *Replications) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.Replications
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId, projectId, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Replications in Capella",
+			"Could not read Capella replications in project "+projectId+": "+err.Error(),
+		)
+		return
+	}
+
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/replications", r.HostURL, organizationId, projectId)
+	if clusterId := state.ClusterId.ValueString(); clusterId != "" {
+		url = fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters/%s/replications", r.HostURL, organizationId, projectId, clusterId)
+	}
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	response, err := api.GetPaginated[[]apigen.ReplicationSummary](ctx, r.ClientV1, r.Token, cfg, api.SortById)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Replications",
+			fmt.Sprintf("Could not read replications in organization %s and project %s, unexpected error: %s", organizationId, projectId, api.ParseError(err)),
+		)
+		return
+	}
+
+	state.Data = make([]providerschema.ReplicationSummary, 0, len(response))
+	for _, replication := range response {
+		audit := providerschema.NewReplicationAudit(replication.Audit)
+		auditObj, diags := types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+		if diags.HasError() {
+			resp.Diagnostics.AddError(
+				"Error Reading Replications",
+				fmt.Sprintf("Could not read replications in organization %s and project %s, unexpected error: %s", organizationId, projectId, errors.ErrUnableToConvertAuditData),
+			)
+			return
+		}
+
+		state.Data = append(state.Data, providerschema.NewReplicationSummary(replication, auditObj))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the replications data source.
+func (r *Replications) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Data = data
+}
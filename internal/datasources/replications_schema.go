@@ -0,0 +1,44 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var replicationsBuilder = capellaschema.NewSchemaBuilder("replications")
+
+func ReplicationsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", replicationsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", replicationsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "cluster_id", replicationsBuilder, optionalString())
+
+	auditAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(auditAttrs, "created_at", replicationsBuilder, computedString(), "ReplicationAuditData")
+	capellaschema.AddAttr(auditAttrs, "created_by", replicationsBuilder, computedString(), "ReplicationAuditData")
+
+	dataAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dataAttrs, "id", replicationsBuilder, computedString(), "ReplicationSummary")
+	capellaschema.AddAttr(dataAttrs, "source_cluster", replicationsBuilder, computedString(), "ReplicationSummary")
+	capellaschema.AddAttr(dataAttrs, "target_cluster", replicationsBuilder, computedString(), "ReplicationSummary")
+	capellaschema.AddAttr(dataAttrs, "direction", replicationsBuilder, computedString(), "ReplicationSummary")
+	capellaschema.AddAttr(dataAttrs, "status", replicationsBuilder, computedString(), "ReplicationSummary")
+	capellaschema.AddAttr(dataAttrs, "audit", replicationsBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: auditAttrs,
+	})
+
+	capellaschema.AddAttr(attrs, "data", replicationsBuilder, &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dataAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "The replications data source retrieves the XDCR replications of a project, or of a single cluster when `cluster_id` is set.",
+		Attributes:          attrs,
+	}
+}
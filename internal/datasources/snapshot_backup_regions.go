@@ -0,0 +1,103 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &SnapshotBackupRegions{}
+	_ datasource.DataSourceWithConfigure = &SnapshotBackupRegions{}
+)
+
+// SnapshotBackupRegions is the data source implementation.
+type SnapshotBackupRegions struct {
+	*providerschema.Data
+}
+
+// NewSnapshotBackupRegions is a helper function to simplify the provider implementation.
+func NewSnapshotBackupRegions() datasource.DataSource {
+	return &SnapshotBackupRegions{}
+}
+
+// Metadata returns the snapshot backup regions data source type name.
+func (s *SnapshotBackupRegions) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_backup_regions"
+}
+
+// Schema defines the schema for the snapshot backup regions data source.
+func (s *SnapshotBackupRegions) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = SnapshotBackupRegionsSchema()
+}
+
+// Read refreshes the Terraform state with the geographic regions available to the cluster.
+func (s *SnapshotBackupRegions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providerschema.SnapshotBackupRegions
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = state.OrganizationId.ValueString()
+		projectId      = state.ProjectId.ValueString()
+		clusterId      = state.ClusterId.ValueString()
+	)
+
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	response, err := s.ClientV2.ListGeographicRegionsWithResponse(ctx, uuids[0], uuids[1], uuids[2])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Snapshot Backup Regions",
+			"Could not read regions of cluster "+clusterId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Snapshot Backup Regions",
+			fmt.Sprintf("Could not read regions of cluster %s, unexpected response status %d: %s", clusterId, response.StatusCode(), string(response.Body)),
+		)
+		return
+	}
+
+	state = providerschema.NewSnapshotBackupRegions(*response.JSON200, organizationId, projectId, clusterId)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the snapshot backup regions data source.
+func (s *SnapshotBackupRegions) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.Data = data
+}
@@ -0,0 +1,25 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var snapshotBackupRegionsBuilder = capellaschema.NewSchemaBuilder("snapshotBackupRegions")
+
+// SnapshotBackupRegionsSchema returns the schema for the SnapshotBackupRegions data source.
+func SnapshotBackupRegionsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", snapshotBackupRegionsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "project_id", snapshotBackupRegionsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "cluster_id", snapshotBackupRegionsBuilder, requiredUUIDString())
+	capellaschema.AddAttr(attrs, "regions", snapshotBackupRegionsBuilder, computedStringSet())
+
+	return schema.Schema{
+		MarkdownDescription: "The data source to retrieve the geographic regions available to the cloud provider of a cluster. " +
+			"These are the regions cloud snapshot backups of the cluster can be copied to, and can be used to pick valid regions for other clusters on the same cloud provider.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,118 @@
+package ephemeralresources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &ApiKeyToken{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ApiKeyToken{}
+)
+
+// ApiKeyToken is the API key token ephemeral resource implementation.
+type ApiKeyToken struct {
+	*providerschema.Data
+}
+
+// NewApiKeyToken is a helper function to simplify the provider implementation.
+func NewApiKeyToken() ephemeral.EphemeralResource {
+	return &ApiKeyToken{}
+}
+
+// Metadata returns the API key token ephemeral resource type name.
+func (a *ApiKeyToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikey_token"
+}
+
+// Schema defines the schema for the API key token ephemeral resource.
+func (a *ApiKeyToken) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = ApiKeyTokenSchema()
+}
+
+// Open reads the API key and returns the token produced by the API key resource as the
+// ephemeral result. Terraform opens ephemeral resources during plan as well as apply, so
+// the API key is never rotated here; it is rotated with the rotate attribute of the
+// couchbase-capella_apikey resource.
+func (a *ApiKeyToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config providerschema.ApiKeyToken
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := config.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella API Key",
+			"Could not read API key: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = config.OrganizationId.ValueString()
+		apiKeyId       = config.ApiKeyId.ValueString()
+	)
+
+	organizationUUID, err := utils.ParseUUID("organization_id", organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing IDs",
+			fmt.Sprintf("Could not parse resource IDs: %s", err.Error()),
+		)
+		return
+	}
+
+	apiKeyResp, err := a.ClientV2.GetOrganizationAPIKeyByAccessKeyWithResponse(ctx, organizationUUID, apiKeyId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella API Key",
+			fmt.Sprintf("Could not read API key %s, unexpected error: %s", apiKeyId, err.Error()),
+		)
+		tflog.Debug(ctx, "error reading API key", map[string]interface{}{
+			"organizationId": organizationId,
+			"apiKeyId":       apiKeyId,
+			"err":            err.Error(),
+		})
+		return
+	}
+
+	if apiKeyResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella API Key",
+			fmt.Sprintf("Could not read API key %s, unexpected response status %d: %s", apiKeyId, apiKeyResp.StatusCode(), string(apiKeyResp.Body)),
+		)
+		return
+	}
+
+	diags = resp.Result.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the API key token ephemeral resource.
+func (a *ApiKeyToken) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.Data = data
+}
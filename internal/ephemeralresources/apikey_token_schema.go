@@ -0,0 +1,30 @@
+package ephemeralresources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var apiKeyTokenBuilder = capellaschema.NewSchemaBuilder("apiKeyToken", "GetAPIKey")
+
+// ApiKeyTokenSchema returns the schema for the ApiKeyToken ephemeral resource.
+func ApiKeyTokenSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", apiKeyTokenBuilder, requiredUUIDString())
+
+	apiKeyId := requiredStringWithValidator()
+	apiKeyId.MarkdownDescription = "The ID of the API key."
+	capellaschema.AddAttr(attrs, "api_key_id", apiKeyTokenBuilder, apiKeyId)
+
+	token := requiredSensitiveString()
+	token.MarkdownDescription = "The token of the API key, as returned by the couchbase-capella_apikey resource when the API key is created or rotated."
+	capellaschema.AddAttr(attrs, "token", apiKeyTokenBuilder, token, "RotateAPIKeyResponse")
+
+	return schema.Schema{
+		MarkdownDescription: "The ephemeral resource to pass the token of an existing API key to other providers without storing it in their state or plan. " +
+			"It does not change the API key; rotate the API key with the rotate attribute of the couchbase-capella_apikey resource.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,74 @@
+package ephemeralresources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+const (
+	testOrgID      = "7cbb7c27-7f5a-4e5e-8a0d-4c1a3b4e2a11"
+	testApiKeyID   = "mYaPiKeYiD"
+	testApiKeyPath = "/v4/organizations/" + testOrgID + "/apikeys/" + testApiKeyID
+)
+
+func TestApiKeyTokenOpen(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "[POSITIVE] existing API key", status: http.StatusOK},
+		{name: "[NEGATIVE] API key not found", status: http.StatusNotFound, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// The API key is only read, never rotated.
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKeyPath, r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			client, err := apigen.NewClientWithResponses(server.URL)
+			require.NoError(t, err)
+			ephemeralResource := &ApiKeyToken{Data: &providerschema.Data{ClientV2: client}}
+
+			ctx := context.Background()
+			schema := ApiKeyTokenSchema()
+			objectType := schema.Type().TerraformType(ctx).(tftypes.Object)
+			config := tfsdk.Config{Schema: schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"organization_id": tftypes.NewValue(tftypes.String, testOrgID),
+				"api_key_id":      tftypes.NewValue(tftypes.String, testApiKeyID),
+				"token":           tftypes.NewValue(tftypes.String, "token"),
+			})}
+
+			resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schema, Raw: tftypes.NewValue(objectType, nil)}}
+			ephemeralResource.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+
+			if test.wantErr {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+			var result providerschema.ApiKeyToken
+			require.False(t, resp.Result.Get(ctx, &result).HasError())
+			assert.Equal(t, "token", result.Token.ValueString())
+		})
+	}
+}
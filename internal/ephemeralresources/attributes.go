@@ -0,0 +1,45 @@
+package ephemeralresources
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Helper functions for common ephemeral resource attribute patterns
+
+func requiredStringWithValidator() *schema.StringAttribute {
+	return &schema.StringAttribute{
+		Required:   true,
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+}
+
+func requiredUUIDString() *schema.StringAttribute {
+	return &schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.RegexMatches(uuidRegex, "must be a valid UUID"),
+		},
+	}
+}
+
+func requiredSensitiveString() *schema.StringAttribute {
+	return &schema.StringAttribute{
+		Required:   true,
+		Sensitive:  true,
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+}
+
+func computedSensitiveString() *schema.StringAttribute {
+	return &schema.StringAttribute{
+		Computed:  true,
+		Sensitive: true,
+	}
+}
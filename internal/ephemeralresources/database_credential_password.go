@@ -0,0 +1,114 @@
+package ephemeralresources
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+const (
+	// generatedPasswordLength is the length of the passwords generated for database credentials.
+	generatedPasswordLength = 32
+
+	passwordLowercase = "abcdefghijklmnopqrstuvwxyz"
+	passwordUppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordDigits    = "0123456789"
+	passwordSpecial   = "!#%*+-_=@"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource = &DatabaseCredentialPassword{}
+)
+
+// DatabaseCredentialPassword is the database credential password ephemeral resource implementation.
+type DatabaseCredentialPassword struct{}
+
+// NewDatabaseCredentialPassword is a helper function to simplify the provider implementation.
+func NewDatabaseCredentialPassword() ephemeral.EphemeralResource {
+	return &DatabaseCredentialPassword{}
+}
+
+// Metadata returns the database credential password ephemeral resource type name.
+func (d *DatabaseCredentialPassword) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_credential_password"
+}
+
+// Schema defines the schema for the database credential password ephemeral resource.
+func (d *DatabaseCredentialPassword) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = DatabaseCredentialPasswordSchema()
+}
+
+// Open generates a new password and returns it as the ephemeral result. Terraform opens
+// ephemeral resources during plan as well as apply, so the password is only generated here
+// and is set on a database credential through its password_wo and password_wo_version attributes.
+func (d *DatabaseCredentialPassword) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config providerschema.DatabaseCredentialPassword
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, err := generatePassword(generatedPasswordLength)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating Database Credential Password",
+			"Could not generate a database credential password: "+err.Error(),
+		)
+		return
+	}
+
+	config.Password = types.StringValue(password)
+
+	diags = resp.Result.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// generatePassword returns a random password of the given length that satisfies the Capella
+// password policy: at least one lowercase letter, uppercase letter, digit and special character.
+func generatePassword(length int) (string, error) {
+	classes := []string{passwordLowercase, passwordUppercase, passwordDigits, passwordSpecial}
+	if length < len(classes) {
+		return "", fmt.Errorf("password length %d is shorter than %d", length, len(classes))
+	}
+
+	all := passwordLowercase + passwordUppercase + passwordDigits + passwordSpecial
+	password := make([]byte, length)
+	for i := range password {
+		charset := all
+		if i < len(classes) {
+			charset = classes[i]
+		}
+		c, err := randomChar(charset)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+
+	// Shuffle so the guaranteed characters are not always at the start of the password.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+func randomChar(charset string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+	return charset[n.Int64()], nil
+}
@@ -0,0 +1,24 @@
+package ephemeralresources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var databaseCredentialPasswordBuilder = capellaschema.NewSchemaBuilder("databaseCredentialPassword", "UpdateDatabaseCredentialRequest")
+
+// DatabaseCredentialPasswordSchema returns the schema for the DatabaseCredentialPassword ephemeral resource.
+func DatabaseCredentialPasswordSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	password := computedSensitiveString()
+	password.MarkdownDescription = "The generated password. Set it on a database credential with password_wo, and change password_wo_version to send it again."
+	capellaschema.AddAttr(attrs, "password", databaseCredentialPasswordBuilder, password)
+
+	return schema.Schema{
+		MarkdownDescription: "The ephemeral resource to generate a password for a database credential without storing it in the Terraform state or plan. " +
+			"A new password is generated every time Terraform opens the ephemeral resource. It does not change any database credential.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,55 @@
+package ephemeralresources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		password, err := generatePassword(generatedPasswordLength)
+		require.NoError(t, err)
+		assert.Len(t, password, generatedPasswordLength)
+
+		for _, charset := range []string{passwordLowercase, passwordUppercase, passwordDigits, passwordSpecial} {
+			assert.True(t, strings.ContainsAny(password, charset), "password %q has no character from %q", password, charset)
+		}
+	}
+
+	_, err := generatePassword(3)
+	assert.Error(t, err)
+}
+
+func TestDatabaseCredentialPasswordOpen(t *testing.T) {
+	ephemeralResource := &DatabaseCredentialPassword{}
+
+	ctx := context.Background()
+	schema := DatabaseCredentialPasswordSchema()
+	objectType := schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tfsdk.Config{Schema: schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"password": tftypes.NewValue(tftypes.String, nil),
+	})}
+
+	open := func() string {
+		resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schema, Raw: tftypes.NewValue(objectType, nil)}}
+		ephemeralResource.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+		var result providerschema.DatabaseCredentialPassword
+		require.False(t, resp.Result.Get(ctx, &result).HasError())
+		return result.Password.ValueString()
+	}
+
+	first, second := open(), open()
+	assert.Len(t, first, generatedPasswordLength)
+	assert.NotEqual(t, first, second)
+}
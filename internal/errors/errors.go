@@ -27,6 +27,9 @@ var (
 	// ErrAppServiceIdMissing is returned when an expected App Service Id was not found after an import.
 	ErrAppServiceIdMissing = errors.New("app service ID is missing or was passed incorrectly, please check provider documentation for syntax")
 
+	// ErrAppEndpointNameMissing is returned when an expected App Endpoint name was not found.
+	ErrAppEndpointNameMissing = errors.New("app endpoint name is missing or was passed incorrectly, please check provider documentation for syntax")
+
 	// ErrAppEndpointInvalidState is returned when an invalid state is provided for an App Endpoint.
 	ErrAppEndpointInvalidState = errors.New("app endpoint state is invalid, valid values are 'Online' and 'Offline'")
 
@@ -0,0 +1,129 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	tlsScheme    = "couchbases://"
+	nonTLSScheme = "couchbase://"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ConnectionString{}
+
+// ConnectionString is the function that builds the connection string of a cluster.
+type ConnectionString struct{}
+
+// NewConnectionString is a helper function to simplify the provider implementation.
+func NewConnectionString() function.Function {
+	return &ConnectionString{}
+}
+
+// Metadata returns the connection_string function name.
+func (f *ConnectionString) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "connection_string"
+}
+
+// Definition defines the parameters and return type of the connection_string function.
+func (f *ConnectionString) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the connection string of a cluster",
+		MarkdownDescription: "Returns the SDK connection string of a cluster, with the `couchbases://` scheme when TLS is used " +
+			"and the `couchbase://` scheme otherwise. The cluster can be a cluster resource or data source object, " +
+			"or the connection string or hostname of the cluster.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "cluster",
+				MarkdownDescription: "The cluster, as an object with a `connection_string` attribute, or its connection string or hostname.",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:                "tls",
+			MarkdownDescription: "Whether the connection uses TLS. Defaults to `true`, which Capella clusters require.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the connection string of the cluster.
+func (f *ConnectionString) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		cluster types.Dynamic
+		tls     []bool
+	)
+	resp.Error = req.Arguments.Get(ctx, &cluster, &tls)
+	if resp.Error != nil {
+		return
+	}
+
+	if len(tls) > 1 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("tls can only be given once, got %d values", len(tls)))
+		return
+	}
+
+	host, known, err := clusterHost(cluster)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if !known {
+		// The connection string of a cluster created in the same apply is only known after apply.
+		resp.Error = resp.Result.Set(ctx, types.StringUnknown())
+		return
+	}
+
+	scheme := tlsScheme
+	if len(tls) == 1 && !tls[0] {
+		scheme = nonTLSScheme
+	}
+
+	resp.Error = resp.Result.Set(ctx, scheme+host)
+}
+
+// clusterHost returns the connection string of the cluster without its scheme. It returns
+// false when the connection string is not known yet.
+func clusterHost(cluster types.Dynamic) (string, bool, error) {
+	if cluster.IsUnknown() || cluster.IsUnderlyingValueUnknown() {
+		return "", false, nil
+	}
+
+	var connectionString basetypes.StringValue
+	switch value := cluster.UnderlyingValue().(type) {
+	case basetypes.StringValue:
+		connectionString = value
+	case basetypes.ObjectValue:
+		attr, ok := value.Attributes()["connection_string"]
+		if !ok {
+			return "", false, fmt.Errorf("cluster object has no connection_string attribute")
+		}
+		if connectionString, ok = attr.(basetypes.StringValue); !ok {
+			return "", false, fmt.Errorf("connection_string of the cluster must be a string")
+		}
+	default:
+		return "", false, fmt.Errorf("cluster must be a connection string or an object with a connection_string attribute")
+	}
+
+	if connectionString.IsUnknown() {
+		return "", false, nil
+	}
+	if connectionString.IsNull() {
+		return "", false, fmt.Errorf("connection_string of the cluster is null")
+	}
+
+	host := strings.TrimSpace(connectionString.ValueString())
+	for _, scheme := range []string{tlsScheme, nonTLSScheme} {
+		host = strings.TrimPrefix(host, scheme)
+	}
+	if host == "" {
+		return "", false, fmt.Errorf("connection_string of the cluster is empty")
+	}
+
+	return host, true, nil
+}
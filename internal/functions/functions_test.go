@@ -0,0 +1,149 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// run calls the function with the given arguments and returns its result and error.
+func run(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func stringMap(values map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func boolTuple(values ...bool) types.Tuple {
+	elementTypes := make([]attr.Type, len(values))
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elementTypes[i] = types.BoolType
+		elements[i] = types.BoolValue(value)
+	}
+	return types.TupleValueMust(elementTypes, elements)
+}
+
+func TestImportIdAndParseImportId(t *testing.T) {
+	ids := map[string]string{"organization_id": "400", "project_id": "300", "cluster_id": "200", "id": "100"}
+
+	importId, err := run(t, NewImportId(), types.StringUnknown(), stringMap(ids))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("id=100,cluster_id=200,project_id=300,organization_id=400"), importId)
+
+	parsed, err := run(t, NewParseImportId(), types.MapUnknown(types.StringType), importId)
+	require.Nil(t, err)
+	assert.Equal(t, stringMap(ids), parsed)
+
+	_, err = run(t, NewImportId(), types.StringUnknown(), stringMap(map[string]string{"user_id": "100"}))
+	assert.NotNil(t, err)
+
+	_, err = run(t, NewParseImportId(), types.MapUnknown(types.StringType), types.StringValue("id"))
+	assert.NotNil(t, err)
+}
+
+func TestConnectionString(t *testing.T) {
+	cluster := types.ObjectValueMust(
+		map[string]attr.Type{"name": types.StringType, "connection_string": types.StringType},
+		map[string]attr.Value{"name": types.StringValue("cluster"), "connection_string": types.StringValue("couchbases://cb.example.cloud.couchbase.com")},
+	)
+
+	tests := []struct {
+		name     string
+		cluster  attr.Value
+		tls      types.Tuple
+		expected string
+		unknown  bool
+		wantErr  bool
+	}{
+		{name: "[POSITIVE] cluster object", cluster: cluster, tls: boolTuple(), expected: "couchbases://cb.example.cloud.couchbase.com"},
+		{name: "[POSITIVE] cluster object without TLS", cluster: cluster, tls: boolTuple(false), expected: "couchbase://cb.example.cloud.couchbase.com"},
+		{name: "[POSITIVE] hostname", cluster: types.StringValue("cb.example.cloud.couchbase.com"), tls: boolTuple(true), expected: "couchbases://cb.example.cloud.couchbase.com"},
+		{name: "[POSITIVE] connection string without TLS", cluster: types.StringValue("couchbase://localhost"), tls: boolTuple(), expected: "couchbases://localhost"},
+		{name: "[POSITIVE] connection string not known yet", cluster: types.ObjectValueMust(
+			map[string]attr.Type{"name": types.StringType, "connection_string": types.StringType},
+			map[string]attr.Value{"name": types.StringValue("cluster"), "connection_string": types.StringUnknown()},
+		), tls: boolTuple(), unknown: true},
+		{name: "[NEGATIVE] tls given twice", cluster: cluster, tls: boolTuple(true, false), wantErr: true},
+		{name: "[NEGATIVE] empty connection string", cluster: types.StringValue("couchbases://"), tls: boolTuple(), wantErr: true},
+		{name: "[NEGATIVE] object without connection string", cluster: types.ObjectValueMust(
+			map[string]attr.Type{"name": types.StringType},
+			map[string]attr.Value{"name": types.StringValue("cluster")},
+		), tls: boolTuple(), wantErr: true},
+		{name: "[NEGATIVE] number", cluster: types.Int64Value(1), tls: boolTuple(), wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := run(t, NewConnectionString(), types.StringUnknown(), types.DynamicValue(test.cluster), test.tls)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			if test.unknown {
+				assert.Equal(t, types.StringUnknown(), result)
+				return
+			}
+			assert.Equal(t, types.StringValue(test.expected), result)
+		})
+	}
+}
+
+func TestKeyspace(t *testing.T) {
+	result, err := run(t, NewKeyspace(), types.StringUnknown(),
+		types.StringValue("travel-sample"), types.StringValue("inventory"), types.StringValue("air`line"))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("`travel-sample`.`inventory`.`air``line`"), result)
+
+	_, err = run(t, NewKeyspace(), types.StringUnknown(),
+		types.StringValue("travel-sample"), types.StringValue(""), types.StringValue("airline"))
+	assert.NotNil(t, err)
+}
+
+func TestValidCidr(t *testing.T) {
+	for value, expected := range map[string]bool{
+		"10.0.0.0/16":       true,
+		"73.222.201.137/32": true,
+		"10.0.0.0":          false,
+		"10.0.0.0/33":       false,
+		"":                  false,
+	} {
+		t.Run(value, func(t *testing.T) {
+			result, err := run(t, NewValidCidr(), types.BoolUnknown(), types.StringValue(value))
+			require.Nil(t, err)
+			assert.Equal(t, types.BoolValue(expected), result)
+		})
+	}
+}
+
+func TestValidClusterName(t *testing.T) {
+	for value, expected := range map[string]bool{
+		"my-cluster":             true,
+		strings.Repeat("a", 256): true,
+		strings.Repeat("a", 257): false,
+		" my-cluster":            false,
+		"":                       false,
+	} {
+		t.Run(value, func(t *testing.T) {
+			result, err := run(t, NewValidClusterName(), types.BoolUnknown(), types.StringValue(value))
+			require.Nil(t, err)
+			assert.Equal(t, types.BoolValue(expected), result)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ImportId{}
+
+// ImportId is the function that builds the ID string used to import a resource.
+type ImportId struct{}
+
+// NewImportId is a helper function to simplify the provider implementation.
+func NewImportId() function.Function {
+	return &ImportId{}
+}
+
+// Metadata returns the import_id function name.
+func (f *ImportId) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "import_id"
+}
+
+// Definition defines the parameters and return type of the import_id function.
+func (f *ImportId) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the ID used to import a resource",
+		MarkdownDescription: "Joins the IDs of a resource into the comma-separated `key=value` string accepted by `terraform import` " +
+			"and `import` blocks, for example `id=<id>,cluster_id=<cluster_id>,project_id=<project_id>,organization_id=<organization_id>`. " +
+			"The IDs are written from the most specific one to the organization, whatever the order of the map. " +
+			"Names such as `project_name` or `cluster_name` can be given instead of IDs for the resources that can be imported by name.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "ids",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the resource, keyed by their attribute names such as `id`, `cluster_id`, `cluster_name` or `organization_id`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the import ID from the given IDs.
+func (f *ImportId) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ids map[string]string
+	resp.Error = req.Arguments.Get(ctx, &ids)
+	if resp.Error != nil {
+		return
+	}
+
+	importId, err := providerschema.BuildImportString(ids)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, importId)
+}
@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &Keyspace{}
+
+// Keyspace is the function that builds the SQL++ keyspace of a collection.
+type Keyspace struct{}
+
+// NewKeyspace is a helper function to simplify the provider implementation.
+func NewKeyspace() function.Function {
+	return &Keyspace{}
+}
+
+// Metadata returns the keyspace function name.
+func (f *Keyspace) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "keyspace"
+}
+
+// Definition defines the parameters and return type of the keyspace function.
+func (f *Keyspace) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the SQL++ keyspace of a collection",
+		MarkdownDescription: "Returns the fully qualified SQL++ keyspace of a collection, with every part escaped by backticks, " +
+			"for example `` `travel-sample`.`inventory`.`airline` ``. " +
+			"Use `_default` for the default scope and collection.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "The name of the bucket.",
+			},
+			function.StringParameter{
+				Name:                "scope",
+				MarkdownDescription: "The name of the scope.",
+			},
+			function.StringParameter{
+				Name:                "collection",
+				MarkdownDescription: "The name of the collection.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the keyspace from the bucket, scope and collection names.
+func (f *Keyspace) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket, scope, collection string
+	resp.Error = req.Arguments.Get(ctx, &bucket, &scope, &collection)
+	if resp.Error != nil {
+		return
+	}
+
+	parts := []string{bucket, scope, collection}
+	for i, part := range parts {
+		if part == "" {
+			resp.Error = function.NewArgumentFuncError(int64(i), "name must not be empty")
+			return
+		}
+		// A backtick inside an escaped identifier is escaped by doubling it.
+		parts[i] = "`" + strings.ReplaceAll(part, "`", "``") + "`"
+	}
+
+	resp.Error = resp.Result.Set(ctx, strings.Join(parts, "."))
+}
@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ParseImportId{}
+
+// ParseImportId is the function that splits the ID string used to import a resource.
+type ParseImportId struct{}
+
+// NewParseImportId is a helper function to simplify the provider implementation.
+func NewParseImportId() function.Function {
+	return &ParseImportId{}
+}
+
+// Metadata returns the parse_import_id function name.
+func (f *ParseImportId) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+// Definition defines the parameters and return type of the parse_import_id function.
+func (f *ParseImportId) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split the ID used to import a resource",
+		MarkdownDescription: "Splits a comma-separated `key=value` import ID, such as " +
+			"`id=<id>,cluster_id=<cluster_id>,project_id=<project_id>,organization_id=<organization_id>`, " +
+			"into a map of IDs keyed by their attribute names. It is the inverse of `import_id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "import_id",
+				MarkdownDescription: "The import ID to split.",
+			},
+		},
+		Return: function.MapReturn{ElementType: types.StringType},
+	}
+}
+
+// Run splits the import ID into its IDs.
+func (f *ParseImportId) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var importId string
+	resp.Error = req.Arguments.Get(ctx, &importId)
+	if resp.Error != nil {
+		return
+	}
+
+	ids, err := providerschema.ParseImportString(importId)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, ids)
+}
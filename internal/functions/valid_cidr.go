@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ValidCidr{}
+
+// ValidCidr is the function that checks whether a value is an IP address range in CIDR notation.
+type ValidCidr struct{}
+
+// NewValidCidr is a helper function to simplify the provider implementation.
+func NewValidCidr() function.Function {
+	return &ValidCidr{}
+}
+
+// Metadata returns the valid_cidr function name.
+func (f *ValidCidr) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "valid_cidr"
+}
+
+// Definition defines the parameters and return type of the valid_cidr function.
+func (f *ValidCidr) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a value is a valid CIDR",
+		MarkdownDescription: "Returns `true` when the value is an IP address range in CIDR notation, such as `10.0.0.0/16`. " +
+			"Use it in variable validation blocks to check the CIDRs given to the allowlist, cluster and network peer " +
+			"resources before a plan is made.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "The CIDR to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run checks the CIDR.
+func (f *ValidCidr) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	resp.Error = req.Arguments.Get(ctx, &cidr)
+	if resp.Error != nil {
+		return
+	}
+
+	_, _, err := net.ParseCIDR(cidr)
+	resp.Error = resp.Result.Set(ctx, err == nil)
+}
@@ -0,0 +1,71 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/resources"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ValidClusterName{}
+
+// ValidClusterName is the function that checks a name against the rules of the cluster name attribute.
+type ValidClusterName struct{}
+
+// NewValidClusterName is a helper function to simplify the provider implementation.
+func NewValidClusterName() function.Function {
+	return &ValidClusterName{}
+}
+
+// Metadata returns the valid_cluster_name function name.
+func (f *ValidClusterName) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "valid_cluster_name"
+}
+
+// Definition defines the parameters and return type of the valid_cluster_name function.
+func (f *ValidClusterName) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a value is a valid cluster name",
+		MarkdownDescription: "Returns `true` when the value is accepted as the `name` of a cluster resource: " +
+			"it is not empty, has no leading or trailing whitespace and passes the validators of the attribute, such as its maximum length. " +
+			"Use it in variable validation blocks to fail before a plan is made.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The cluster name to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run checks the cluster name.
+func (f *ValidClusterName) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	valid := name != "" &&
+		providerschema.IsTrimmed(name) &&
+		passesStringValidators(ctx, name, clusterNameValidators())
+
+	resp.Error = resp.Result.Set(ctx, valid)
+}
+
+// clusterNameValidators returns the validators of the name attribute of the cluster resource,
+// including the ones added from the OpenAPI spec.
+func clusterNameValidators() []validator.String {
+	attr, ok := resources.ClusterSchema().Attributes["name"].(interface {
+		StringValidators() []validator.String
+	})
+	if !ok {
+		return nil
+	}
+	return attr.StringValidators()
+}
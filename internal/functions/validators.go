@@ -0,0 +1,25 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// passesStringValidators reports whether the value is accepted by all the given schema validators,
+// so a function applies exactly the rules of the attribute the validators come from.
+func passesStringValidators(ctx context.Context, value string, validators []validator.String) bool {
+	for _, v := range validators {
+		resp := &validator.StringResponse{}
+		v.ValidateString(ctx, validator.StringRequest{
+			Path:        path.Root("value"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,288 @@
+// Package hclgen generates Terraform configuration for the objects that already exist
+// in a Capella organization, so that an estate built by hand can be brought under
+// Terraform with import blocks.
+package hclgen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/resources"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// header is written at the top of the generated configuration.
+const header = `# Generated by generate-hcl from the objects in a Capella organization.
+# Review the configuration before applying it. Values that the Capella APIs do not
+# return, such as database credential passwords, are not set.
+
+`
+
+var (
+	projectKind = resourceKind{
+		name:      "project",
+		schema:    resources.ProjectSchema(),
+		importKey: "id",
+		childAttr: "project_id",
+		refAttr:   "id",
+	}
+	clusterKind = resourceKind{
+		name:      "cluster",
+		schema:    resources.ClusterSchema(),
+		importKey: "id",
+		childAttr: "cluster_id",
+		refAttr:   "id",
+	}
+	bucketKind = resourceKind{
+		name:      "bucket",
+		schema:    resources.BucketSchema(),
+		importKey: "id",
+		childAttr: "bucket_id",
+		refAttr:   "id",
+	}
+	scopeKind = resourceKind{
+		name:      "scope",
+		schema:    resources.ScopeSchema(),
+		keys:      map[string]string{"scope_name": "name"},
+		importKey: "scope_name",
+		childAttr: "scope_name",
+		refAttr:   "scope_name",
+	}
+	collectionKind = resourceKind{
+		name:      "collection",
+		schema:    resources.CollectionSchema(),
+		keys:      map[string]string{"collection_name": "name"},
+		importKey: "collection_name",
+	}
+	allowlistKind = resourceKind{
+		name:      "allowlist",
+		schema:    resources.AllowlistsSchema(),
+		importKey: "id",
+	}
+	databaseCredentialKind = resourceKind{
+		name:      "database_credential",
+		schema:    resources.DatabaseCredentialSchema(),
+		importKey: "id",
+	}
+	appServiceKind = resourceKind{
+		name:      "app_service",
+		schema:    resources.AppServiceSchema(),
+		importKey: "id",
+		childAttr: "app_service_id",
+		refAttr:   "id",
+	}
+	appEndpointKind = resourceKind{
+		name:      "app_endpoint",
+		schema:    resources.AppEndpointSchema(),
+		importKey: "app_endpoint_name",
+	}
+)
+
+// Generator walks a Capella organization with the list APIs and generates an import
+// block and a resource block for each project, cluster, bucket, scope, collection,
+// allowlist, database credential, app service and app endpoint in it.
+type Generator struct {
+	*providerschema.Data
+
+	// OrganizationId is the ID of the organization to generate the configuration for.
+	OrganizationId string
+}
+
+// Generate returns the configuration for the organization. Children refer to the
+// generated resources of their parents, so the configuration can be applied as is
+// to import the whole organization.
+func (g *Generator) Generate(ctx context.Context) ([]byte, error) {
+	w := newWriter()
+	organization := parent{attr: "organization_id", value: g.OrganizationId}
+
+	appServices, err := g.list(ctx, fmt.Sprintf("%s/v4/organizations/%s/appservices", g.HostURL, g.OrganizationId))
+	if err != nil {
+		return nil, fmt.Errorf("could not list app services: %w", err)
+	}
+	appServicesByCluster := make(map[string][]map[string]any)
+	for _, appService := range appServices {
+		clusterId := stringField(appService, "clusterId")
+		appServicesByCluster[clusterId] = append(appServicesByCluster[clusterId], appService)
+	}
+
+	projects, err := g.list(ctx, fmt.Sprintf("%s/v4/organizations/%s/projects", g.HostURL, g.OrganizationId))
+	if err != nil {
+		return nil, fmt.Errorf("could not list projects: %w", err)
+	}
+	for _, project := range projects {
+		projectRef, err := w.writeResource(projectKind, stringField(project, "name"), project, stringField(project, "id"), []parent{organization})
+		if err != nil {
+			return nil, err
+		}
+		if err := g.generateClusters(ctx, w, appServicesByCluster, []parent{organization, projectRef}); err != nil {
+			return nil, err
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	out.Write(hclwrite.Format(bytes.TrimRight(w.file.Bytes(), "\n")))
+	out.WriteString("\n")
+	return out.Bytes(), nil
+}
+
+func (g *Generator) generateClusters(ctx context.Context, w *writer, appServicesByCluster map[string][]map[string]any, parents []parent) error {
+	projectId := parents[len(parents)-1].value
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters", g.HostURL, g.OrganizationId, projectId)
+	clusters, err := g.list(ctx, url)
+	if err != nil {
+		return fmt.Errorf("could not list clusters in project %s: %w", projectId, err)
+	}
+
+	for _, cluster := range clusters {
+		name := stringField(cluster, "name")
+		clusterRef, err := w.writeResource(clusterKind, name, cluster, stringField(cluster, "id"), parents)
+		if err != nil {
+			return err
+		}
+		clusterParents := append(parents[:len(parents):len(parents)], clusterRef)
+		clusterUrl := url + "/" + clusterRef.value
+
+		if err := g.generateBuckets(ctx, w, clusterUrl, name, clusterParents); err != nil {
+			return err
+		}
+
+		allowlists, err := g.list(ctx, clusterUrl+"/allowedcidrs")
+		if err != nil {
+			return fmt.Errorf("could not list allowlists in cluster %s: %w", clusterRef.value, err)
+		}
+		for _, allowlist := range allowlists {
+			label := name + "_" + stringField(allowlist, "cidr")
+			if _, err := w.writeResource(allowlistKind, label, allowlist, stringField(allowlist, "id"), clusterParents); err != nil {
+				return err
+			}
+		}
+
+		credentials, err := g.list(ctx, clusterUrl+"/users")
+		if err != nil {
+			return fmt.Errorf("could not list database credentials in cluster %s: %w", clusterRef.value, err)
+		}
+		for _, credential := range credentials {
+			label := name + "_" + stringField(credential, "name")
+			if _, err := w.writeResource(databaseCredentialKind, label, credential, stringField(credential, "id"), clusterParents); err != nil {
+				return err
+			}
+		}
+
+		for _, appService := range appServicesByCluster[clusterRef.value] {
+			if err := g.generateAppService(ctx, w, clusterUrl, appService, clusterParents); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Generator) generateBuckets(ctx context.Context, w *writer, clusterUrl, clusterName string, parents []parent) error {
+	buckets, err := g.list(ctx, clusterUrl+"/buckets")
+	if err != nil {
+		return fmt.Errorf("could not list buckets in cluster %s: %w", parents[len(parents)-1].value, err)
+	}
+
+	for _, bucket := range buckets {
+		name := clusterName + "_" + stringField(bucket, "name")
+		bucketRef, err := w.writeResource(bucketKind, name, bucket, stringField(bucket, "id"), parents)
+		if err != nil {
+			return err
+		}
+		bucketParents := append(parents[:len(parents):len(parents)], bucketRef)
+
+		scopes, err := g.listScopes(ctx, clusterUrl+"/buckets/"+bucketRef.value+"/scopes")
+		if err != nil {
+			return fmt.Errorf("could not list scopes in bucket %s: %w", bucketRef.value, err)
+		}
+		for _, scope := range scopes {
+			// System scopes and collections, such as _default, are created by Couchbase Server
+			// and cannot be managed. Collections added to a system scope refer to it by name.
+			scopeName := stringField(scope, "name")
+			scopeRef := parent{attr: "scope_name", value: scopeName}
+			if !strings.HasPrefix(scopeName, "_") {
+				scopeRef, err = w.writeResource(scopeKind, name+"_"+scopeName, scope, scopeName, bucketParents)
+				if err != nil {
+					return err
+				}
+			}
+
+			collections, _ := scope["collections"].([]any)
+			for _, item := range collections {
+				collection, ok := item.(map[string]any)
+				collectionName := stringField(collection, "name")
+				if !ok || strings.HasPrefix(collectionName, "_") {
+					continue
+				}
+				label := name + "_" + scopeName + "_" + collectionName
+				if _, err := w.writeResource(collectionKind, label, collection, collectionName, append(bucketParents, scopeRef)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Generator) generateAppService(ctx context.Context, w *writer, clusterUrl string, appService map[string]any, parents []parent) error {
+	name := stringField(appService, "name")
+	appServiceRef, err := w.writeResource(appServiceKind, name, appService, stringField(appService, "id"), parents)
+	if err != nil {
+		return err
+	}
+
+	endpoints, err := g.list(ctx, clusterUrl+"/appservices/"+appServiceRef.value+"/appEndpoints")
+	if err != nil {
+		return fmt.Errorf("could not list app endpoints in app service %s: %w", appServiceRef.value, err)
+	}
+	for _, endpoint := range endpoints {
+		endpointName := stringField(endpoint, "name")
+		appServiceParents := append(parents[:len(parents):len(parents)], appServiceRef)
+		if _, err := w.writeResource(appEndpointKind, name+"_"+endpointName, endpoint, endpointName, appServiceParents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// list lists every page of the given list endpoint.
+func (g *Generator) list(ctx context.Context, url string) ([]map[string]any, error) {
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+	response, err := api.GetPaginated[[]map[string]any](ctx, g.ClientV1, g.Token, cfg, api.SortById)
+	if err != nil {
+		return nil, fmt.Errorf("%s", api.ParseError(err))
+	}
+	return response, nil
+}
+
+// listScopes lists the scopes of a bucket, with their collections. The scopes endpoint
+// is not paginated.
+func (g *Generator) listScopes(ctx context.Context, url string) ([]map[string]any, error) {
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+	response, err := g.ClientV1.ExecuteWithRetry(ctx, cfg, nil, g.Token, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s", api.ParseError(err))
+	}
+
+	var scopes struct {
+		Scopes []map[string]any `json:"scopes"`
+	}
+	if err := json.Unmarshal(response.Body, &scopes); err != nil {
+		return nil, err
+	}
+	return scopes.Scopes, nil
+}
+
+// stringField returns a string field of a JSON object, or "" if it is not set.
+func stringField(object map[string]any, key string) string {
+	value, _ := object[key].(string)
+	return value
+}
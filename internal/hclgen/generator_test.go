@@ -0,0 +1,274 @@
+package hclgen
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+const (
+	testOrgID        = "11111111-1111-4111-8111-111111111111"
+	testProjectID    = "22222222-2222-4222-8222-222222222222"
+	testClusterID    = "33333333-3333-4333-8333-333333333333"
+	testAppSvcID     = "44444444-4444-4444-8444-444444444444"
+	testCredID       = "55555555-5555-4555-8555-555555555555"
+	testAllowlistID  = "66666666-6666-4666-8666-666666666666"
+	testBucketID     = "b3JkZXJz"
+	testOtherCluster = "33333333-3333-4333-8333-444444444444"
+)
+
+// fakeCapella serves the list endpoints of an organization, one item per page so the
+// generator is exercised across several pages, and the unpaginated scopes endpoint.
+type fakeCapella struct {
+	lists  map[string][]map[string]any
+	scopes map[string]any
+}
+
+func (f *fakeCapella) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if scopes, ok := f.scopes[r.URL.Path]; ok {
+		_ = json.NewEncoder(w).Encode(scopes)
+		return
+	}
+
+	items, ok := f.lists[r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":404,"hint":"not found","httpStatusCode":404,"message":"not found"}`))
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	data := []map[string]any{}
+	if page <= len(items) {
+		data = items[page-1 : page]
+	}
+	next := 0
+	if page < len(items) {
+		next = page + 1
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"data":   data,
+		"cursor": map[string]any{"pages": map[string]any{"page": page, "next": next}},
+	})
+}
+
+func newTestGenerator(t *testing.T, fake *fakeCapella) *Generator {
+	t.Helper()
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return &Generator{
+		Data: &providerschema.Data{
+			HostURL:  server.URL,
+			Token:    "token",
+			ClientV1: &api.Client{Client: server.Client()},
+		},
+		OrganizationId: testOrgID,
+	}
+}
+
+func newFakeCapella() *fakeCapella {
+	organization := "/v4/organizations/" + testOrgID
+	project := organization + "/projects/" + testProjectID
+	cluster := project + "/clusters/" + testClusterID
+
+	return &fakeCapella{
+		lists: map[string][]map[string]any{
+			organization + "/projects": {
+				{"id": testProjectID, "name": "Prod", "description": "production", "audit": map[string]any{"version": 3}},
+			},
+			project + "/clusters": {
+				{
+					"id":                         testClusterID,
+					"name":                       "orders",
+					"enablePrivateDNSResolution": false,
+					"cloudProvider":              map[string]any{"type": "aws", "region": "us-east-1", "cidr": "10.0.0.0/23"},
+					"serviceGroups": []any{
+						map[string]any{
+							"node": map[string]any{
+								"compute": map[string]any{"cpu": 4, "ram": 16},
+								"disk":    map[string]any{"type": "gp3", "storage": 50, "iops": 3000},
+							},
+							"numOfNodes": 3,
+							"services":   []any{"data", "index", "query"},
+						},
+					},
+					"availability":    map[string]any{"type": "multi"},
+					"support":         map[string]any{"plan": "developer pro", "timezone": "PT"},
+					"couchbaseServer": map[string]any{"version": "7.6"},
+					"currentState":    "healthy",
+				},
+			},
+			cluster + "/buckets": {
+				{"id": testBucketID, "name": "orders", "memoryAllocationInMb": 1024, "replicas": 1, "flush": false, "stats": map[string]any{"itemCount": 10}},
+			},
+			cluster + "/allowedcidrs": {
+				{"id": testAllowlistID, "cidr": "10.1.0.0/16", "comment": "office"},
+			},
+			cluster + "/users": {
+				{"id": testCredID, "name": "app", "access": []any{
+					map[string]any{"privileges": []any{"data_reader"}, "resources": map[string]any{
+						"buckets": []any{map[string]any{"name": "orders"}},
+					}},
+				}},
+			},
+			organization + "/appservices": {
+				{"id": testAppSvcID, "name": "sync", "clusterId": testClusterID, "nodes": 2, "compute": map[string]any{"cpu": 2, "ram": 4}},
+				// App services of clusters in other projects are not written under this cluster.
+				{"id": "44444444-4444-4444-8444-555555555555", "name": "other", "clusterId": testOtherCluster},
+			},
+			cluster + "/appservices/" + testAppSvcID + "/appEndpoints": {
+				{
+					"name":   "mobile",
+					"bucket": "orders",
+					"scopes": map[string]any{
+						"inventory": map[string]any{"collections": map[string]any{
+							"hotel": map[string]any{"accessControlFunction": "function(doc){channel(doc.channels);}"},
+						}},
+					},
+					"state": "Online",
+				},
+			},
+		},
+		scopes: map[string]any{
+			cluster + "/buckets/" + testBucketID + "/scopes": map[string]any{
+				"scopes": []any{
+					map[string]any{"name": "inventory", "collections": []any{
+						map[string]any{"name": "hotel", "maxTTL": 3600},
+					}},
+					map[string]any{"name": "_default", "collections": []any{
+						map[string]any{"name": "_default", "maxTTL": 0},
+						map[string]any{"name": "legacy", "maxTTL": 0},
+					}},
+					map[string]any{"name": "_system", "collections": []any{
+						map[string]any{"name": "_mobile", "maxTTL": 0},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	generator := newTestGenerator(t, newFakeCapella())
+
+	config, err := generator.Generate(context.Background())
+	require.NoError(t, err)
+
+	file, diags := hclwrite.ParseConfig(config, "imported.tf", hcl.InitialPos)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	var imports, resources []string
+	for _, block := range file.Body().Blocks() {
+		switch block.Type() {
+		case "import":
+			imports = append(imports, string(block.Body().GetAttribute("to").Expr().BuildTokens(nil).Bytes()))
+		case "resource":
+			resources = append(resources, block.Labels()[0]+"."+block.Labels()[1])
+		}
+	}
+
+	expected := []string{
+		"couchbase-capella_project.prod",
+		"couchbase-capella_cluster.orders",
+		"couchbase-capella_bucket.orders_orders",
+		"couchbase-capella_scope.orders_orders_inventory",
+		"couchbase-capella_collection.orders_orders_inventory_hotel",
+		"couchbase-capella_collection.orders_orders__default_legacy",
+		"couchbase-capella_allowlist.orders_10_1_0_0_16",
+		"couchbase-capella_database_credential.orders_app",
+		"couchbase-capella_app_service.sync",
+		"couchbase-capella_app_endpoint.sync_mobile",
+	}
+	assert.Equal(t, expected, resources)
+	require.Len(t, imports, len(expected))
+	for i, address := range expected {
+		assert.Equal(t, " "+address, imports[i])
+	}
+
+	out := string(config)
+	for _, want := range []string{
+		`id = "id=` + testProjectID + `,organization_id=` + testOrgID + `"`,
+		`id = "id=` + testClusterID + `,project_id=` + testProjectID + `,organization_id=` + testOrgID + `"`,
+		`id = "id=` + testBucketID + `,cluster_id=` + testClusterID + `,project_id=` + testProjectID + `,organization_id=` + testOrgID + `"`,
+		`id = "scope_name=inventory,bucket_id=` + testBucketID + `,cluster_id=` + testClusterID + `,project_id=` + testProjectID + `,organization_id=` + testOrgID + `"`,
+		`id = "collection_name=hotel,scope_name=inventory,bucket_id=` + testBucketID + `,`,
+		`id = "app_endpoint_name=mobile,app_service_id=` + testAppSvcID + `,cluster_id=` + testClusterID + `,`,
+		`organization_id = "` + testOrgID + `"`,
+		`project_id      = couchbase-capella_project.prod.id`,
+		`bucket_id       = couchbase-capella_bucket.orders_orders.id`,
+		`scope_name      = couchbase-capella_scope.orders_orders_inventory.scope_name`,
+		`scope_name      = "_default"`,
+		`app_service_id  = couchbase-capella_app_service.sync.id`,
+		`enable_private_dns_resolution = false`,
+		`num_of_nodes = 3`,
+		`services     = ["data", "index", "query"]`,
+		`max_ttl         = 3600`,
+		`memory_allocation_in_mb = 1024`,
+		`access_control_function = "function(doc){channel(doc.channels);}"`,
+	} {
+		assert.Contains(t, out, want)
+	}
+
+	// Read-only values and values of the other clusters are not written.
+	for _, unwanted := range []string{"current_state", "stats", "audit", "password", "_mobile", "other", "state"} {
+		assert.NotContains(t, out, unwanted+" ")
+	}
+}
+
+func TestGenerateListError(t *testing.T) {
+	fake := newFakeCapella()
+	delete(fake.lists, "/v4/organizations/"+testOrgID+"/projects/"+testProjectID+"/clusters/"+testClusterID+"/users")
+	generator := newTestGenerator(t, fake)
+
+	_, err := generator.Generate(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not list database credentials in cluster "+testClusterID)
+}
+
+func TestLabel(t *testing.T) {
+	w := newWriter()
+
+	tests := []struct {
+		name     string
+		typeName string
+		input    string
+		expected string
+	}{
+		{name: "[POSITIVE] lower case", typeName: "couchbase-capella_project", input: "My Project", expected: "my_project"},
+		{name: "[POSITIVE] dashes are kept", typeName: "couchbase-capella_project", input: "my-project!", expected: "my-project"},
+		{name: "[POSITIVE] same label again", typeName: "couchbase-capella_project", input: "My Project", expected: "my_project_2"},
+		{name: "[POSITIVE] other type", typeName: "couchbase-capella_cluster", input: "My Project", expected: "my_project"},
+		{name: "[POSITIVE] leading digit", typeName: "couchbase-capella_cluster", input: "2024 cluster", expected: "_2024_cluster"},
+		{name: "[POSITIVE] no valid characters", typeName: "couchbase-capella_cluster", input: "***", expected: "unnamed"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, w.label(test.typeName, test.input))
+		})
+	}
+}
+
+func TestJsonKey(t *testing.T) {
+	assert.Equal(t, "numOfNodes", jsonKey("num_of_nodes"))
+	assert.Equal(t, "name", jsonKey("name"))
+	assert.Equal(t, "maxTTL", jsonKey("max_ttl"))
+	assert.Equal(t, "enablePrivateDNSResolution", jsonKey("enable_private_dns_resolution"))
+}
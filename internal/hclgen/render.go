@@ -0,0 +1,309 @@
+package hclgen
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zclconf/go-cty/cty"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// providerTypeName is the prefix of every resource type of the provider.
+const providerTypeName = "couchbase-capella"
+
+// parentAttributes are the attributes that refer to the parents of a resource, in the
+// order they are written before the other attributes of a resource block.
+var parentAttributes = []string{
+	"organization_id",
+	"project_id",
+	"cluster_id",
+	"app_service_id",
+	"bucket_id",
+	"scope_name",
+}
+
+// jsonKeys maps the attributes whose JSON key in the list responses is not the
+// camel case form of the attribute name.
+var jsonKeys = map[string]string{
+	"enable_private_dns_resolution": "enablePrivateDNSResolution",
+	"max_ttl":                       "maxTTL",
+}
+
+// resourceKind describes a resource type that the generator writes blocks for.
+type resourceKind struct {
+	// name is the resource type without the provider prefix, such as "cluster".
+	name string
+
+	// schema is the resource schema, used to pick the attributes to write.
+	schema schema.Schema
+
+	// keys maps attributes to the JSON key they are read from, where it differs
+	// from jsonKeys and the camel case form of the attribute name.
+	keys map[string]string
+
+	// importKey is the key of the import ID that identifies the object itself.
+	importKey string
+
+	// childAttr is the attribute that children of the resource refer to it with,
+	// such as "project_id".
+	childAttr string
+
+	// refAttr is the attribute of the resource that children refer to, such as "id".
+	refAttr string
+}
+
+// typeName returns the resource type, such as "couchbase-capella_cluster".
+func (k resourceKind) typeName() string {
+	return providerTypeName + "_" + k.name
+}
+
+// parent is a parent of an object, referred to by the child resource block.
+type parent struct {
+	// attr is the attribute of the child that refers to the parent, such as "project_id".
+	attr string
+
+	// value is the ID or name of the parent, used in the import ID.
+	value string
+
+	// address is the address of the generated parent resource, such as
+	// "couchbase-capella_project.prod". It is empty when the value is written as is.
+	address string
+
+	// refAttr is the attribute of the parent resource that is referred to.
+	refAttr string
+}
+
+// writer writes import and resource blocks to an HCL file, giving every resource
+// a label that is unique for its type.
+type writer struct {
+	file   *hclwrite.File
+	labels map[string]map[string]bool
+}
+
+func newWriter() *writer {
+	return &writer{file: hclwrite.NewEmptyFile(), labels: make(map[string]map[string]bool)}
+}
+
+// writeResource writes the import block and the resource block for an object and
+// returns the parent that children of the object refer to it by.
+func (w *writer) writeResource(kind resourceKind, name string, object map[string]any, ownId string, parents []parent) (parent, error) {
+	ids := map[string]string{kind.importKey: ownId}
+	for _, p := range parents {
+		ids[p.attr] = p.value
+	}
+	importId, err := providerschema.BuildImportString(ids)
+	if err != nil {
+		return parent{}, fmt.Errorf("could not build the import ID of %s %q: %w", kind.name, name, err)
+	}
+
+	label := w.label(kind.typeName(), name)
+	address := kind.typeName() + "." + label
+
+	body := w.file.Body()
+	importBlock := body.AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: kind.typeName()},
+		hcl.TraverseAttr{Name: label},
+	})
+	importBlock.SetAttributeValue("id", cty.StringVal(importId))
+	body.AppendNewline()
+
+	resourceBody := body.AppendNewBlock("resource", []string{kind.typeName(), label}).Body()
+	written := make(map[string]bool, len(parents))
+	for _, attr := range parentAttributes {
+		for _, p := range parents {
+			if p.attr != attr || kind.schema.Attributes[attr] == nil {
+				continue
+			}
+			if p.address == "" {
+				resourceBody.SetAttributeValue(attr, cty.StringVal(p.value))
+			} else {
+				resourceBody.SetAttributeTraversal(attr, traversal(p.address, p.refAttr))
+			}
+			written[attr] = true
+		}
+	}
+
+	names := make([]string, 0, len(kind.schema.Attributes))
+	for attrName := range kind.schema.Attributes {
+		if !written[attrName] {
+			names = append(names, attrName)
+		}
+	}
+	sort.Strings(names)
+	for _, attrName := range names {
+		attr := kind.schema.Attributes[attrName]
+		if !configurable(attr) {
+			continue
+		}
+		key, ok := kind.keys[attrName]
+		if !ok {
+			key = jsonKey(attrName)
+		}
+		if value, ok := convert(attr, object[key]); ok {
+			resourceBody.SetAttributeValue(attrName, value)
+		}
+	}
+	body.AppendNewline()
+
+	return parent{attr: kind.childAttr, value: ownId, address: address, refAttr: kind.refAttr}, nil
+}
+
+// traversal returns the reference to an attribute of a resource, such as
+// couchbase-capella_project.prod.id.
+func traversal(address, attr string) hcl.Traversal {
+	typeName, label, _ := strings.Cut(address, ".")
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: label},
+		hcl.TraverseAttr{Name: attr},
+	}
+}
+
+// configurable reports whether an attribute can be set in the configuration and
+// should be written. Sensitive and write-only values are never returned by the
+// list APIs, so they are left out.
+func configurable(attr interface {
+	IsRequired() bool
+	IsOptional() bool
+	IsSensitive() bool
+	IsWriteOnly() bool
+}) bool {
+	return (attr.IsRequired() || attr.IsOptional()) && !attr.IsSensitive() && !attr.IsWriteOnly()
+}
+
+// jsonKey returns the JSON key an attribute is read from, such as "numOfNodes"
+// for num_of_nodes.
+func jsonKey(attr string) string {
+	if key, ok := jsonKeys[attr]; ok {
+		return key
+	}
+	parts := strings.Split(attr, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// convert converts a value decoded from JSON to the value of an attribute. It
+// returns false for null values, which are not written.
+func convert(attr schema.Attribute, value any) (cty.Value, bool) {
+	if value == nil {
+		return cty.NilVal, false
+	}
+
+	nested, isNested := attr.(schema.NestedAttribute)
+	_, isMap := attr.GetType().(types.MapType)
+
+	switch v := value.(type) {
+	case map[string]any:
+		if isMap {
+			elems := make(map[string]cty.Value, len(v))
+			for key, elem := range v {
+				if converted, ok := convertElement(nested, elem); ok {
+					elems[key] = converted
+				}
+			}
+			return cty.ObjectVal(elems), true
+		}
+		if isNested {
+			return convertObject(nested, v)
+		}
+		return cty.NilVal, false
+	case []any:
+		elems := make([]cty.Value, 0, len(v))
+		for _, elem := range v {
+			if converted, ok := convertElement(nested, elem); ok {
+				elems = append(elems, converted)
+			}
+		}
+		return cty.TupleVal(elems), true
+	default:
+		if isNested {
+			return cty.NilVal, false
+		}
+		return convertPrimitive(v)
+	}
+}
+
+// convertElement converts an element of a list, set or map attribute, which is an
+// object when the attribute is nested.
+func convertElement(nested schema.NestedAttribute, value any) (cty.Value, bool) {
+	if nested != nil {
+		return convertObject(nested, value)
+	}
+	return convertPrimitive(value)
+}
+
+// convertObject converts a JSON object to the value of a nested attribute object.
+func convertObject(nested schema.NestedAttribute, value any) (cty.Value, bool) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return cty.NilVal, false
+	}
+
+	attrs := make(map[string]cty.Value)
+	for name, nestedAttr := range nested.GetNestedObject().GetAttributes() {
+		attr, ok := nestedAttr.(schema.Attribute)
+		if !ok || !configurable(attr) {
+			continue
+		}
+		if converted, ok := convert(attr, object[jsonKey(name)]); ok {
+			attrs[name] = converted
+		}
+	}
+	return cty.ObjectVal(attrs), true
+}
+
+func convertPrimitive(value any) (cty.Value, bool) {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v), true
+	case bool:
+		return cty.BoolVal(v), true
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return cty.NumberIntVal(int64(v)), true
+		}
+		return cty.NumberFloatVal(v), true
+	default:
+		return cty.NilVal, false
+	}
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// label returns a resource label for the name that is not used yet by another
+// resource of the same type, such as "orders" or "orders_2".
+func (w *writer) label(typeName, name string) string {
+	base := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" {
+		base = "unnamed"
+	}
+	if base[0] >= '0' && base[0] <= '9' || base[0] == '-' {
+		base = "_" + base
+	}
+
+	used := w.labels[typeName]
+	if used == nil {
+		used = make(map[string]bool)
+		w.labels[typeName] = used
+	}
+
+	label := base
+	for i := 2; used[label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[label] = true
+	return label
+}
@@ -10,12 +10,16 @@ import (
 
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/datasources"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/ephemeralresources"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/functions"
 	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/resources"
 	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/version"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -25,7 +29,11 @@ import (
 )
 
 // Ensure the implementation satisfies the expected interfaces.
-var _ provider.Provider = &capellaProvider{}
+var (
+	_ provider.Provider                       = &capellaProvider{}
+	_ provider.ProviderWithEphemeralResources = &capellaProvider{}
+	_ provider.ProviderWithFunctions          = &capellaProvider{}
+)
 
 const (
 	capellaAuthenticationTokenField     = "authentication_token"
@@ -219,8 +227,8 @@ func (p *capellaProvider) Configure(
 		ClientV2: clientV2,
 	}
 
-	// Make the Capella client available during DataSource and Resource
-	// type Configure methods.
+	// Make the Capella client available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
 	//
 	// DataSourceData is provider-defined data, clients, etc. that is passed
 	// to [datasource.ConfigureRequest.ProviderData] for each DataSource type
@@ -230,6 +238,10 @@ func (p *capellaProvider) Configure(
 	// to [resource.ConfigureRequest.ProviderData] for each Resource type
 	// that implements the Configure method.
 	resp.ResourceData = providerData
+	// EphemeralResourceData is provider-defined data, clients, etc. that is passed
+	// to [ephemeral.ConfigureRequest.ProviderData] for each EphemeralResource type
+	// that implements the Configure method.
+	resp.EphemeralResourceData = providerData
 
 	tflog.Info(ctx, "Configured Capella client", map[string]any{"success": true})
 
@@ -279,6 +291,7 @@ func (p *capellaProvider) DataSources(_ context.Context) []func() datasource.Dat
 		datasources.NewProjectSnapshotBackups,
 		datasources.NewSnapshotBackup,
 		datasources.NewAppEndpointResync,
+		datasources.NewAppEndpointCollections,
 		datasources.NewAppEndpoints,
 		datasources.NewAppEndpoint,
 		datasources.NewAppEndpointActivationStatus,
@@ -290,6 +303,27 @@ func (p *capellaProvider) DataSources(_ context.Context) []func() datasource.Dat
 		datasources.NewEventingFunction,
 		datasources.NewEventingFunctions,
 		datasources.NewDataApi,
+		datasources.NewReplications,
+		datasources.NewCmekHistory,
+		datasources.NewAnalyticsBackups,
+		datasources.NewAnalyticsRestores,
+		datasources.NewAlertIntegrations,
+		datasources.NewAiWorkflowRuns,
+		datasources.NewAiWorkflowProcessedFiles,
+		datasources.NewAppEndpointAdminUsers,
+		datasources.NewAppServiceAWSPrivateEndpointCommand,
+		datasources.NewAppServiceAzurePrivateEndpointCommand,
+		datasources.NewAppServiceGCPPrivateEndpointCommand,
+		datasources.NewAppServiceAuditLogExports,
+		datasources.NewAppServiceAuditLogEventIDs,
+		datasources.NewDataAPIPrivateEndpointCommand,
+		datasources.NewCloudAccounts,
+		datasources.NewSnapshotBackupRegions,
+		datasources.NewBackupCycles,
+		datasources.NewAiProviders,
+		datasources.NewAiModel,
+		datasources.NewAnalyticsPrivateEndpoints,
+		datasources.NewAnalyticsPrivateEndpointCommand,
 	}
 }
 
@@ -340,5 +374,53 @@ func (p *capellaProvider) Resources(_ context.Context) []func() resource.Resourc
 		resources.NewClusterDeletionProtection,
 		resources.NewEventingFunction,
 		resources.NewDataApi,
+		resources.NewReplication,
+		resources.NewCmek,
+		resources.NewCmekClusterAssociation,
+		resources.NewAnalyticsCluster,
+		resources.NewAnalyticsAllowList,
+		resources.NewAnalyticsOnOffSchedule,
+		resources.NewAnalyticsBackup,
+		resources.NewAnalyticsBackupSchedule,
+		resources.NewAlertIntegration,
+		resources.NewMtlsCertificate,
+		resources.NewMtlsConfiguration,
+		resources.NewAiModel,
+		resources.NewAiModelAPIKey,
+		resources.NewAiWorkflow,
+		resources.NewAppServiceAdminUser,
+		resources.NewAppServicePrivateEndpointService,
+		resources.NewAppServicePrivateEndpoint,
+		resources.NewAppServiceAuditLogSettings,
+		resources.NewAppEndpointAuditLogSettings,
+		resources.NewAppServiceAuditLogStreaming,
+		resources.NewAppServiceAuditLogExport,
+		resources.NewDataAPIPrivateEndpoint,
+		resources.NewClusterClone,
+		resources.NewOrganizationConfiguration,
+		resources.NewBackupCycleRetention,
+		resources.NewAiProvider,
+		resources.NewAnalyticsPrivateEndpointService,
+		resources.NewAnalyticsPrivateEndpoint,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *capellaProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		ephemeralresources.NewDatabaseCredentialPassword,
+		ephemeralresources.NewApiKeyToken,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *capellaProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewImportId,
+		functions.NewParseImportId,
+		functions.NewConnectionString,
+		functions.NewKeyspace,
+		functions.NewValidCidr,
+		functions.NewValidClusterName,
 	}
 }
@@ -0,0 +1,655 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+const (
+	// aiModelTimeout is the default create, update and delete timeout of a model.
+	aiModelTimeout = 60 * time.Minute
+
+	// aiModelPollInterval is the time between model status checks.
+	aiModelPollInterval = 30 * time.Second
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AiModel{}
+	_ resource.ResourceWithConfigure   = &AiModel{}
+	_ resource.ResourceWithImportState = &AiModel{}
+)
+
+// AiModel is the AI model resource implementation.
+type AiModel struct {
+	*providerschema.Data
+}
+
+// NewAiModel is a helper function to simplify the provider implementation.
+func NewAiModel() resource.Resource {
+	return &AiModel{}
+}
+
+// Metadata returns the AI model resource type name.
+func (a *AiModel) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_model"
+}
+
+// Schema defines the schema for the AI model resource.
+func (a *AiModel) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AiModelSchema()
+}
+
+// Configure adds the provider configured client to the AI model resource.
+func (a *AiModel) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ImportState imports a remote AI model that is not created by Terraform.
+func (a *AiModel) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create deploys the model, waits for the deployment to finish and turns the model off
+// when the planned state is off.
+func (a *AiModel) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AiModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := plan.OrganizationId.ValueString()
+	orgUUID, err := utils.ParseUUID("organization_id", organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	createReq, diags := newAiModelCreateRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, aiModelTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	createResp, err := a.ClientV2.CreateModelWithResponse(ctx, orgUUID, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI model",
+			"Could not create AI model, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON202 == nil || createResp.JSON202.Id == nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI model",
+			fmt.Sprintf("Could not create AI model, unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	modelId := createResp.JSON202.Id.String()
+
+	// Save the ID so a model that fails to deploy is still tracked and can be destroyed.
+	initialState := plan
+	initialState.Id = types.StringValue(modelId)
+	initialState.Status = types.StringNull()
+	initialState.ConnectionString = types.StringNull()
+	if initialState.Optimization.IsUnknown() {
+		initialState.Optimization = types.StringNull()
+	}
+	if initialState.Quantization.IsUnknown() {
+		initialState.Quantization = types.StringNull()
+	}
+	if initialState.Dimensions.IsUnknown() {
+		initialState.Dimensions = types.Int64Null()
+	}
+	initialState.Audit = types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	diags = resp.State.Set(ctx, initialState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := a.waitForAiModelState(ctx, organizationId, modelId, providerschema.AiModelStateOn, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI model",
+			"Could not deploy AI model with ID "+modelId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	if plan.State.ValueString() == providerschema.AiModelStateOff {
+		refreshedState, err = a.switchAiModel(ctx, organizationId, modelId, providerschema.AiModelStateOff, &plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating AI model",
+				"AI model with ID "+modelId+" was deployed but could not be turned off: "+api.ParseError(err),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the AI model.
+func (a *AiModel) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AiModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AI Model in Capella",
+			"Could not read Capella AI model with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		modelId        = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := a.retrieveAiModel(ctx, organizationId, modelId, &state)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading AI Model in Capella",
+			"Could not read Capella AI model with ID "+modelId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update applies changed model settings and turns the model on or off when the planned state changes.
+func (a *AiModel) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.AiModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating AI model",
+			"Could not update AI model with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		modelId        = IDs[providerschema.Id]
+	)
+
+	planReq, diags := newAiModelUpdateRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	stateReq, diags := newAiModelUpdateRequest(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, aiModelTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	if !reflect.DeepEqual(planReq, stateReq) {
+		if err := a.updateAiModel(ctx, organizationId, modelId, planReq); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating AI model",
+				"Could not update AI model with ID "+modelId+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.State.ValueString() != state.State.ValueString() {
+		if _, err := a.switchAiModel(ctx, organizationId, modelId, plan.State.ValueString(), &plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating AI model",
+				"Could not turn AI model with ID "+modelId+" "+plan.State.ValueString()+": "+api.ParseError(err),
+			)
+			return
+		}
+	}
+
+	refreshedState, err := a.retrieveAiModel(ctx, organizationId, modelId, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating AI model",
+			"Could not read AI model with ID "+modelId+" after update: "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete destroys the model and waits for it to be removed.
+func (a *AiModel) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AiModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Model in Capella",
+			"Could not delete Capella AI model with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		modelId        = IDs[providerschema.Id]
+	)
+
+	orgUUID, modelUUID, err := parseAiModelUUIDs(organizationId, modelId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, aiModelTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	deleteResp, err := a.ClientV2.DestroyModelWithResponse(ctx, orgUUID, modelUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Model in Capella",
+			"Could not delete Capella AI model with ID "+modelId+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Model in Capella",
+			fmt.Sprintf("Could not delete Capella AI model with ID %s, unexpected response status %d: %s", modelId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+		return
+	}
+
+	if err := a.waitForAiModelDestroyed(ctx, organizationId, modelId); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Model in Capella",
+			"Could not destroy Capella AI model with ID "+modelId+": "+api.ParseError(err),
+		)
+	}
+}
+
+// switchAiModel turns the model on or off and waits for it to reach that state.
+// A model that is already in the requested state is left as it is.
+func (a *AiModel) switchAiModel(
+	ctx context.Context,
+	organizationId, modelId, state string,
+	prior *providerschema.AiModel,
+) (*providerschema.AiModel, error) {
+	orgUUID, modelUUID, err := parseAiModelUUIDs(organizationId, modelId)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		statusCode int
+		body       []byte
+	)
+	switch state {
+	case providerschema.AiModelStateOn:
+		onResp, err := a.ClientV2.ModelOnWithResponse(ctx, orgUUID, modelUUID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body = onResp.StatusCode(), onResp.Body
+	case providerschema.AiModelStateOff:
+		offResp, err := a.ClientV2.ModelOffWithResponse(ctx, orgUUID, modelUUID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body = offResp.StatusCode(), offResp.Body
+	default:
+		return nil, fmt.Errorf("invalid state value %q: state must be either 'on' or 'off'", state)
+	}
+
+	switch statusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	case http.StatusConflict:
+		// Treat "already in desired state" as idempotent success. Any other conflict,
+		// such as the model still deploying, is reported by the wait below.
+		tflog.Info(ctx, "AI model is already switching or in the requested state", map[string]interface{}{
+			"state": state,
+		})
+	default:
+		return nil, fmt.Errorf("unexpected response status %d: %s", statusCode, string(body))
+	}
+
+	return a.waitForAiModelState(ctx, organizationId, modelId, state, prior)
+}
+
+// waitForAiModelState polls the model until it settles in the requested on/off state,
+// or ctx is done, then returns the refreshed resource state. A failed deployment, pause or resume is
+// returned as an error.
+func (a *AiModel) waitForAiModelState(
+	ctx context.Context,
+	organizationId, modelId, state string,
+	prior *providerschema.AiModel,
+) (*providerschema.AiModel, error) {
+	ticker := time.NewTicker(aiModelPollInterval)
+	defer ticker.Stop()
+
+	for {
+		refreshedState, err := a.retrieveAiModel(ctx, organizationId, modelId, prior)
+		if err != nil {
+			return nil, err
+		}
+
+		status := refreshedState.Status.ValueString()
+		switch {
+		case strings.HasSuffix(status, "Failed"):
+			return nil, fmt.Errorf("model status is %s", status)
+		case !isAiModelTransitioning(status) && refreshedState.State.ValueString() == state:
+			return refreshedState, nil
+		default:
+			tflog.Info(ctx, "waiting for AI model to be "+state, map[string]interface{}{
+				"status": status,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out while waiting for the AI model to be %s: %w", state, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForAiModelDestroyed polls the model until Capella no longer returns it or ctx is done.
+func (a *AiModel) waitForAiModelDestroyed(ctx context.Context, organizationId, modelId string) error {
+	ticker := time.NewTicker(aiModelPollInterval)
+	defer ticker.Stop()
+
+	for {
+		refreshedState, err := a.retrieveAiModel(ctx, organizationId, modelId, nil)
+		switch {
+		case err == errors.ErrNotFound:
+			return nil
+		case err != nil:
+			return err
+		case refreshedState.Status.ValueString() == "destroyFailed":
+			return fmt.Errorf("model status is %s", refreshedState.Status.ValueString())
+		default:
+			tflog.Info(ctx, "waiting for AI model to be destroyed", map[string]interface{}{
+				"status": refreshedState.Status.ValueString(),
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out while waiting for the AI model to be destroyed: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// isAiModelTransitioning reports whether the model is between states.
+func isAiModelTransitioning(status string) bool {
+	switch status {
+	case "deploying", "destroying", "pausing", "resuming":
+		return true
+	default:
+		return false
+	}
+}
+
+// updateAiModel sends the changed model settings to Capella.
+func (a *AiModel) updateAiModel(ctx context.Context, organizationId, modelId string, request apigen.UpdateModelRequest) error {
+	orgUUID, modelUUID, err := parseAiModelUUIDs(organizationId, modelId)
+	if err != nil {
+		return err
+	}
+
+	updateResp, err := a.ClientV2.PutModelWithResponse(ctx, orgUUID, modelUUID, &apigen.PutModelParams{}, request)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch updateResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", updateResp.StatusCode(), string(updateResp.Body))
+	}
+}
+
+// retrieveAiModel retrieves the model and converts it into Terraform state.
+// errors.ErrNotFound is returned when the model does not exist.
+func (a *AiModel) retrieveAiModel(
+	ctx context.Context,
+	organizationId, modelId string,
+	prior *providerschema.AiModel,
+) (*providerschema.AiModel, error) {
+	orgUUID, modelUUID, err := parseAiModelUUIDs(organizationId, modelId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetModelWithResponse(ctx, orgUUID, modelUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	model := getResp.JSON200
+
+	auditObj := types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	if model.Model != nil && model.Model.Audit != nil {
+		audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(*model.Model.Audit))
+		var diags diag.Diagnostics
+		auditObj, diags = types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+		if diags.HasError() {
+			return nil, errors.ErrUnableToConvertAuditData
+		}
+	}
+
+	refreshedState := providerschema.NewAiModel(*model, organizationId, prior, auditObj)
+	if refreshedState.Id.IsNull() {
+		refreshedState.Id = types.StringValue(modelId)
+	}
+
+	return refreshedState, nil
+}
+
+// parseAiModelUUIDs parses the organization and model IDs into UUIDs for the generated API client.
+func parseAiModelUUIDs(organizationId, modelId string) (uuid.UUID, uuid.UUID, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "id", Value: modelId},
+	)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids[0], uuids[1], nil
+}
+
+// newAiModelCreateRequest builds the create request body from the plan.
+func newAiModelCreateRequest(ctx context.Context, plan providerschema.AiModel) (apigen.CreateModelJSONRequestBody, diag.Diagnostics) {
+	update, diags := newAiModelUpdateRequest(ctx, plan)
+
+	request := apigen.CreateModelJSONRequestBody{
+		Name:             plan.Name.ValueString(),
+		CatalogModelName: plan.CatalogModelName.ValueString(),
+		Caching:          update.Caching,
+		EnableBatching:   update.EnableBatching,
+		Guardrails:       update.Guardrails,
+		Jailbreak:        update.Jailbreak,
+		KeywordFiltering: update.KeywordFiltering,
+	}
+
+	if plan.CloudConfig != nil {
+		request.CloudConfig.Provider = apigen.CloudConfigProvider(plan.CloudConfig.Provider.ValueString())
+		request.CloudConfig.Region = plan.CloudConfig.Region.ValueString()
+		request.CloudConfig.Compute.Cpu = apigen.CloudConfigComputeCpu(plan.CloudConfig.Compute.Cpu.ValueInt64())
+		request.CloudConfig.Compute.GpuMemory = apigen.CloudConfigComputeGpuMemory(plan.CloudConfig.Compute.GpuMemory.ValueInt64())
+	}
+
+	if !plan.Optimization.IsNull() && !plan.Optimization.IsUnknown() {
+		optimization := apigen.CreateModelJSONBodyOptimization(plan.Optimization.ValueString())
+		request.Optimization = &optimization
+	}
+	if !plan.Quantization.IsNull() && !plan.Quantization.IsUnknown() {
+		quantization := apigen.CreateModelJSONBodyQuantization(plan.Quantization.ValueString())
+		request.Quantization = &quantization
+	}
+	if !plan.Dimensions.IsNull() && !plan.Dimensions.IsUnknown() {
+		dimensions := int(plan.Dimensions.ValueInt64())
+		request.Dimensions = &dimensions
+	}
+
+	return request, diags
+}
+
+// newAiModelUpdateRequest builds the update request body from the model settings that can be changed in place.
+func newAiModelUpdateRequest(ctx context.Context, model providerschema.AiModel) (apigen.UpdateModelRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := apigen.UpdateModelRequest{
+		Name:           model.Name.ValueStringPointer(),
+		EnableBatching: model.EnableBatching.ValueBoolPointer(),
+	}
+
+	if !model.Guardrails.IsNull() && !model.Guardrails.IsUnknown() {
+		var guardrails []string
+		diags.Append(model.Guardrails.ElementsAs(ctx, &guardrails, false)...)
+		request.Guardrails = &guardrails
+	}
+	if !model.KeywordFiltering.IsNull() && !model.KeywordFiltering.IsUnknown() {
+		var keywords []string
+		diags.Append(model.KeywordFiltering.ElementsAs(ctx, &keywords, false)...)
+		request.KeywordFiltering = &keywords
+	}
+
+	if model.Jailbreak != nil {
+		request.Jailbreak = &struct {
+			ScoreThreshold *float64 `json:"scoreThreshold,omitempty"`
+		}{
+			ScoreThreshold: model.Jailbreak.ScoreThreshold.ValueFloat64Pointer(),
+		}
+	}
+
+	if caching := model.Caching; caching != nil {
+		request.Caching = &apigen.Caching{
+			EnableConversational: caching.EnableConversational.ValueBoolPointer(),
+			EnableStandard:       caching.EnableStandard.ValueBoolPointer(),
+		}
+		if !caching.DefaultCache.IsNull() && !caching.DefaultCache.IsUnknown() {
+			defaultCache := apigen.CachingDefaultCache(caching.DefaultCache.ValueString())
+			request.Caching.DefaultCache = &defaultCache
+		}
+		if !caching.ExpiryTtl.IsNull() && !caching.ExpiryTtl.IsUnknown() {
+			expiryTtl := int(caching.ExpiryTtl.ValueInt64())
+			request.Caching.ExpiryTTL = &expiryTtl
+		}
+		if semantic := caching.Semantic; semantic != nil {
+			request.Caching.Semantic = &struct {
+				Dimensions     *int     `json:"dimensions,omitempty"`
+				DistanceMetric *string  `json:"distanceMetric,omitempty"`
+				EmbeddingModel *string  `json:"embeddingModel,omitempty"`
+				ScoreThreshold *float32 `json:"scoreThreshold,omitempty"`
+			}{
+				DistanceMetric: semantic.DistanceMetric.ValueStringPointer(),
+				EmbeddingModel: semantic.EmbeddingModel.ValueStringPointer(),
+			}
+			if !semantic.Dimensions.IsNull() && !semantic.Dimensions.IsUnknown() {
+				dimensions := int(semantic.Dimensions.ValueInt64())
+				request.Caching.Semantic.Dimensions = &dimensions
+			}
+			if !semantic.ScoreThreshold.IsNull() && !semantic.ScoreThreshold.IsUnknown() {
+				scoreThreshold := float32(semantic.ScoreThreshold.ValueFloat64())
+				request.Caching.Semantic.ScoreThreshold = &scoreThreshold
+			}
+		}
+	}
+
+	return request, diags
+}
@@ -0,0 +1,297 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AiModelAPIKey{}
+	_ resource.ResourceWithConfigure   = &AiModelAPIKey{}
+	_ resource.ResourceWithImportState = &AiModelAPIKey{}
+)
+
+// AiModelAPIKey is the AI model API key resource implementation.
+type AiModelAPIKey struct {
+	*providerschema.Data
+}
+
+// NewAiModelAPIKey is a helper function to simplify the provider implementation.
+func NewAiModelAPIKey() resource.Resource {
+	return &AiModelAPIKey{}
+}
+
+// Metadata returns the AI model API key resource type name.
+func (a *AiModelAPIKey) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_model_api_key"
+}
+
+// Schema defines the schema for the AI model API key resource.
+func (a *AiModelAPIKey) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AiModelAPIKeySchema()
+}
+
+// Configure adds the provider configured client to the AI model API key resource.
+func (a *AiModelAPIKey) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ImportState imports a remote AI model API key that is not created by Terraform.
+// The token is only returned at creation, so it is null for an imported key.
+func (a *AiModelAPIKey) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create creates the API key and stores the token returned with it.
+func (a *AiModelAPIKey) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AiModelAPIKey
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := plan.OrganizationId.ValueString()
+	orgUUID, err := utils.ParseUUID("organization_id", organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	createReq, diags := newAiModelAPIKeyRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := a.ClientV2.CreateModelAPIKeyWithResponse(ctx, orgUUID, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI model API key",
+			"Could not create AI model API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON201 == nil || createResp.JSON201.Id == nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI model API key",
+			fmt.Sprintf("Could not create AI model API key, unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	var (
+		apiKeyId = *createResp.JSON201.Id
+		token    = types.StringPointerValue(createResp.JSON201.Token)
+	)
+
+	refreshedState, err := a.retrieveAiModelAPIKey(ctx, organizationId, apiKeyId, token, &plan)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error reading AI model API key",
+			"Could not read AI model API key with ID "+apiKeyId+": "+api.ParseError(err),
+		)
+
+		// Keep the token, which cannot be retrieved again, even when the read fails.
+		plan.Id = types.StringValue(apiKeyId)
+		plan.Token = token
+		plan.Audit = types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the API key. The token is carried over from state.
+func (a *AiModelAPIKey) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AiModelAPIKey
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AI Model API Key in Capella",
+			"Could not read Capella AI model API key with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		apiKeyId       = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := a.retrieveAiModelAPIKey(ctx, organizationId, apiKeyId, state.Token, &state)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading AI Model API Key in Capella",
+			"Could not read Capella AI model API key with ID "+apiKeyId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is not supported for AI model API keys.
+func (a *AiModelAPIKey) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// From https://developer.hashicorp.com/terraform/plugin/framework/resources/update#caveats
+	// If the resource does not support modification and should always be recreated on configuration value updates,
+	// the Update logic can be left empty and ensure all configurable schema attributes
+	// implement the resource.RequiresReplace() attribute plan modifier.
+}
+
+// Delete deletes the API key.
+func (a *AiModelAPIKey) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AiModelAPIKey
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Model API Key in Capella",
+			"Could not delete Capella AI model API key with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		apiKeyId       = IDs[providerschema.Id]
+	)
+
+	orgUUID, apiKeyUUID, err := parseAiModelUUIDs(organizationId, apiKeyId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	deleteResp, err := a.ClientV2.DeleteModelAPIKeyWithResponse(ctx, orgUUID, apiKeyUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Model API Key in Capella",
+			"Could not delete Capella AI model API key with ID "+apiKeyId+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Model API Key in Capella",
+			fmt.Sprintf("Could not delete Capella AI model API key with ID %s, unexpected response status %d: %s", apiKeyId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// retrieveAiModelAPIKey retrieves the API key and converts it into Terraform state.
+// errors.ErrNotFound is returned when the API key does not exist.
+func (a *AiModelAPIKey) retrieveAiModelAPIKey(
+	ctx context.Context,
+	organizationId, apiKeyId string,
+	token types.String,
+	prior *providerschema.AiModelAPIKey,
+) (*providerschema.AiModelAPIKey, error) {
+	orgUUID, apiKeyUUID, err := parseAiModelUUIDs(organizationId, apiKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetModelAPIKeyWithResponse(ctx, orgUUID, apiKeyUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	apiKey := getResp.JSON200
+
+	auditObj := types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	if apiKey.Audit != nil {
+		audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(*apiKey.Audit))
+		var diags diag.Diagnostics
+		auditObj, diags = types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+		if diags.HasError() {
+			return nil, errors.ErrUnableToConvertAuditData
+		}
+	}
+
+	return providerschema.NewAiModelAPIKey(*apiKey, organizationId, apiKeyId, token, prior, auditObj), nil
+}
+
+// newAiModelAPIKeyRequest builds the create request body from the plan.
+func newAiModelAPIKeyRequest(ctx context.Context, plan providerschema.AiModelAPIKey) (apigen.CreateLanguageModelAPIKeyRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := apigen.CreateLanguageModelAPIKeyRequest{
+		Name:   plan.Name.ValueString(),
+		Region: plan.Region.ValueString(),
+		Expiry: float32(plan.Expiry.ValueInt64()),
+	}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		request.Description = plan.Description.ValueStringPointer()
+	}
+
+	diags.Append(plan.AllowedCIDRs.ElementsAs(ctx, &request.AllowedCIDRs, false)...)
+
+	if !plan.AllowedModels.IsNull() && !plan.AllowedModels.IsUnknown() {
+		var models []string
+		diags.Append(plan.AllowedModels.ElementsAs(ctx, &models, false)...)
+		request.AllowedModels = &models
+	}
+
+	return request, diags
+}
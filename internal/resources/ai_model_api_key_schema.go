@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var aiModelAPIKeyBuilder = capellaschema.NewSchemaBuilder("aiModelApiKey", "CreateLanguageModelAPIKeyRequest")
+
+// AiModelAPIKeySchema returns the schema for the ai_model_api_key resource.
+func AiModelAPIKeySchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", aiModelAPIKeyBuilder, stringAttribute([]string{computed, useStateForUnknown}), "CreateLanguageModelAPIKeyResponse")
+	capellaschema.AddAttr(attrs, "organization_id", aiModelAPIKeyBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "name", aiModelAPIKeyBuilder, stringAttribute([]string{required, requiresReplace}))
+	capellaschema.AddAttr(attrs, "description", aiModelAPIKeyBuilder, stringDefaultAttribute("", optional, computed, requiresReplace, useStateForUnknown))
+	capellaschema.AddAttr(attrs, "region", aiModelAPIKeyBuilder, stringAttribute([]string{required, requiresReplace}))
+
+	expiryAttr := int64Attribute(required, requiresReplace)
+	expiryAttr.Validators = []validator.Int64{int64validator.Between(1, 365)}
+	capellaschema.AddAttr(attrs, "expiry", aiModelAPIKeyBuilder, expiryAttr)
+
+	allowedCIDRsAttr := stringSetAttribute(required, requiresReplace)
+	allowedCIDRsAttr.Validators = []validator.Set{setvalidator.SizeAtLeast(1)}
+	capellaschema.AddAttr(attrs, "allowed_cidrs", aiModelAPIKeyBuilder, allowedCIDRsAttr)
+	capellaschema.AddAttr(attrs, "allowed_models", aiModelAPIKeyBuilder, stringSetAttribute(optional, requiresReplace))
+
+	// Token field - description from CreateLanguageModelAPIKeyResponse schema
+	capellaschema.AddAttr(attrs, "token", aiModelAPIKeyBuilder, stringAttribute([]string{computed, sensitive, useStateForUnknown}), "CreateLanguageModelAPIKeyResponse")
+
+	capellaschema.AddAttr(attrs, "audit", aiModelAPIKeyBuilder, computedAuditAttribute())
+
+	return schema.Schema{
+		MarkdownDescription: "Manages an API key used to call the models hosted in a Capella AI Services region. " +
+			"The token is only returned when the key is created, and is not available after import.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,128 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var aiModelBuilder = capellaschema.NewSchemaBuilder("aiModel", "UpdateModelRequest")
+
+// AiModelSchema returns the schema for the ai_model resource.
+func AiModelSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", aiModelBuilder, stringAttribute([]string{computed, useStateForUnknown}), "GetLanguageModelResponse")
+	capellaschema.AddAttr(attrs, "organization_id", aiModelBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "name", aiModelBuilder, requiredStringAttributeNoReplace())
+	capellaschema.AddAttr(attrs, "catalog_model_name", aiModelBuilder, requiredNonEmptyStringAttribute(), "Config")
+
+	computeAttrs := make(map[string]schema.Attribute)
+	cpuAttr := int64Attribute(required)
+	cpuAttr.Validators = []validator.Int64{int64validator.OneOf(
+		int64(apigen.CloudConfigComputeCpuN4),
+		int64(apigen.CloudConfigComputeCpuN32),
+	)}
+	capellaschema.AddAttr(computeAttrs, "cpu", aiModelBuilder, cpuAttr, "CloudConfig")
+	gpuMemoryAttr := int64Attribute(required)
+	gpuMemoryAttr.Validators = []validator.Int64{int64validator.OneOf(
+		int64(apigen.N24),
+		int64(apigen.N48),
+		int64(apigen.N192),
+	)}
+	capellaschema.AddAttr(computeAttrs, "gpu_memory", aiModelBuilder, gpuMemoryAttr, "CloudConfig")
+
+	cloudConfigAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(cloudConfigAttrs, "provider", aiModelBuilder, stringAttribute([]string{required}, stringvalidator.OneOf(
+		string(apigen.CloudConfigProviderAws),
+		string(apigen.CloudConfigProviderAzure),
+		string(apigen.CloudConfigProviderGcp),
+	)), "CloudConfig")
+	capellaschema.AddAttr(cloudConfigAttrs, "region", aiModelBuilder, stringAttribute([]string{required}), "CloudConfig")
+	capellaschema.AddAttr(cloudConfigAttrs, "compute", aiModelBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: computeAttrs,
+	}, "CloudConfig")
+
+	capellaschema.AddAttr(attrs, "cloud_config", aiModelBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: cloudConfigAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}, "GetLanguageModelResponse")
+
+	// Capella picks defaults for the deployment profile when these are omitted.
+	capellaschema.AddAttr(attrs, "optimization", aiModelBuilder, stringAttribute([]string{optional, computed, requiresReplace, useStateForUnknown}, stringvalidator.OneOf(
+		string(apigen.CreateModelJSONBodyOptimizationLatency),
+		string(apigen.CreateModelJSONBodyOptimizationThroughput),
+	)), "Config")
+	capellaschema.AddAttr(attrs, "quantization", aiModelBuilder, stringAttribute([]string{optional, computed, requiresReplace, useStateForUnknown}, stringvalidator.OneOf(
+		string(apigen.CreateModelJSONBodyQuantizationFp16),
+		string(apigen.CreateModelJSONBodyQuantizationFp8),
+		string(apigen.CreateModelJSONBodyQuantizationFullPrecision),
+	)), "Config")
+	capellaschema.AddAttr(attrs, "dimensions", aiModelBuilder, int64Attribute(optional, computed, requiresReplace, useStateForUnknown), "Config")
+
+	capellaschema.AddAttr(attrs, "enable_batching", aiModelBuilder, boolDefaultAttribute(false, optional, computed))
+	capellaschema.AddAttr(attrs, "guardrails", aiModelBuilder, stringSetAttribute(optional))
+	capellaschema.AddAttr(attrs, "keyword_filtering", aiModelBuilder, stringSetAttribute(optional))
+
+	jailbreakAttrs := make(map[string]schema.Attribute)
+	scoreThresholdAttr := float64Attribute(required)
+	scoreThresholdAttr.Validators = []validator.Float64{float64validator.Between(0, 1)}
+	capellaschema.AddAttr(jailbreakAttrs, "score_threshold", aiModelBuilder, scoreThresholdAttr)
+	capellaschema.AddAttr(attrs, "jailbreak", aiModelBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: jailbreakAttrs,
+	})
+
+	semanticAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(semanticAttrs, "dimensions", aiModelBuilder, int64Attribute(optional), "Caching")
+	capellaschema.AddAttr(semanticAttrs, "distance_metric", aiModelBuilder, stringAttribute([]string{optional}), "Caching")
+	capellaschema.AddAttr(semanticAttrs, "embedding_model", aiModelBuilder, stringAttribute([]string{optional}), "Caching")
+	capellaschema.AddAttr(semanticAttrs, "score_threshold", aiModelBuilder, float64Attribute(optional), "Caching")
+
+	cachingAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(cachingAttrs, "default_cache", aiModelBuilder, stringAttribute([]string{optional}, stringvalidator.OneOf(
+		string(apigen.Semantic),
+		string(apigen.Standard),
+	)), "Caching")
+	capellaschema.AddAttr(cachingAttrs, "enable_conversational", aiModelBuilder, boolAttribute(optional), "Caching")
+	capellaschema.AddAttr(cachingAttrs, "enable_standard", aiModelBuilder, boolAttribute(optional), "Caching")
+	capellaschema.AddAttr(cachingAttrs, "expiry_ttl", aiModelBuilder, int64Attribute(optional), "Caching")
+	capellaschema.AddAttr(cachingAttrs, "semantic", aiModelBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: semanticAttrs,
+	}, "Caching")
+	capellaschema.AddAttr(attrs, "caching", aiModelBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: cachingAttrs,
+	})
+
+	// state is not part of the model API. It drives the model on and off endpoints.
+	stateAttr := stringDefaultAttribute(capellaschema.AiModelStateOn, optional, computed)
+	stateAttr.Validators = append(stateAttr.Validators, stringvalidator.OneOf(capellaschema.AiModelStateOn, capellaschema.AiModelStateOff))
+	stateAttr.MarkdownDescription = "Whether the model is `on` or `off`. Turning a model off pauses it until it is turned back on."
+	capellaschema.AddAttr(attrs, "state", aiModelBuilder, stateAttr)
+
+	capellaschema.AddAttr(attrs, "status", aiModelBuilder, stringAttribute([]string{computed}), "GetLanguageModelResponse")
+	capellaschema.AddAttr(attrs, "connection_string", aiModelBuilder, stringAttribute([]string{computed, useStateForUnknown}), "GetLanguageModelResponse")
+	capellaschema.AddAttr(attrs, "audit", aiModelBuilder, computedAuditAttribute())
+
+	return schema.Schema{
+		MarkdownDescription: "Manages a model hosted in Capella AI Services. " +
+			"Changing the catalog model, cloud configuration or deployment profile redeploys the model.",
+		Attributes: attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
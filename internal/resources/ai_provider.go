@@ -0,0 +1,474 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &AiProvider{}
+	_ resource.ResourceWithConfigure      = &AiProvider{}
+	_ resource.ResourceWithImportState    = &AiProvider{}
+	_ resource.ResourceWithValidateConfig = &AiProvider{}
+)
+
+// AiProvider is the AI provider resource implementation.
+type AiProvider struct {
+	*providerschema.Data
+}
+
+// NewAiProvider is a helper function to simplify the provider implementation.
+func NewAiProvider() resource.Resource {
+	return &AiProvider{}
+}
+
+// Metadata returns the AI provider resource type name.
+func (a *AiProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_provider"
+}
+
+// Schema defines the schema for the AI provider resource.
+func (a *AiProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AiProviderSchema()
+}
+
+// Configure adds the provider configured client to the AI provider resource.
+func (a *AiProvider) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ValidateConfig checks that the attributes needed by the configured provider type are set,
+// and that attributes belonging to other provider types are not.
+func (a *AiProvider) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config providerschema.AiProvider
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+
+	var (
+		requiredAttrs  []string
+		forbiddenAttrs []string
+		values         = map[string]types.String{
+			"aws_region":           config.AwsRegion,
+			"bucket":               config.Bucket,
+			"folder_path":          config.FolderPath,
+			"api_key_wo":           config.ApiKeyWo,
+			"access_key_id_wo":     config.AccessKeyIdWo,
+			"secret_access_key_wo": config.SecretAccessKeyWo,
+			"session_token_wo":     config.SessionTokenWo,
+		}
+	)
+
+	switch providerType := config.Type.ValueString(); providerType {
+	case providerschema.AiProviderTypeAwsS3:
+		requiredAttrs = []string{"aws_region", "bucket", "access_key_id_wo", "secret_access_key_wo"}
+		forbiddenAttrs = []string{"api_key_wo"}
+	case providerschema.AiProviderTypeOpenAI:
+		requiredAttrs = []string{"api_key_wo"}
+		forbiddenAttrs = []string{"aws_region", "bucket", "folder_path", "access_key_id_wo", "secret_access_key_wo", "session_token_wo"}
+	case providerschema.AiProviderTypeAwsBedrock:
+		requiredAttrs = []string{"access_key_id_wo", "secret_access_key_wo"}
+		forbiddenAttrs = []string{"aws_region", "bucket", "folder_path", "api_key_wo", "session_token_wo"}
+	default:
+		return
+	}
+
+	for _, name := range requiredAttrs {
+		if values[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing AI Provider Attribute",
+				fmt.Sprintf("%s is required when type is %s", name, config.Type.ValueString()),
+			)
+		}
+	}
+	for _, name := range forbiddenAttrs {
+		if !values[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid AI Provider Attribute",
+				fmt.Sprintf("%s cannot be set when type is %s", name, config.Type.ValueString()),
+			)
+		}
+	}
+}
+
+// ImportState imports a remote AI provider that is not created by Terraform.
+// The credentials are write-only, so they are not available after import.
+func (a *AiProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create registers the provider with the credentials from the configuration.
+func (a *AiProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config providerschema.AiProvider
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Write-only values are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := plan.OrganizationId.ValueString()
+	orgUUID, err := utils.ParseUUID("organization_id", organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	createReq, err := newAiProviderCreateRequest(plan, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI provider",
+			"Could not build AI provider configuration: "+err.Error(),
+		)
+		return
+	}
+
+	createResp, err := a.ClientV2.CreateProviderWithResponse(ctx, orgUUID, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI provider",
+			"Could not create AI provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON201 == nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI provider",
+			fmt.Sprintf("Could not create AI provider, unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	providerId := createResp.JSON201.Id
+
+	refreshedState, err := a.retrieveAiProvider(ctx, organizationId, providerId, plan.CredentialsWoVersion)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error reading AI provider",
+			"Could not read AI provider with ID "+providerId+": "+api.ParseError(err),
+		)
+
+		plan.Id = types.StringValue(providerId)
+		plan.Audit = types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+		diags := resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags := resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the AI provider. Credentials are never returned by Capella.
+func (a *AiProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AiProvider
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AI Provider in Capella",
+			"Could not read Capella AI provider with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		providerId     = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := a.retrieveAiProvider(ctx, organizationId, providerId, state.CredentialsWoVersion)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading AI Provider in Capella",
+			"Could not read Capella AI provider with ID "+providerId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the S3 location of the provider, and sends the credentials again
+// when credentials_wo_version has changed.
+func (a *AiProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config providerschema.AiProvider
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating AI Provider in Capella",
+			"Could not update Capella AI provider with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId    = IDs[providerschema.OrganizationId]
+		providerId        = IDs[providerschema.Id]
+		sendCredentials   = !plan.CredentialsWoVersion.Equal(state.CredentialsWoVersion)
+		locationChanged   = !plan.AwsRegion.Equal(state.AwsRegion) || !plan.Bucket.Equal(state.Bucket) || !plan.FolderPath.Equal(state.FolderPath)
+		isS3Configuration = plan.Type.ValueString() == providerschema.AiProviderTypeAwsS3
+	)
+
+	if sendCredentials || (isS3Configuration && locationChanged) {
+		orgUUID, err := uuid.Parse(organizationId)
+		if err != nil {
+			resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+			return
+		}
+
+		updateReq, err := newAiProviderUpdateRequest(plan, config, sendCredentials)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating AI Provider in Capella",
+				"Could not build AI provider configuration: "+err.Error(),
+			)
+			return
+		}
+
+		updateResp, err := a.ClientV2.UpdateProviderWithResponse(ctx, orgUUID, providerId, &apigen.UpdateProviderParams{}, updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating AI Provider in Capella",
+				"Could not update Capella AI provider with ID "+providerId+": "+err.Error(),
+			)
+			return
+		}
+
+		switch updateResp.StatusCode() {
+		case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+		default:
+			resp.Diagnostics.AddError(
+				"Error Updating AI Provider in Capella",
+				fmt.Sprintf("Could not update Capella AI provider with ID %s, unexpected response status %d: %s", providerId, updateResp.StatusCode(), string(updateResp.Body)),
+			)
+			return
+		}
+	}
+
+	refreshedState, err := a.retrieveAiProvider(ctx, organizationId, providerId, plan.CredentialsWoVersion)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating AI Provider in Capella",
+			"Could not read Capella AI provider with ID "+providerId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the AI provider.
+func (a *AiProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AiProvider
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Provider in Capella",
+			"Could not delete Capella AI provider with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		providerId     = IDs[providerschema.Id]
+	)
+
+	orgUUID, err := uuid.Parse(organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	deleteResp, err := a.ClientV2.DeleteProviderWithResponse(ctx, orgUUID, providerId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Provider in Capella",
+			"Could not delete Capella AI provider with ID "+providerId+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Provider in Capella",
+			fmt.Sprintf("Could not delete Capella AI provider with ID %s, unexpected response status %d: %s", providerId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// retrieveAiProvider retrieves the AI provider and converts it into Terraform state.
+// errors.ErrNotFound is returned when the provider does not exist.
+func (a *AiProvider) retrieveAiProvider(
+	ctx context.Context,
+	organizationId, providerId string,
+	credentialsWoVersion types.Int64,
+) (*providerschema.AiProvider, error) {
+	orgUUID, err := uuid.Parse(organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetProviderWithResponse(ctx, orgUUID, providerId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	provider := getResp.JSON200
+
+	auditObj := types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	if provider.Audit != nil {
+		audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(*provider.Audit))
+		var diags diag.Diagnostics
+		auditObj, diags = types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+		if diags.HasError() {
+			return nil, errors.ErrUnableToConvertAuditData
+		}
+	}
+
+	return providerschema.NewAiProvider(*provider, organizationId, providerId, credentialsWoVersion, auditObj), nil
+}
+
+// newAiProviderCreateRequest builds the create request body from the plan,
+// taking the write-only credentials from the configuration.
+func newAiProviderCreateRequest(plan, config providerschema.AiProvider) (apigen.CreateProviderRequest, error) {
+	request := apigen.CreateProviderRequest{
+		Name: plan.Name.ValueString(),
+		Type: apigen.CreateProviderRequestType(plan.Type.ValueString()),
+	}
+
+	var err error
+	switch plan.Type.ValueString() {
+	case providerschema.AiProviderTypeAwsS3:
+		err = request.Configuration.FromCreateS3ConfigurationRequest(apigen.CreateS3ConfigurationRequest{
+			AwsRegion:       plan.AwsRegion.ValueString(),
+			Bucket:          plan.Bucket.ValueString(),
+			FolderPath:      plan.FolderPath.ValueStringPointer(),
+			AccessKeyId:     config.AccessKeyIdWo.ValueString(),
+			SecretAccessKey: config.SecretAccessKeyWo.ValueString(),
+			SessionToken:    config.SessionTokenWo.ValueStringPointer(),
+		})
+	case providerschema.AiProviderTypeOpenAI:
+		err = request.Configuration.FromCreateOpenAIConfigurationRequest(apigen.CreateOpenAIConfigurationRequest{
+			ApiKey: config.ApiKeyWo.ValueString(),
+		})
+	case providerschema.AiProviderTypeAwsBedrock:
+		err = request.Configuration.FromCreateBedrockConfigurationRequest(apigen.CreateBedrockConfigurationRequest{
+			AccessKeyId:     config.AccessKeyIdWo.ValueString(),
+			SecretAccessKey: config.SecretAccessKeyWo.ValueString(),
+		})
+	default:
+		err = fmt.Errorf("unsupported provider type %s", plan.Type.ValueString())
+	}
+
+	return request, err
+}
+
+// newAiProviderUpdateRequest builds the update request body from the plan. The write-only
+// credentials are taken from the configuration and only included when sendCredentials is true.
+func newAiProviderUpdateRequest(plan, config providerschema.AiProvider, sendCredentials bool) (apigen.UpdateProviderRequest, error) {
+	var (
+		request apigen.UpdateProviderRequest
+		err     error
+	)
+
+	switch plan.Type.ValueString() {
+	case providerschema.AiProviderTypeAwsS3:
+		s3 := apigen.UpdateS3ConfigurationRequest{
+			AwsRegion:  plan.AwsRegion.ValueStringPointer(),
+			Bucket:     plan.Bucket.ValueStringPointer(),
+			FolderPath: plan.FolderPath.ValueStringPointer(),
+		}
+		if sendCredentials {
+			s3.AccessKeyId = config.AccessKeyIdWo.ValueStringPointer()
+			s3.SecretAccessKey = config.SecretAccessKeyWo.ValueStringPointer()
+			s3.SessionToken = config.SessionTokenWo.ValueStringPointer()
+		}
+		err = request.Configuration.FromUpdateS3ConfigurationRequest(s3)
+	case providerschema.AiProviderTypeOpenAI:
+		err = request.Configuration.FromUpdateOpenAIConfigurationRequest(apigen.UpdateOpenAIConfigurationRequest{
+			ApiKey: config.ApiKeyWo.ValueStringPointer(),
+		})
+	case providerschema.AiProviderTypeAwsBedrock:
+		err = request.Configuration.FromUpdateBedrockConfigurationRequest(apigen.UpdateBedrockConfigurationRequest{
+			AccessKeyId:     config.AccessKeyIdWo.ValueStringPointer(),
+			SecretAccessKey: config.SecretAccessKeyWo.ValueStringPointer(),
+		})
+	default:
+		err = fmt.Errorf("unsupported provider type %s", plan.Type.ValueString())
+	}
+
+	return request, err
+}
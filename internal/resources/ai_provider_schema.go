@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var aiProviderBuilder = capellaschema.NewSchemaBuilder("aiProvider", "CreateProviderRequest")
+
+// AiProviderSchema returns the schema for the ai_provider resource.
+func AiProviderSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", aiProviderBuilder, stringAttribute([]string{computed, useStateForUnknown}), "CreateProviderResponse")
+	capellaschema.AddAttr(attrs, "organization_id", aiProviderBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "name", aiProviderBuilder, stringAttribute([]string{required, requiresReplace}))
+	capellaschema.AddAttr(attrs, "type", aiProviderBuilder, stringAttribute([]string{required, requiresReplace}))
+
+	// S3 location, only used by awsS3 providers.
+	capellaschema.AddAttr(attrs, "aws_region", aiProviderBuilder, stringAttribute([]string{optional}), "CreateS3ConfigurationRequest")
+	capellaschema.AddAttr(attrs, "bucket", aiProviderBuilder, stringAttribute([]string{optional}), "CreateS3ConfigurationRequest")
+	capellaschema.AddAttr(attrs, "folder_path", aiProviderBuilder, stringAttribute([]string{optional}, stringvalidator.LengthAtLeast(1)), "CreateS3ConfigurationRequest")
+
+	// Credentials are write-only and never stored in state.
+	capellaschema.AddAttr(attrs, "api_key_wo", aiProviderBuilder, writeOnlyStringAttribute(
+		"The API key to access the OpenAI API. Required for openAI providers.",
+	))
+	capellaschema.AddAttr(attrs, "access_key_id_wo", aiProviderBuilder, writeOnlyStringAttribute(
+		"The AWS access key ID. Required for awsS3 and awsBedrock providers.",
+	))
+	capellaschema.AddAttr(attrs, "secret_access_key_wo", aiProviderBuilder, writeOnlyStringAttribute(
+		"The AWS secret access key. Required for awsS3 and awsBedrock providers.",
+	))
+	capellaschema.AddAttr(attrs, "session_token_wo", aiProviderBuilder, writeOnlyStringAttribute(
+		"The AWS session token, when temporary credentials are used. Only used by awsS3 providers.",
+	))
+
+	credentialsVersionAttr := int64Attribute(optional)
+	credentialsVersionAttr.MarkdownDescription = "Change this value to send the write-only credentials to Capella again, for example after rotating them."
+	credentialsVersionAttr.Validators = []validator.Int64{int64validator.AtLeast(0)}
+	capellaschema.AddAttr(attrs, "credentials_wo_version", aiProviderBuilder, credentialsVersionAttr)
+
+	capellaschema.AddAttr(attrs, "audit", aiProviderBuilder, computedAuditAttribute())
+
+	return schema.Schema{
+		MarkdownDescription: "Manages an external provider registered with Capella AI Services, such as an S3 bucket, OpenAI or AWS Bedrock. " +
+			"The provider credentials are write-only: they are sent to Capella but never stored in the Terraform state or plan. " +
+			"Requires Terraform 1.11 or later.",
+		Attributes: attrs,
+	}
+}
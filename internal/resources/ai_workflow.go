@@ -0,0 +1,645 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+const (
+	// aiWorkflowTimeout is the default create, update and delete timeout of a workflow.
+	// Create and update include waiting for the run of the workflow to finish.
+	aiWorkflowTimeout = 2 * time.Hour
+
+	// aiWorkflowRunPollInterval is the time between workflow run status checks.
+	aiWorkflowRunPollInterval = 30 * time.Second
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AiWorkflow{}
+	_ resource.ResourceWithConfigure   = &AiWorkflow{}
+	_ resource.ResourceWithImportState = &AiWorkflow{}
+	_ resource.ResourceWithModifyPlan  = &AiWorkflow{}
+)
+
+// AiWorkflow is the AI workflow resource implementation.
+type AiWorkflow struct {
+	*providerschema.Data
+}
+
+// NewAiWorkflow is a helper function to simplify the provider implementation.
+func NewAiWorkflow() resource.Resource {
+	return &AiWorkflow{}
+}
+
+// Metadata returns the AI workflow resource type name.
+func (a *AiWorkflow) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_workflow"
+}
+
+// Schema defines the schema for the AI workflow resource.
+func (a *AiWorkflow) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AiWorkflowSchema()
+}
+
+// Configure adds the provider configured client to the AI workflow resource.
+func (a *AiWorkflow) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ImportState imports a remote AI workflow that is not created by Terraform.
+// The API key token of a hosted embedding model is not returned by Capella, so it is null after import.
+func (a *AiWorkflow) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan marks last_run as unknown when a change to run_trigger will start a new run.
+func (a *AiWorkflow) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state providerschema.AiWorkflow
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !isAiWorkflowRunTriggered(plan.RunTrigger, state.RunTrigger) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_run"), types.ObjectUnknown(providerschema.AiWorkflowRun{}.AttributeTypes()))...)
+}
+
+// Create creates the workflow and, when run_trigger is set, runs it.
+func (a *AiWorkflow) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AiWorkflow
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+	)
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	createReq, err := newAiWorkflowCreateRequest(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI workflow",
+			"Could not build AI workflow request: "+err.Error(),
+		)
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, aiWorkflowTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	createResp, err := a.ClientV2.CreateAiWorkflowWithResponse(ctx, orgUUID, projUUID, clusterUUID, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI workflow",
+			"Could not create AI workflow, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON201 == nil {
+		resp.Diagnostics.AddError(
+			"Error creating AI workflow",
+			fmt.Sprintf("Could not create AI workflow, unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	workflowId := createResp.JSON201.Id
+
+	// Save the ID so the workflow is still tracked when its first run fails.
+	initialState := plan
+	initialState.Id = types.StringValue(workflowId)
+	initialState.LastRun = types.ObjectNull(providerschema.AiWorkflowRun{}.AttributeTypes())
+	initialState.Audit = types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	diags = resp.State.Set(ctx, initialState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := a.retrieveAiWorkflow(ctx, organizationId, projectId, clusterId, workflowId, &initialState)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading AI workflow",
+			"Could not read AI workflow with ID "+workflowId+": "+api.ParseError(err),
+		)
+		return
+	}
+	refreshedState.RunTrigger = plan.RunTrigger
+
+	if !plan.RunTrigger.IsNull() {
+		refreshedState.LastRun, diags = a.runAiWorkflow(ctx, organizationId, projectId, clusterId, workflowId)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the workflow and refreshes the status of its last run.
+func (a *AiWorkflow) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AiWorkflow
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AI Workflow in Capella",
+			"Could not read Capella AI workflow with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		workflowId     = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := a.retrieveAiWorkflow(ctx, organizationId, projectId, clusterId, workflowId, &state)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading AI Workflow in Capella",
+			"Could not read Capella AI workflow with ID "+workflowId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	if !refreshedState.LastRun.IsNull() {
+		var lastRun providerschema.AiWorkflowRun
+		diags = refreshedState.LastRun.As(ctx, &lastRun, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		run, err := a.retrieveAiWorkflowRun(ctx, organizationId, projectId, clusterId, workflowId, lastRun.Id.ValueString())
+		switch {
+		case err == errors.ErrNotFound:
+			tflog.Info(ctx, "last workflow run doesn't exist in remote server, keeping it in state")
+		case err != nil:
+			resp.Diagnostics.AddError(
+				"Error Reading AI Workflow in Capella",
+				"Could not read the last run of Capella AI workflow with ID "+workflowId+": "+api.ParseError(err),
+			)
+			return
+		default:
+			refreshedState.LastRun, diags = types.ObjectValueFrom(ctx, providerschema.AiWorkflowRun{}.AttributeTypes(), providerschema.NewAiWorkflowRun(*run))
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update runs the workflow when run_trigger changes. Every other attribute forces a replacement.
+func (a *AiWorkflow) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.AiWorkflow
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating AI Workflow in Capella",
+			"Could not update Capella AI workflow with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		workflowId     = IDs[providerschema.Id]
+	)
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, aiWorkflowTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	refreshedState := state
+	refreshedState.Timeouts = plan.Timeouts
+
+	if isAiWorkflowRunTriggered(plan.RunTrigger, state.RunTrigger) {
+		lastRun, diags := a.runAiWorkflow(ctx, organizationId, projectId, clusterId, workflowId)
+		resp.Diagnostics.Append(diags...)
+		// A failed run keeps the previous run_trigger and last_run, so the next apply retries it.
+		if !diags.HasError() {
+			refreshedState.RunTrigger = plan.RunTrigger
+			refreshedState.LastRun = lastRun
+		}
+	} else {
+		refreshedState.RunTrigger = plan.RunTrigger
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete stops the active run of the workflow, if any, and deletes the workflow.
+func (a *AiWorkflow) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AiWorkflow
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Workflow in Capella",
+			"Could not delete Capella AI workflow with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		workflowId     = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, clusterUUID, workflowUUID, err := parseAiWorkflowUUIDs(organizationId, projectId, clusterId, workflowId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, aiWorkflowTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	// A workflow cannot be deleted while it is running. Stopping is best effort, as there may be no active run.
+	if err := a.stopAiWorkflowRun(ctx, orgUUID, projUUID, clusterUUID, workflowUUID); err != nil {
+		tflog.Debug(ctx, "could not stop workflow run before deleting the workflow", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	deleteResp, err := a.ClientV2.DeleteAiWorkflowWithResponse(ctx, orgUUID, projUUID, clusterUUID, workflowUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Workflow in Capella",
+			"Could not delete Capella AI workflow with ID "+workflowId+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting AI Workflow in Capella",
+			fmt.Sprintf("Could not delete Capella AI workflow with ID %s, unexpected response status %d: %s", workflowId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// runAiWorkflow starts a run of the workflow and waits for it to finish.
+// The run is returned even when it fails, so that its file counts are kept in state.
+func (a *AiWorkflow) runAiWorkflow(ctx context.Context, organizationId, projectId, clusterId, workflowId string) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	lastRun := types.ObjectNull(providerschema.AiWorkflowRun{}.AttributeTypes())
+
+	orgUUID, projUUID, clusterUUID, workflowUUID, err := parseAiWorkflowUUIDs(organizationId, projectId, clusterId, workflowId)
+	if err != nil {
+		diags.AddError("Error parsing IDs", err.Error())
+		return lastRun, diags
+	}
+
+	runResp, err := a.ClientV2.CreateAiWorkflowRunWithResponse(ctx, orgUUID, projUUID, clusterUUID, workflowUUID)
+	if err != nil {
+		diags.AddError(
+			"Error running AI workflow",
+			"Could not start a run of AI workflow with ID "+workflowId+", unexpected error: "+err.Error(),
+		)
+		return lastRun, diags
+	}
+	if runResp.JSON202 == nil {
+		diags.AddError(
+			"Error running AI workflow",
+			fmt.Sprintf("Could not start a run of AI workflow with ID %s, unexpected response status %d: %s", workflowId, runResp.StatusCode(), string(runResp.Body)),
+		)
+		return lastRun, diags
+	}
+
+	runId := runResp.JSON202.Id
+
+	run, err := a.waitForAiWorkflowRun(ctx, organizationId, projectId, clusterId, workflowId, runId)
+	if err != nil {
+		// ctx may be done when the run timed out, so stop the run without its deadline.
+		if stopErr := a.stopAiWorkflowRun(context.WithoutCancel(ctx), orgUUID, projUUID, clusterUUID, workflowUUID); stopErr != nil {
+			tflog.Warn(ctx, "could not stop workflow run", map[string]interface{}{
+				"run_id": runId,
+				"error":  stopErr.Error(),
+			})
+		}
+		diags.AddError(
+			"Error running AI workflow",
+			"Run "+runId+" of AI workflow with ID "+workflowId+" did not finish: "+api.ParseError(err),
+		)
+		return lastRun, diags
+	}
+
+	lastRun, diags = types.ObjectValueFrom(ctx, providerschema.AiWorkflowRun{}.AttributeTypes(), providerschema.NewAiWorkflowRun(*run))
+
+	switch run.Status {
+	case apigen.GetWorkflowRunResponseStatusFailed:
+		diags.AddError(
+			"Error running AI workflow",
+			"Run "+runId+" of AI workflow with ID "+workflowId+" failed. Use the couchbase-capella_ai_workflow_processed_files data source to list the files that could not be processed.",
+		)
+	case apigen.GetWorkflowRunResponseStatusPartiallyCompleted:
+		diags.AddWarning(
+			"AI workflow run partially completed",
+			"Run "+runId+" of AI workflow with ID "+workflowId+" could not process every file. Use the couchbase-capella_ai_workflow_processed_files data source to list the files that could not be processed.",
+		)
+	}
+
+	return lastRun, diags
+}
+
+// waitForAiWorkflowRun polls the run until it has finished or ctx is done.
+func (a *AiWorkflow) waitForAiWorkflowRun(
+	ctx context.Context,
+	organizationId, projectId, clusterId, workflowId, runId string,
+) (*apigen.GetWorkflowRunResponse, error) {
+	ticker := time.NewTicker(aiWorkflowRunPollInterval)
+	defer ticker.Stop()
+
+	for {
+		run, err := a.retrieveAiWorkflowRun(ctx, organizationId, projectId, clusterId, workflowId, runId)
+		if err != nil {
+			return nil, err
+		}
+
+		if providerschema.IsAiWorkflowRunFinished(run.Status) {
+			return run, nil
+		}
+
+		fields := map[string]interface{}{
+			"status": run.Status,
+		}
+		if run.ProcessedFiles != nil {
+			fields["processed_files"] = *run.ProcessedFiles
+		}
+		if run.TotalFiles != nil {
+			fields["total_files"] = *run.TotalFiles
+		}
+		tflog.Info(ctx, "waiting for AI workflow run to finish", fields)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out while waiting for the AI workflow run to finish: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// stopAiWorkflowRun stops the active run of the workflow.
+func (a *AiWorkflow) stopAiWorkflowRun(ctx context.Context, orgUUID, projUUID, clusterUUID, workflowUUID uuid.UUID) error {
+	stopResp, err := a.ClientV2.StopAiWorkflowRunWithResponse(ctx, orgUUID, projUUID, clusterUUID, workflowUUID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch stopResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", stopResp.StatusCode(), string(stopResp.Body))
+	}
+}
+
+// retrieveAiWorkflow retrieves the workflow and converts it into Terraform state.
+// errors.ErrNotFound is returned when the workflow does not exist.
+func (a *AiWorkflow) retrieveAiWorkflow(
+	ctx context.Context,
+	organizationId, projectId, clusterId, workflowId string,
+	prior *providerschema.AiWorkflow,
+) (*providerschema.AiWorkflow, error) {
+	orgUUID, projUUID, clusterUUID, workflowUUID, err := parseAiWorkflowUUIDs(organizationId, projectId, clusterId, workflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetAiWorkflowWithResponse(ctx, orgUUID, projUUID, clusterUUID, workflowUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	workflow := getResp.JSON200
+
+	audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(workflow.Audit))
+	auditObj, diags := types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+	if diags.HasError() {
+		return nil, errors.ErrUnableToConvertAuditData
+	}
+
+	return providerschema.NewAiWorkflow(*workflow, organizationId, projectId, clusterId, prior, auditObj)
+}
+
+// retrieveAiWorkflowRun retrieves a run of the workflow.
+// errors.ErrNotFound is returned when the run does not exist.
+func (a *AiWorkflow) retrieveAiWorkflowRun(
+	ctx context.Context,
+	organizationId, projectId, clusterId, workflowId, runId string,
+) (*apigen.GetWorkflowRunResponse, error) {
+	orgUUID, projUUID, clusterUUID, workflowUUID, err := parseAiWorkflowUUIDs(organizationId, projectId, clusterId, workflowId)
+	if err != nil {
+		return nil, err
+	}
+	runUUID, err := utils.ParseUUID("run_id", runId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetAiWorkflowRunWithResponse(ctx, orgUUID, projUUID, clusterUUID, workflowUUID, runUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	return getResp.JSON200, nil
+}
+
+// isAiWorkflowRunTriggered reports whether run_trigger was set or changed, which starts a new run.
+// Removing run_trigger does not start a run.
+func isAiWorkflowRunTriggered(planned, prior types.String) bool {
+	return !planned.IsNull() && !planned.Equal(prior)
+}
+
+// parseAiWorkflowUUIDs parses the organization, project, cluster and workflow IDs into UUIDs for the generated API client.
+func parseAiWorkflowUUIDs(organizationId, projectId, clusterId, workflowId string) (uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+		utils.IDField{Name: "id", Value: workflowId},
+	)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids[0], uuids[1], uuids[2], uuids[3], nil
+}
+
+// newAiWorkflowCreateRequest builds the create request body for a vectorization workflow from the plan.
+func newAiWorkflowCreateRequest(plan providerschema.AiWorkflow) (apigen.CreateWorkflowRequest, error) {
+	request := apigen.CreateWorkflowRequest{
+		Name: plan.Name.ValueString(),
+		Type: apigen.CreateWorkflowRequestTypeVectorization,
+	}
+
+	config := apigen.CreateVectorizationWorkflowRequest{
+		TargetCouchbaseKeyspace: apigen.CouchbaseKeyspace{
+			Bucket:     plan.TargetCouchbaseKeyspace.Bucket.ValueString(),
+			Scope:      plan.TargetCouchbaseKeyspace.Scope.ValueString(),
+			Collection: plan.TargetCouchbaseKeyspace.Collection.ValueString(),
+		},
+		VectorizationConfig: apigen.VectorizationConfigCreation{
+			CreateIndexes: plan.VectorizationConfig.CreateIndexes.ValueBoolPointer(),
+		},
+	}
+
+	mappings := make(map[string]struct {
+		SourceFields *[]string `json:"sourceFields,omitempty"`
+	}, len(plan.VectorizationConfig.EmbeddingFieldMappings))
+	for field, mapping := range plan.VectorizationConfig.EmbeddingFieldMappings {
+		sourceFields := make([]string, 0, len(mapping.SourceFields.Elements()))
+		for _, sourceField := range mapping.SourceFields.Elements() {
+			value, ok := sourceField.(types.String)
+			if !ok {
+				return request, fmt.Errorf("source field of %s is not a string", field)
+			}
+			sourceFields = append(sourceFields, value.ValueString())
+		}
+		mappings[field] = struct {
+			SourceFields *[]string `json:"sourceFields,omitempty"`
+		}{SourceFields: &sourceFields}
+	}
+	config.VectorizationConfig.EmbeddingFieldMappings = &mappings
+
+	model := plan.VectorizationConfig.EmbeddingModel
+	var err error
+	switch {
+	case model.CapellaHostedModel != nil:
+		var hosted apigen.VectorizationConfigCreationEmbeddingModel1
+		hosted.CapellaHostedModel.Id = model.CapellaHostedModel.Id.ValueString()
+		hosted.CapellaHostedModel.ModelName = model.CapellaHostedModel.ModelName.ValueString()
+		hosted.CapellaHostedModel.ApiKeyId = model.CapellaHostedModel.ApiKeyId.ValueString()
+		hosted.CapellaHostedModel.ApiKeyToken = model.CapellaHostedModel.ApiKeyToken.ValueString()
+		hosted.CapellaHostedModel.PrivateEndpointEnabled = model.CapellaHostedModel.PrivateEndpointEnabled.ValueBool()
+		err = config.VectorizationConfig.EmbeddingModel.FromVectorizationConfigCreationEmbeddingModel1(hosted)
+	case model.External != nil:
+		var external apigen.VectorizationConfigCreationEmbeddingModel0
+		external.External.ModelName = model.External.ModelName.ValueString()
+		external.External.OpenAiIntegration.ProviderId = model.External.ProviderId.ValueString()
+		err = config.VectorizationConfig.EmbeddingModel.FromVectorizationConfigCreationEmbeddingModel0(external)
+	default:
+		return request, fmt.Errorf("one of capella_hosted_model or external must be configured for the embedding model")
+	}
+	if err != nil {
+		return request, err
+	}
+
+	if err := request.Configuration.FromCreateVectorizationWorkflowRequest(config); err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
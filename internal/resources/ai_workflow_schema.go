@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	customvalidator "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema/validator"
+)
+
+var aiWorkflowBuilder = capellaschema.NewSchemaBuilder("aiWorkflow", "CreateWorkflowRequest")
+
+// AiWorkflowSchema returns the schema for the ai_workflow resource.
+func AiWorkflowSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", aiWorkflowBuilder, stringAttribute([]string{computed, useStateForUnknown}), "GetWorkflowResponse")
+	capellaschema.AddAttr(attrs, "organization_id", aiWorkflowBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", aiWorkflowBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", aiWorkflowBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "name", aiWorkflowBuilder, requiredNonEmptyStringAttribute())
+
+	keyspaceAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(keyspaceAttrs, "bucket", aiWorkflowBuilder, stringAttribute([]string{required}), "CouchbaseKeyspace")
+	capellaschema.AddAttr(keyspaceAttrs, "scope", aiWorkflowBuilder, stringAttribute([]string{required}), "CouchbaseKeyspace")
+	capellaschema.AddAttr(keyspaceAttrs, "collection", aiWorkflowBuilder, stringAttribute([]string{required}), "CouchbaseKeyspace")
+	capellaschema.AddAttr(attrs, "target_couchbase_keyspace", aiWorkflowBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: keyspaceAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}, "CreateVectorizationWorkflowRequest")
+
+	hostedModelAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(hostedModelAttrs, "id", aiWorkflowBuilder, stringAttribute([]string{required}), "CapellaHostedModel")
+	capellaschema.AddAttr(hostedModelAttrs, "model_name", aiWorkflowBuilder, stringAttribute([]string{required}), "CapellaHostedModel")
+	capellaschema.AddAttr(hostedModelAttrs, "api_key_id", aiWorkflowBuilder, stringAttribute([]string{required}), "CapellaHostedModel")
+	capellaschema.AddAttr(hostedModelAttrs, "api_key_token", aiWorkflowBuilder, stringAttribute([]string{required, sensitive}), "CapellaHostedModel")
+	capellaschema.AddAttr(hostedModelAttrs, "private_endpoint_enabled", aiWorkflowBuilder, boolDefaultAttribute(false, optional, computed), "CapellaHostedModel")
+
+	externalModelAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(externalModelAttrs, "model_name", aiWorkflowBuilder, stringAttribute([]string{required}, stringvalidator.OneOf(
+		string(apigen.TextEmbedding3Large),
+		string(apigen.TextEmbedding3Small),
+		string(apigen.TextEmbeddingAda002),
+	)), "ExternalModel")
+	// provider_id is nested in openAiIntegration in the API.
+	providerIdAttr := stringAttribute([]string{required})
+	providerIdAttr.MarkdownDescription = "The ID of the OpenAI integration used to reach the external model."
+	capellaschema.AddAttr(externalModelAttrs, "provider_id", aiWorkflowBuilder, providerIdAttr)
+
+	embeddingModelAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(embeddingModelAttrs, "capella_hosted_model", aiWorkflowBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: hostedModelAttrs,
+	}, "CapellaHostedModel")
+	capellaschema.AddAttr(embeddingModelAttrs, "external", aiWorkflowBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: externalModelAttrs,
+	}, "ExternalModel")
+
+	embeddingFieldAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(embeddingFieldAttrs, "source_fields", aiWorkflowBuilder, stringListAttribute(required), "VectorizationConfigCreation")
+
+	vectorizationAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(vectorizationAttrs, "embedding_model", aiWorkflowBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: embeddingModelAttrs,
+		Validators: []validator.Object{
+			customvalidator.ExactlyOneOfNested("capella_hosted_model", "external"),
+		},
+	}, "VectorizationConfigCreation")
+	capellaschema.AddAttr(vectorizationAttrs, "embedding_field_mappings", aiWorkflowBuilder, &schema.MapNestedAttribute{
+		Required: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: embeddingFieldAttrs,
+		},
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}, "VectorizationConfigCreation")
+	capellaschema.AddAttr(vectorizationAttrs, "create_indexes", aiWorkflowBuilder, boolDefaultAttribute(false, optional, computed), "VectorizationConfigCreation")
+	capellaschema.AddAttr(attrs, "vectorization_config", aiWorkflowBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: vectorizationAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}, "CreateVectorizationWorkflowRequest")
+
+	// run_trigger is not part of the workflow API. It drives the workflow run endpoints.
+	runTriggerAttr := stringAttribute([]string{optional})
+	runTriggerAttr.MarkdownDescription = "Starts a run of the workflow when it is set or changed, and waits for the run to finish. " +
+		"Any value can be used, for example a timestamp or a version of the source data. Removing it does not start a run."
+	capellaschema.AddAttr(attrs, "run_trigger", aiWorkflowBuilder, runTriggerAttr)
+
+	lastRunAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(lastRunAttrs, "id", aiWorkflowBuilder, stringAttribute([]string{computed}), "GetWorkflowRunResponse")
+	capellaschema.AddAttr(lastRunAttrs, "status", aiWorkflowBuilder, stringAttribute([]string{computed}), "GetWorkflowRunResponse")
+	capellaschema.AddAttr(lastRunAttrs, "created_at", aiWorkflowBuilder, stringAttribute([]string{computed}), "GetWorkflowRunResponse")
+	capellaschema.AddAttr(lastRunAttrs, "created_by_user_id", aiWorkflowBuilder, stringAttribute([]string{computed}), "GetWorkflowRunResponse")
+	capellaschema.AddAttr(lastRunAttrs, "total_files", aiWorkflowBuilder, int64Attribute(computed), "GetWorkflowRunResponse")
+	capellaschema.AddAttr(lastRunAttrs, "processed_files", aiWorkflowBuilder, int64Attribute(computed), "GetWorkflowRunResponse")
+	capellaschema.AddAttr(lastRunAttrs, "errored_files", aiWorkflowBuilder, int64Attribute(computed), "GetWorkflowRunResponse")
+
+	// last_run reports the run started through run_trigger.
+	lastRunAttr := &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: lastRunAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+	}
+	lastRunAttr.MarkdownDescription = "The run started by the most recent change to `run_trigger`, including how many files it processed."
+	capellaschema.AddAttr(attrs, "last_run", aiWorkflowBuilder, lastRunAttr)
+
+	capellaschema.AddAttr(attrs, "audit", aiWorkflowBuilder, computedAuditAttribute())
+
+	return schema.Schema{
+		MarkdownDescription: "Manages a vectorization workflow in Capella AI Services, which generates vector embeddings " +
+			"for the documents of a collection. Changing any setting other than `run_trigger` or `timeouts` recreates the workflow.",
+		Attributes: attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
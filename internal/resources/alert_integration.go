@@ -0,0 +1,441 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AlertIntegration{}
+	_ resource.ResourceWithConfigure   = &AlertIntegration{}
+	_ resource.ResourceWithImportState = &AlertIntegration{}
+)
+
+// AlertIntegration is the alert integration resource implementation.
+type AlertIntegration struct {
+	*providerschema.Data
+}
+
+// NewAlertIntegration is a helper function to simplify the provider implementation.
+func NewAlertIntegration() resource.Resource {
+	return &AlertIntegration{}
+}
+
+// Metadata returns the alert integration resource type name.
+func (a *AlertIntegration) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_integration"
+}
+
+// Schema defines the schema for the alert integration resource.
+func (a *AlertIntegration) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AlertIntegrationSchema()
+}
+
+// Configure adds the provider configured client to the alert integration resource.
+func (a *AlertIntegration) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ImportState imports a remote alert integration that is not created by Terraform.
+func (a *AlertIntegration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create creates a new alert integration, optionally sending a test alert first.
+func (a *AlertIntegration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AlertIntegration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+	)
+
+	orgUUID, projUUID, err := parseOrganizationProjectUUIDs(organizationId, projectId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	config, diags := alertIntegrationRequestConfig(ctx, plan.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TestOnCreate.ValueBool() {
+		if err := a.testAlertIntegration(ctx, orgUUID, projUUID, plan.Kind.ValueString(), config); err != nil {
+			resp.Diagnostics.AddError(
+				"Error testing alert integration",
+				"The test alert could not be delivered, the alert integration was not created: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	createReq := apigen.CreateAlertRequest{
+		Name:   plan.Name.ValueString(),
+		Kind:   apigen.CreateAlertRequestKind(plan.Kind.ValueString()),
+		Config: config,
+	}
+
+	createResp, err := a.ClientV2.PostAlertIntegrationWithResponse(ctx, orgUUID, projUUID, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating alert integration",
+			"Could not create alert integration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON201 == nil {
+		resp.Diagnostics.AddError(
+			"Error creating alert integration",
+			fmt.Sprintf("Could not create alert integration, unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	alertIntegrationId := createResp.JSON201.Id.String()
+	refreshedState, err := a.retrieveAlertIntegration(ctx, organizationId, projectId, alertIntegrationId, plan.Config, plan.TestOnCreate)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error reading alert integration",
+			"Could not read alert integration with ID "+alertIntegrationId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the alert integration.
+func (a *AlertIntegration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AlertIntegration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Integration in Capella",
+			"Could not read Capella alert integration with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		alertIntegrationId = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := a.retrieveAlertIntegration(ctx, organizationId, projectId, alertIntegrationId, state.Config, state.TestOnCreate)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Integration in Capella",
+			"Could not read Capella alert integration with ID "+alertIntegrationId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the name and config of the alert integration.
+func (a *AlertIntegration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.AlertIntegration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating alert integration",
+			"Could not update alert integration with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		alertIntegrationId = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, err := parseOrganizationProjectUUIDs(organizationId, projectId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+	alertIntegrationUUID, err := utils.ParseUUID("id", alertIntegrationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	config, diags := alertIntegrationRequestConfig(ctx, plan.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	updateReq := apigen.UpdateAlertRequest{
+		Name:   &name,
+		Config: config,
+	}
+
+	updateResp, err := a.ClientV2.PutAlertIntegrationWithResponse(ctx, orgUUID, projUUID, alertIntegrationUUID, updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating alert integration",
+			"Could not update alert integration with ID "+alertIntegrationId+": "+err.Error(),
+		)
+		return
+	}
+	switch updateResp.StatusCode() {
+	case http.StatusOK, http.StatusNoContent:
+	default:
+		resp.Diagnostics.AddError(
+			"Error updating alert integration",
+			fmt.Sprintf("Could not update alert integration with ID %s, unexpected response status %d: %s", alertIntegrationId, updateResp.StatusCode(), string(updateResp.Body)),
+		)
+		return
+	}
+
+	refreshedState, err := a.retrieveAlertIntegration(ctx, organizationId, projectId, alertIntegrationId, plan.Config, plan.TestOnCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating alert integration",
+			"Could not read alert integration with ID "+alertIntegrationId+" after update: "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the alert integration.
+func (a *AlertIntegration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AlertIntegration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Alert Integration in Capella",
+			"Could not delete Capella alert integration with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		alertIntegrationId = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, err := parseOrganizationProjectUUIDs(organizationId, projectId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+	alertIntegrationUUID, err := utils.ParseUUID("id", alertIntegrationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	deleteResp, err := a.ClientV2.DeleteAlertIntegrationByIDWithResponse(ctx, orgUUID, projUUID, alertIntegrationUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Alert Integration in Capella",
+			"Could not delete Capella alert integration with ID "+alertIntegrationId+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting Alert Integration in Capella",
+			fmt.Sprintf("Could not delete Capella alert integration with ID %s, unexpected response status %d: %s", alertIntegrationId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// testAlertIntegration sends a test alert through the given config. An error is returned
+// when Capella reports that the alert could not be delivered.
+func (a *AlertIntegration) testAlertIntegration(ctx context.Context, orgUUID, projUUID uuid.UUID, kind string, config apigen.RequestConfig) error {
+	testResp, err := a.ClientV2.PostTestAlertIntegrationWithResponse(ctx, orgUUID, projUUID, apigen.PostTestAlertIntegrationJSONRequestBody{
+		Kind:   apigen.PostTestAlertIntegrationJSONBodyKind(kind),
+		Config: config,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch testResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", testResp.StatusCode(), string(testResp.Body))
+	}
+}
+
+// retrieveAlertIntegration retrieves the alert integration and converts it into Terraform state.
+// errors.ErrNotFound is returned when the alert integration does not exist.
+func (a *AlertIntegration) retrieveAlertIntegration(
+	ctx context.Context,
+	organizationId, projectId, alertIntegrationId string,
+	priorConfig *providerschema.AlertIntegrationConfig,
+	testOnCreate types.Bool,
+) (*providerschema.AlertIntegration, error) {
+	orgUUID, projUUID, err := parseOrganizationProjectUUIDs(organizationId, projectId)
+	if err != nil {
+		return nil, err
+	}
+	alertIntegrationUUID, err := utils.ParseUUID("id", alertIntegrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetAlertIntegrationByIDWithResponse(ctx, orgUUID, projUUID, alertIntegrationUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	alert := getResp.JSON200
+
+	audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(alert.Audit))
+	auditObj, diags := types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+	if diags.HasError() {
+		return nil, errors.ErrUnableToConvertAuditData
+	}
+
+	return providerschema.NewAlertIntegration(*alert, organizationId, projectId, priorConfig, testOnCreate, auditObj), nil
+}
+
+// alertIntegrationRequestConfig converts the configured webhook into a generated request config.
+func alertIntegrationRequestConfig(ctx context.Context, config *providerschema.AlertIntegrationConfig) (apigen.RequestConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if config == nil || config.Webhook == nil {
+		diags.AddAttributeError(path.Root("config").AtName("webhook"), "Missing webhook config", "config.webhook must be set.")
+		return apigen.RequestConfig{}, diags
+	}
+
+	webhook := config.Webhook
+	requestWebhook := apigen.RequestWebhook{
+		Url:    webhook.Url.ValueString(),
+		Method: apigen.RequestWebhookMethod(webhook.Method.ValueString()),
+	}
+
+	if !webhook.Token.IsNull() && !webhook.Token.IsUnknown() {
+		token := webhook.Token.ValueString()
+		requestWebhook.Token = &token
+	}
+
+	if !webhook.Headers.IsNull() && !webhook.Headers.IsUnknown() {
+		headers := make(map[string]string, len(webhook.Headers.Elements()))
+		diags.Append(webhook.Headers.ElementsAs(ctx, &headers, false)...)
+		requestWebhook.Headers = &headers
+	}
+
+	if webhook.BasicAuth != nil {
+		requestWebhook.BasicAuth = &apigen.BasicAuth{
+			User:     webhook.BasicAuth.User.ValueString(),
+			Password: webhook.BasicAuth.Password.ValueString(),
+		}
+	}
+
+	if webhook.Exclude != nil {
+		exclude := apigen.Exclude{}
+		if !webhook.Exclude.Clusters.IsNull() && !webhook.Exclude.Clusters.IsUnknown() {
+			var clusters []string
+			diags.Append(webhook.Exclude.Clusters.ElementsAs(ctx, &clusters, false)...)
+			exclude.Clusters = &clusters
+		}
+		if !webhook.Exclude.AppServices.IsNull() && !webhook.Exclude.AppServices.IsUnknown() {
+			var appServices []string
+			diags.Append(webhook.Exclude.AppServices.ElementsAs(ctx, &appServices, false)...)
+			exclude.AppServices = &appServices
+		}
+		requestWebhook.Exclude = &exclude
+	}
+
+	return apigen.RequestConfig{Webhook: requestWebhook}, diags
+}
+
+// parseOrganizationProjectUUIDs parses the organization and project IDs into UUIDs for the generated API client.
+func parseOrganizationProjectUUIDs(organizationId, projectId string) (uuid.UUID, uuid.UUID, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+	)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids[0], uuids[1], nil
+}
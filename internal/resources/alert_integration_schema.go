@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var alertIntegrationBuilder = capellaschema.NewSchemaBuilder("alertIntegration", "CreateAlertRequest")
+
+// AlertIntegrationSchema returns the schema for the alert_integration resource.
+func AlertIntegrationSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", alertIntegrationBuilder, stringAttribute([]string{computed, useStateForUnknown}), "GetAlertResponse")
+	capellaschema.AddAttr(attrs, "organization_id", alertIntegrationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", alertIntegrationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "name", alertIntegrationBuilder, requiredStringAttributeNoReplace())
+	capellaschema.AddAttr(attrs, "kind", alertIntegrationBuilder, stringDefaultAttribute("webhook", optional, computed, requiresReplace))
+
+	basicAuthAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(basicAuthAttrs, "user", alertIntegrationBuilder, stringAttribute([]string{required}), "BasicAuth")
+	capellaschema.AddAttr(basicAuthAttrs, "password", alertIntegrationBuilder, stringAttribute([]string{required, sensitive}), "BasicAuth")
+
+	excludeAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(excludeAttrs, "clusters", alertIntegrationBuilder, stringSetAttribute(optional), "Exclude")
+	capellaschema.AddAttr(excludeAttrs, "app_services", alertIntegrationBuilder, stringSetAttribute(optional), "Exclude")
+
+	// The url of chat and paging webhooks embeds the routing key, so it is sensitive
+	// along with the token, headers and basic auth password.
+	webhookAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(webhookAttrs, "url", alertIntegrationBuilder, stringAttribute([]string{required, sensitive}), "RequestWebhook")
+	capellaschema.AddAttr(webhookAttrs, "method", alertIntegrationBuilder, stringAttribute([]string{required}), "RequestWebhook")
+	capellaschema.AddAttr(webhookAttrs, "token", alertIntegrationBuilder, stringAttribute([]string{optional, sensitive}), "RequestWebhook")
+	capellaschema.AddAttr(webhookAttrs, "headers", alertIntegrationBuilder, mapAttribute(types.StringType, optional, sensitive), "RequestWebhook")
+	capellaschema.AddAttr(webhookAttrs, "basic_auth", alertIntegrationBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: basicAuthAttrs,
+	}, "RequestWebhook")
+	capellaschema.AddAttr(webhookAttrs, "exclude", alertIntegrationBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: excludeAttrs,
+	}, "RequestWebhook")
+
+	configAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(configAttrs, "webhook", alertIntegrationBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: webhookAttrs,
+	}, "RequestConfig")
+
+	capellaschema.AddAttr(attrs, "config", alertIntegrationBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: configAttrs,
+	})
+
+	capellaschema.AddAttr(attrs, "config_key", alertIntegrationBuilder, stringAttribute([]string{computed, useStateForUnknown}), "GetAlertResponse")
+	capellaschema.AddAttr(attrs, "status", alertIntegrationBuilder, stringAttribute([]string{computed}), "GetAlertResponse")
+	capellaschema.AddAttr(attrs, "enabled", alertIntegrationBuilder, boolAttribute(computed), "GetAlertResponse")
+	capellaschema.AddAttr(attrs, "audit", alertIntegrationBuilder, computedAuditAttribute())
+
+	// test_on_create drives the test endpoint and is not part of the integration payload,
+	// so its description is set here rather than looked up from the spec.
+	testOnCreate := boolDefaultAttribute(false, optional, computed)
+	testOnCreate.MarkdownDescription = "Sends a test alert through the configured webhook before the integration is created. " +
+		"The create fails, and no integration is created, if the test alert cannot be delivered."
+	capellaschema.AddAttr(attrs, "test_on_create", alertIntegrationBuilder, testOnCreate)
+
+	return schema.Schema{
+		MarkdownDescription: "Manages an alert integration of a project. Alerts are delivered to a webhook, " +
+			"such as a PagerDuty, Slack or custom endpoint.",
+		Attributes: attrs,
+	}
+}
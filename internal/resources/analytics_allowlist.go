@@ -0,0 +1,369 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AnalyticsAllowList{}
+	_ resource.ResourceWithConfigure   = &AnalyticsAllowList{}
+	_ resource.ResourceWithImportState = &AnalyticsAllowList{}
+)
+
+const errorMessageAfterAnalyticsAllowListCreation = "Analytics allow list creation is successful, but encountered an error while checking the current" +
+	" state of the allow list. Please run `terraform plan` after 1-2 minutes to know the" +
+	" current allow list state. Additionally, run `terraform apply --refresh-only` to update" +
+	" the state from remote, unexpected error: "
+
+const errorMessageWhileAnalyticsAllowListCreation = "There is an error during analytics allow list creation. Please check in Capella to see if any hanging resources" +
+	" have been created, unexpected error: "
+
+// AnalyticsAllowList is the analytics cluster allowed CIDR resource implementation.
+type AnalyticsAllowList struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsAllowList is a helper function to simplify the provider implementation.
+func NewAnalyticsAllowList() resource.Resource {
+	return &AnalyticsAllowList{}
+}
+
+// Metadata returns the analytics allowlist resource type name.
+func (r *AnalyticsAllowList) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_allowlist"
+}
+
+// Schema defines the schema for the analytics allowlist resource.
+func (r *AnalyticsAllowList) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AnalyticsAllowlistSchema()
+}
+
+// Configure adds the provider configured client to the analytics allowlist resource.
+func (r *AnalyticsAllowList) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.Data = data
+}
+
+// ImportState imports a remote analytics allowlist that is not created by Terraform.
+// example: id=cidr123,analytics_cluster_id=analytics123,project_id=proj123,organization_id=org123
+func (r *AnalyticsAllowList) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create creates a new allowed CIDR for the analytics cluster.
+func (r *AnalyticsAllowList) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AnalyticsAllowList
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allowListRequest, err := buildAnalyticsAllowListRequest(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating analytics allow list",
+			"Could not create analytics allow list, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = plan.OrganizationId.ValueString()
+		projectId          = plan.ProjectId.ValueString()
+		analyticsClusterId = plan.AnalyticsClusterId.ValueString()
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	createResp, err := r.ClientV2.PostAnalyticsAllowedCidrWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, allowListRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error executing request",
+			errorMessageWhileAnalyticsAllowListCreation+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON201 == nil {
+		resp.Diagnostics.AddError(
+			"Error creating analytics allow list",
+			errorMessageWhileAnalyticsAllowListCreation+fmt.Sprintf("unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+	allowListId := createResp.JSON201.Id
+
+	diags = resp.State.Set(ctx, initializeAnalyticsAllowListWithPlanAndId(plan, allowListId))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := r.refreshAnalyticsAllowList(ctx, organizationId, projectId, analyticsClusterId, allowListId)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error reading Capella analytics allow list",
+			errorMessageAfterAnalyticsAllowListCreation+api.ParseError(err),
+		)
+		return
+	}
+
+	// This is added to workaround any timezone conversions that the API does automatically and
+	// may cause an issue in the state file.
+	refreshedState.ExpiresAt = plan.ExpiresAt
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the allowed CIDR of the analytics cluster.
+func (r *AnalyticsAllowList) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AnalyticsAllowList
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Analytics AllowList",
+			"Could not read Capella analytics allow list: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+		allowListId        = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := r.refreshAnalyticsAllowList(ctx, organizationId, projectId, analyticsClusterId, allowListId)
+	switch {
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Analytics AllowList",
+			"Could not read Capella analytics allowListID "+allowListId+": "+err.Error(),
+		)
+		return
+	}
+
+	if sameInstant(state.ExpiresAt, refreshedState.ExpiresAt) {
+		refreshedState.ExpiresAt = state.ExpiresAt
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the analytics allowlist.
+func (r *AnalyticsAllowList) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// Allowed CIDRs of an analytics cluster can only be created, read and deleted.
+	// Every configurable attribute is marked RequiresReplace, so terraform apply
+	// deletes and re-creates the allowed CIDR instead.
+}
+
+// Delete deletes the allowed CIDR of the analytics cluster.
+func (r *AnalyticsAllowList) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AnalyticsAllowList
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Capella Analytics Allow List",
+			"Could not delete Capella analytics allow list: "+err.Error(),
+		)
+		return
+	}
+
+	allowListId := IDs[providerschema.Id]
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(
+		IDs[providerschema.OrganizationId], IDs[providerschema.ProjectId], IDs[providerschema.AnalyticsClusterId],
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	allowListUUID, err := utils.ParseUUID("id", allowListId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	deleteResp, err := r.ClientV2.DeleteColumnarAllowedCidrByIDWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, allowListUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Capella Analytics Allow List",
+			"Could not delete Capella analytics allowListID "+allowListId+": "+errors.ErrExecutingRequest.Error()+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting Capella Analytics Allow List",
+			fmt.Sprintf("Could not delete Capella analytics allowListID %s, unexpected response status %d: %s",
+				allowListId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// refreshAnalyticsAllowList retrieves the allowed CIDR and converts it to the Terraform state.
+// errors.ErrNotFound is returned when the allowed CIDR or its analytics cluster does not exist.
+func (r *AnalyticsAllowList) refreshAnalyticsAllowList(
+	ctx context.Context, organizationId, projectId, analyticsClusterId, allowListId string,
+) (*providerschema.AnalyticsAllowList, error) {
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	allowListUUID, err := utils.ParseUUID("id", allowListId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := r.ClientV2.GetColumnarAllowedCidrByIDWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, allowListUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	allowList := getResp.JSON200
+
+	audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(allowList.Audit))
+	auditObj, diags := types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+	if diags.HasError() {
+		return nil, errors.ErrUnableToConvertAuditData
+	}
+
+	refreshedState := providerschema.AnalyticsAllowList{
+		Id:                 types.StringValue(allowList.Id),
+		OrganizationId:     types.StringValue(organizationId),
+		ProjectId:          types.StringValue(projectId),
+		AnalyticsClusterId: types.StringValue(analyticsClusterId),
+		Cidr:               types.StringValue(allowList.Cidr),
+		Comment:            types.StringValue(""),
+		ExpiresAt:          types.StringNull(),
+		Status:             types.StringValue(string(allowList.Status)),
+		Type:               types.StringValue(string(allowList.Type)),
+		Audit:              auditObj,
+	}
+
+	if allowList.Comment != nil {
+		refreshedState.Comment = types.StringValue(*allowList.Comment)
+	}
+
+	if allowList.ExpiresAt != nil {
+		refreshedState.ExpiresAt = types.StringValue(allowList.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return &refreshedState, nil
+}
+
+// buildAnalyticsAllowListRequest converts the plan to the create allowed CIDR payload.
+func buildAnalyticsAllowListRequest(plan providerschema.AnalyticsAllowList) (apigen.CreateAllowedCidrRequest, error) {
+	request := apigen.CreateAllowedCidrRequest{
+		Cidr: plan.Cidr.ValueString(),
+	}
+
+	if !plan.Comment.IsNull() && !plan.Comment.IsUnknown() {
+		if !providerschema.IsTrimmed(plan.Comment.ValueString()) {
+			return request, fmt.Errorf("comment %s", errors.ErrNotTrimmed)
+		}
+		request.Comment = plan.Comment.ValueStringPointer()
+	}
+
+	if !plan.ExpiresAt.IsNull() && !plan.ExpiresAt.IsUnknown() {
+		expiresAt, err := time.Parse(time.RFC3339, plan.ExpiresAt.ValueString())
+		if err != nil {
+			return request, fmt.Errorf("expires_at must be an RFC3339 timestamp: %w", err)
+		}
+		request.ExpiresAt = &expiresAt
+	}
+
+	return request, nil
+}
+
+// initializeAnalyticsAllowListWithPlanAndId initializes an instance of providerschema.AnalyticsAllowList
+// with the specified plan and ID. It marks all computed fields as null.
+func initializeAnalyticsAllowListWithPlanAndId(plan providerschema.AnalyticsAllowList, id string) providerschema.AnalyticsAllowList {
+	plan.Id = types.StringValue(id)
+	plan.Status = types.StringNull()
+	plan.Type = types.StringNull()
+	plan.Audit = types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	if plan.Comment.IsNull() || plan.Comment.IsUnknown() {
+		plan.Comment = types.StringNull()
+	}
+	return plan
+}
+
+// sameInstant reports whether two RFC3339 timestamps represent the same point in time.
+func sameInstant(a, b types.String) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return false
+	}
+	at, err := time.Parse(time.RFC3339, a.ValueString())
+	if err != nil {
+		return false
+	}
+	bt, err := time.Parse(time.RFC3339, b.ValueString())
+	if err != nil {
+		return false
+	}
+	return at.Equal(bt)
+}
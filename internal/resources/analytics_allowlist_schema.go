@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsAllowlistBuilder = capellaschema.NewSchemaBuilder("analyticsAllowlist", "CreateAllowedCidrRequest")
+
+func AnalyticsAllowlistSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", analyticsAllowlistBuilder, stringAttribute([]string{computed, useStateForUnknown}), "AllowedCidr")
+	capellaschema.AddAttr(attrs, "organization_id", analyticsAllowlistBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", analyticsAllowlistBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsAllowlistBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cidr", analyticsAllowlistBuilder, stringAttribute([]string{required, requiresReplace}))
+	capellaschema.AddAttr(attrs, "comment", analyticsAllowlistBuilder, stringAttribute([]string{optional, computed, requiresReplace}))
+	capellaschema.AddAttr(attrs, "expires_at", analyticsAllowlistBuilder, stringAttribute([]string{optional, requiresReplace}))
+	capellaschema.AddAttr(attrs, "status", analyticsAllowlistBuilder, stringAttribute([]string{computed}), "AllowedCidr")
+	capellaschema.AddAttr(attrs, "type", analyticsAllowlistBuilder, stringAttribute([]string{computed, useStateForUnknown}), "AllowedCidr")
+	capellaschema.AddAttr(attrs, "audit", analyticsAllowlistBuilder, computedAuditAttribute())
+
+	return schema.Schema{
+		MarkdownDescription: "Manages the allowed IP addresses to connect to a Capella Columnar analytics cluster.",
+		Attributes:          attrs,
+	}
+}
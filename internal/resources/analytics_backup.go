@@ -0,0 +1,605 @@
+package resources
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/snapshot_backup"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AnalyticsBackup{}
+	_ resource.ResourceWithConfigure   = &AnalyticsBackup{}
+	_ resource.ResourceWithImportState = &AnalyticsBackup{}
+)
+
+const errorMessageWhileAnalyticsBackupCreation = "There is an error during analytics backup creation. Please check in Capella to see if any hanging resources" +
+	" have been created, unexpected error: "
+
+// analyticsBackupTimeout is the default create, update and delete timeout of an analytics
+// backup. Update includes waiting for the backup to complete before it is restored.
+const analyticsBackupTimeout = 60 * time.Minute
+
+// AnalyticsBackup is the analytics cluster backup resource implementation.
+type AnalyticsBackup struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsBackup is a helper function to simplify the provider implementation.
+func NewAnalyticsBackup() resource.Resource {
+	return &AnalyticsBackup{}
+}
+
+// Metadata returns the analytics backup resource type name.
+func (a *AnalyticsBackup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_backup"
+}
+
+// Schema defines the schema for the analytics backup resource.
+func (a *AnalyticsBackup) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AnalyticsBackupSchema()
+}
+
+// Configure adds the provider configured client to the analytics backup resource.
+func (a *AnalyticsBackup) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ImportState imports a remote analytics backup that is not created by Terraform.
+// example: id=<backupId>,analytics_cluster_id=<analyticsClusterId>,project_id=<projId>,organization_id=<orgId>
+func (a *AnalyticsBackup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create creates a new backup of the analytics cluster.
+func (a *AnalyticsBackup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AnalyticsBackup
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RestoreTimes.IsNull() {
+		resp.Diagnostics.AddError(
+			"Error creating analytics backup",
+			"The analytics backup cannot be restored before it is created. Please remove restore_times from the plan.",
+		)
+		return
+	}
+
+	var (
+		organizationId     = plan.OrganizationId.ValueString()
+		projectId          = plan.ProjectId.ValueString()
+		analyticsClusterId = plan.AnalyticsClusterId.ValueString()
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, analyticsBackupTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	createRequest := apigen.CreateColumnarAnalyticsBackupRequest{}
+	if !plan.Retention.IsNull() && !plan.Retention.IsUnknown() {
+		retention := int(plan.Retention.ValueInt64())
+		createRequest.Retention = &retention
+	}
+
+	createResp, err := a.ClientV2.CreateColumnarAnalyticsBackupWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error executing request",
+			errorMessageWhileAnalyticsBackupCreation+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON202 == nil || createResp.JSON202.BackupId == nil {
+		resp.Diagnostics.AddError(
+			"Error executing request",
+			errorMessageWhileAnalyticsBackupCreation+fmt.Sprintf("unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	backupId := *createResp.JSON202.BackupId
+
+	// The backup exists remotely from here on. Persist the known ID first so a failed
+	// lookup leaves a resource that is refreshed, rather than a second backup on re-apply.
+	diags = resp.State.Set(ctx, initializeAnalyticsBackupWithPlanAndId(plan, backupId))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var backup *apigen.GetColumnarAnalyticsBackupResponse
+lookup:
+	for attempt := 0; attempt < 10; attempt++ {
+		backup, err = a.getAnalyticsBackup(ctx, organizationId, projectId, analyticsClusterId, backupId)
+		if !stderrors.Is(err, errors.ErrNotFound) {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break lookup
+		case <-time.After(2 * time.Second):
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error while checking latest analytics backup status",
+			errorMessageWhileAnalyticsBackupCreation+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := morphToAnalyticsBackup(ctx, backup, organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating analytics backup",
+			"Could not create analytics backup: "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState.RestoreTimes = plan.RestoreTimes
+	refreshedState.RestoreId = types.StringNull()
+	refreshedState.RestoreStatus = types.StringNull()
+	refreshedState.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the analytics backup.
+func (a *AnalyticsBackup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AnalyticsBackup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading analytics backup",
+			"Could not read analytics backup with id "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+		backupId           = IDs[providerschema.Id]
+	)
+
+	backup, err := a.getAnalyticsBackup(ctx, organizationId, projectId, analyticsClusterId, backupId)
+	switch {
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error reading analytics backup",
+			"Could not read analytics backup with id "+backupId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := morphToAnalyticsBackup(ctx, backup, organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading analytics backup",
+			"Could not read analytics backup with id "+backupId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState.RestoreTimes = state.RestoreTimes
+	refreshedState.RestoreId = state.RestoreId
+	refreshedState.RestoreStatus = a.refreshRestoreStatus(ctx, organizationId, projectId, analyticsClusterId, state.RestoreId, state.RestoreStatus)
+	refreshedState.Timeouts = state.Timeouts
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the retention of the analytics backup and restores the analytics cluster
+// from it when restore_times is increased.
+func (a *AnalyticsBackup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.AnalyticsBackup
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := plan.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating analytics backup",
+			"Could not update analytics backup with id "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+		backupId           = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+	backupUUID, err := utils.ParseUUID("id", backupId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, analyticsBackupTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	if !plan.Retention.IsUnknown() && plan.Retention.ValueInt64() != state.Retention.ValueInt64() {
+		updateResp, err := a.ClientV2.UpdateColumnarAnalyticsBackupRetentionWithResponse(
+			ctx, orgUUID, projUUID, analyticsClusterUUID, backupUUID,
+			apigen.EditColumnarAnalyticsBackupRetentionRequest{Retention: int(plan.Retention.ValueInt64())},
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating analytics backup retention",
+				"Could not update analytics backup with id "+backupId+": "+errors.ErrExecutingRequest.Error()+": "+err.Error(),
+			)
+			return
+		}
+		if updateResp.StatusCode() != http.StatusNoContent && updateResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError(
+				"Error updating analytics backup retention",
+				fmt.Sprintf("Could not update analytics backup with id %s, unexpected response status %d: %s",
+					backupId, updateResp.StatusCode(), string(updateResp.Body)),
+			)
+			return
+		}
+	}
+
+	restoreId, restoreStatus := state.RestoreId, state.RestoreStatus
+
+	if plan.RestoreTimes.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Error restoring analytics backup",
+			"Could not restore analytics backup with id "+backupId+": plan restore times value is not set",
+		)
+		return
+	}
+
+	if !plan.RestoreTimes.IsNull() {
+		planRestoreTimes := plan.RestoreTimes.ValueBigFloat()
+		if !state.RestoreTimes.IsNull() && planRestoreTimes.Cmp(state.RestoreTimes.ValueBigFloat()) == -1 {
+			resp.Diagnostics.AddError(
+				"Error restoring analytics backup",
+				"Could not restore analytics backup with id "+backupId+": plan restore times value is not greater than state restore times value",
+			)
+			return
+		}
+		if state.RestoreTimes.IsNull() || planRestoreTimes.Cmp(state.RestoreTimes.ValueBigFloat()) == 1 {
+			restore, err := a.restoreAnalyticsBackup(ctx, orgUUID, projUUID, analyticsClusterUUID, backupUUID, organizationId, projectId, analyticsClusterId)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error restoring analytics backup",
+					"Could not restore analytics backup with id "+backupId+": "+err.Error(),
+				)
+				return
+			}
+			restoreId = types.StringPointerValue(restore.Id)
+			restoreStatus = types.StringPointerValue(restore.Status)
+		}
+	}
+
+	backup, err := a.getAnalyticsBackup(ctx, organizationId, projectId, analyticsClusterId, backupId)
+	switch {
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error reading analytics backup",
+			"Could not read analytics backup with id "+backupId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := morphToAnalyticsBackup(ctx, backup, organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading analytics backup",
+			"Could not read analytics backup with id "+backupId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState.RestoreTimes = plan.RestoreTimes
+	refreshedState.RestoreId = restoreId
+	refreshedState.RestoreStatus = restoreStatus
+	refreshedState.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the analytics backup.
+func (a *AnalyticsBackup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AnalyticsBackup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting analytics backup",
+			"Could not delete analytics backup with id "+state.Id.String()+" unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	backupId := IDs[providerschema.Id]
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(IDs[providerschema.OrganizationId], IDs[providerschema.ProjectId], IDs[providerschema.AnalyticsClusterId])
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+	backupUUID, err := utils.ParseUUID("id", backupId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, analyticsBackupTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	deleteResp, err := a.ClientV2.DeleteColumnarAnalyticsBackupWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, backupUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting analytics backup",
+			"Could not delete analytics backup with id "+backupId+": "+errors.ErrExecutingRequest.Error()+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusAccepted, http.StatusNoContent:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+	default:
+		resp.Diagnostics.AddError(
+			"Error deleting analytics backup",
+			fmt.Sprintf("Could not delete analytics backup with id %s, unexpected response status %d: %s",
+				backupId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// getAnalyticsBackup retrieves an analytics backup by its ID. There is no endpoint to get a
+// single backup, so every page of the backups list is searched for it.
+func (a *AnalyticsBackup) getAnalyticsBackup(
+	ctx context.Context, organizationId, projectId, analyticsClusterId, backupId string,
+) (*apigen.GetColumnarAnalyticsBackupResponse, error) {
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/analyticsClusters/%s/cloudSnapshotBackups", a.HostURL, organizationId, projectId, analyticsClusterId)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	backups, err := api.GetPaginated[[]apigen.GetColumnarAnalyticsBackupResponse](ctx, a.ClientV1, a.Token, cfg, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range backups {
+		if backups[i].Id != nil && *backups[i].Id == backupId {
+			return &backups[i], nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+// getAnalyticsRestore retrieves a restore of the analytics cluster by its ID from the restores list.
+func (a *AnalyticsBackup) getAnalyticsRestore(
+	ctx context.Context, organizationId, projectId, analyticsClusterId, restoreId string,
+) (*apigen.GetColumnarAnalyticsRestoreResponse, error) {
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/analyticsClusters/%s/cloudSnapshotBackups/restores", a.HostURL, organizationId, projectId, analyticsClusterId)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+
+	restores, err := api.GetPaginated[[]apigen.GetColumnarAnalyticsRestoreResponse](ctx, a.ClientV1, a.Token, cfg, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range restores {
+		if restores[i].Id != nil && *restores[i].Id == restoreId {
+			return &restores[i], nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+// refreshRestoreStatus returns the current status of the tracked restore. The known status is
+// kept when there is no tracked restore, or when it cannot be read.
+func (a *AnalyticsBackup) refreshRestoreStatus(
+	ctx context.Context, organizationId, projectId, analyticsClusterId string, restoreId, restoreStatus types.String,
+) types.String {
+	if restoreId.IsNull() || restoreId.IsUnknown() {
+		return restoreStatus
+	}
+
+	restore, err := a.getAnalyticsRestore(ctx, organizationId, projectId, analyticsClusterId, restoreId.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "could not refresh analytics restore status", map[string]interface{}{
+			"restoreId": restoreId.ValueString(),
+			"err":       err,
+		})
+		return restoreStatus
+	}
+	return types.StringPointerValue(restore.Status)
+}
+
+// restoreAnalyticsBackup waits for the backup to complete, restores the analytics cluster from
+// it and waits for the restore to show up in the restores list.
+func (a *AnalyticsBackup) restoreAnalyticsBackup(
+	ctx context.Context,
+	orgUUID, projUUID, analyticsClusterUUID, backupUUID uuid.UUID,
+	organizationId, projectId, analyticsClusterId string,
+) (*apigen.GetColumnarAnalyticsRestoreResponse, error) {
+	backupId := backupUUID.String()
+	if err := a.waitForAnalyticsBackupComplete(ctx, organizationId, projectId, analyticsClusterId, backupId); err != nil {
+		return nil, fmt.Errorf("backup did not reach complete state: %w", err)
+	}
+
+	restoreResp, err := a.ClientV2.RestoreColumnarAnalyticsClusterWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, backupUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+	if restoreResp.JSON202 == nil || restoreResp.JSON202.RestoreId == nil {
+		return nil, fmt.Errorf("unexpected response status %d: %s", restoreResp.StatusCode(), string(restoreResp.Body))
+	}
+
+	restoreId := *restoreResp.JSON202.RestoreId
+
+	// The restore is not always readable from the restores list as soon as it is accepted.
+	const (
+		pollInterval = 2 * time.Second
+		maxAttempts  = 30
+	)
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		restore, err := a.getAnalyticsRestore(ctx, organizationId, projectId, analyticsClusterId, restoreId)
+		if err == nil {
+			return restore, nil
+		}
+		if !stderrors.Is(err, errors.ErrNotFound) {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+
+	tflog.Debug(ctx, "analytics restore did not appear in the restores list", map[string]interface{}{
+		"restoreId": restoreId,
+	})
+	return &apigen.GetColumnarAnalyticsRestoreResponse{Id: &restoreId}, nil
+}
+
+// waitForAnalyticsBackupComplete polls the analytics backup until its progress status reaches
+// a final state or ctx is done. A backup cannot be restored before it completes.
+func (a *AnalyticsBackup) waitForAnalyticsBackupComplete(ctx context.Context, organizationId, projectId, analyticsClusterId, backupId string) error {
+	const pollInterval = 30 * time.Second
+	for {
+		backup, err := a.getAnalyticsBackup(ctx, organizationId, projectId, analyticsClusterId, backupId)
+		if err != nil {
+			return err
+		}
+		if backup.Progress != nil && backup.Progress.Status != nil {
+			switch snapshot_backup.State(*backup.Progress.Status) {
+			case snapshot_backup.Complete:
+				return nil
+			case snapshot_backup.Failed:
+				return fmt.Errorf("analytics backup %s reached failed state", backupId)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out while waiting for analytics backup %s to complete: %w", backupId, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// morphToAnalyticsBackup converts an analytics backup returned by Capella to the Terraform state.
+func morphToAnalyticsBackup(
+	ctx context.Context,
+	backup *apigen.GetColumnarAnalyticsBackupResponse,
+	organizationId, projectId, analyticsClusterId string,
+) (*providerschema.AnalyticsBackup, error) {
+	progress := providerschema.NewAnalyticsBackupProgress(backup.Progress)
+	progressObj, diags := types.ObjectValueFrom(ctx, progress.AttributeTypes(), progress)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error during progress conversion")
+	}
+
+	analyticsBackup := providerschema.NewAnalyticsBackup(*backup, organizationId, projectId, analyticsClusterId, progressObj)
+	return &analyticsBackup, nil
+}
+
+// initializeAnalyticsBackupWithPlanAndId initializes an instance of providerschema.AnalyticsBackup
+// with the specified plan and ID. It marks all computed fields as null.
+func initializeAnalyticsBackupWithPlanAndId(plan providerschema.AnalyticsBackup, id string) providerschema.AnalyticsBackup {
+	plan.Id = types.StringValue(id)
+	if plan.Retention.IsUnknown() {
+		plan.Retention = types.Int64Null()
+	}
+	plan.CreatedAt = types.StringNull()
+	plan.Expiration = types.StringNull()
+	plan.DatabaseSize = types.Int64Null()
+	plan.Region = types.StringNull()
+	plan.Type = types.StringNull()
+	plan.Progress = types.ObjectNull(providerschema.Progress{}.AttributeTypes())
+	plan.RestoreId = types.StringNull()
+	plan.RestoreStatus = types.StringNull()
+	return plan
+}
@@ -0,0 +1,319 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AnalyticsBackupSchedule{}
+	_ resource.ResourceWithConfigure   = &AnalyticsBackupSchedule{}
+	_ resource.ResourceWithImportState = &AnalyticsBackupSchedule{}
+)
+
+const errorMessageWhileAnalyticsBackupScheduleCreation = "There is an error during analytics backup schedule creation. Please check in Capella to see if any hanging resources" +
+	" have been created, unexpected error: "
+
+// AnalyticsBackupSchedule is the analytics cluster backup schedule resource implementation.
+type AnalyticsBackupSchedule struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsBackupSchedule is a helper function to simplify the provider implementation.
+func NewAnalyticsBackupSchedule() resource.Resource {
+	return &AnalyticsBackupSchedule{}
+}
+
+// Metadata returns the analytics backup schedule resource type name.
+func (a *AnalyticsBackupSchedule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_backup_schedule"
+}
+
+// Schema defines the schema for the analytics backup schedule resource.
+func (a *AnalyticsBackupSchedule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AnalyticsBackupScheduleSchema()
+}
+
+// Configure adds the provider configured client to the analytics backup schedule resource.
+func (a *AnalyticsBackupSchedule) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ImportState imports a remote analytics backup schedule that is not created by Terraform.
+// example: analytics_cluster_id=<analyticsClusterId>,project_id=<projId>,organization_id=<orgId>
+func (a *AnalyticsBackupSchedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("analytics_cluster_id"), req, resp)
+}
+
+// Create creates the backup schedule of the analytics cluster.
+func (a *AnalyticsBackupSchedule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AnalyticsBackupSchedule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId     = plan.OrganizationId.ValueString()
+		projectId          = plan.ProjectId.ValueString()
+		analyticsClusterId = plan.AnalyticsClusterId.ValueString()
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	// Backups start at the next full hour unless a start time is configured.
+	if plan.StartTime.IsNull() || plan.StartTime.IsUnknown() {
+		plan.StartTime = timetypes.NewRFC3339TimeValue(time.Now().UTC().Truncate(time.Hour).Add(time.Hour))
+	}
+
+	if err := a.upsertAnalyticsBackupSchedule(ctx, orgUUID, projUUID, analyticsClusterUUID, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Upserting Analytics Backup Schedule in Capella",
+			errorMessageWhileAnalyticsBackupScheduleCreation+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := a.retrieveAnalyticsBackupSchedule(ctx, orgUUID, projUUID, analyticsClusterUUID, organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error Getting Analytics Backup Schedule in Capella",
+			"Could not get Capella analytics backup schedule for analytics cluster with ID "+analyticsClusterId+": "+err.Error(),
+		)
+		refreshedState = &plan
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the backup schedule of the analytics cluster.
+func (a *AnalyticsBackupSchedule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AnalyticsBackupSchedule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading analytics backup schedule",
+			"Could not read backup schedule for analytics cluster with id "+state.AnalyticsClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	refreshedState, err := a.retrieveAnalyticsBackupSchedule(ctx, orgUUID, projUUID, analyticsClusterUUID, organizationId, projectId, analyticsClusterId)
+	switch {
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error reading analytics backup schedule",
+			"Could not read backup schedule for analytics cluster with id "+analyticsClusterId+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the backup schedule of the analytics cluster.
+func (a *AnalyticsBackupSchedule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan providerschema.AnalyticsBackupSchedule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := plan.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating analytics backup schedule",
+			"Could not update backup schedule for analytics cluster with id "+plan.AnalyticsClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	if err := a.upsertAnalyticsBackupSchedule(ctx, orgUUID, projUUID, analyticsClusterUUID, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Upserting Analytics Backup Schedule in Capella",
+			"Could not upsert Capella analytics backup schedule for analytics cluster with ID "+analyticsClusterId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := a.retrieveAnalyticsBackupSchedule(ctx, orgUUID, projUUID, analyticsClusterUUID, organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Analytics Backup Schedule in Capella",
+			"Could not get Capella analytics backup schedule for analytics cluster with ID "+analyticsClusterId+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the backup schedule of the analytics cluster.
+func (a *AnalyticsBackupSchedule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AnalyticsBackupSchedule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting analytics backup schedule",
+			"Could not delete backup schedule for analytics cluster with id "+state.AnalyticsClusterId.String()+" unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	analyticsClusterId := IDs[providerschema.AnalyticsClusterId]
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(IDs[providerschema.OrganizationId], IDs[providerschema.ProjectId], analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	deleteResp, err := a.ClientV2.DeleteColumnarAnalyticsBackupScheduleWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting analytics backup schedule",
+			"Could not delete backup schedule for analytics cluster with id "+analyticsClusterId+": "+errors.ErrExecutingRequest.Error()+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusAccepted, http.StatusNoContent:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+	default:
+		resp.Diagnostics.AddError(
+			"Error deleting analytics backup schedule",
+			fmt.Sprintf("Could not delete backup schedule for analytics cluster with id %s, unexpected response status %d: %s",
+				analyticsClusterId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// upsertAnalyticsBackupSchedule creates or replaces the backup schedule of the analytics cluster.
+func (a *AnalyticsBackupSchedule) upsertAnalyticsBackupSchedule(
+	ctx context.Context, orgUUID, projUUID, analyticsClusterUUID uuid.UUID, plan providerschema.AnalyticsBackupSchedule,
+) error {
+	startTime, diags := plan.StartTime.ValueRFC3339Time()
+	if diags.HasError() {
+		return fmt.Errorf("invalid start_time %s", plan.StartTime.ValueString())
+	}
+
+	upsertRequest := apigen.UpsertColumnarAnalyticsBackupScheduleRequest{
+		Interval:  int(plan.Interval.ValueInt64()),
+		Retention: int(plan.Retention.ValueInt64()),
+		StartTime: startTime,
+	}
+
+	upsertResp, err := a.ClientV2.UpsertColumnarAnalyticsBackupScheduleWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, upsertRequest)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch upsertResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	default:
+		tflog.Debug(ctx, "error upserting analytics backup schedule", map[string]interface{}{
+			"upsertRequest": upsertRequest,
+			"statusCode":    upsertResp.StatusCode(),
+		})
+		return fmt.Errorf("unexpected response status %d: %s", upsertResp.StatusCode(), string(upsertResp.Body))
+	}
+}
+
+// retrieveAnalyticsBackupSchedule retrieves the backup schedule of the analytics cluster and converts
+// it to the Terraform state. errors.ErrNotFound is returned when no schedule exists.
+func (a *AnalyticsBackupSchedule) retrieveAnalyticsBackupSchedule(
+	ctx context.Context,
+	orgUUID, projUUID, analyticsClusterUUID uuid.UUID,
+	organizationId, projectId, analyticsClusterId string,
+) (*providerschema.AnalyticsBackupSchedule, error) {
+	getResp, err := a.ClientV2.GetColumnarAnalyticsBackupScheduleWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	return providerschema.NewAnalyticsBackupSchedule(*getResp.JSON200, organizationId, projectId, analyticsClusterId), nil
+}
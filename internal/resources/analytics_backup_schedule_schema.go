@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsBackupScheduleBuilder = capellaschema.NewSchemaBuilder("analyticsBackupSchedule", "UpsertColumnarAnalyticsBackupScheduleRequest")
+
+func AnalyticsBackupScheduleSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", analyticsBackupScheduleBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", analyticsBackupScheduleBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsBackupScheduleBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "interval", analyticsBackupScheduleBuilder, int64Attribute(required))
+	capellaschema.AddAttr(attrs, "retention", analyticsBackupScheduleBuilder, int64Attribute(required))
+	capellaschema.AddAttr(attrs, "start_time", analyticsBackupScheduleBuilder, rfc3339Attribute(optional, computed, useStateForUnknown))
+
+	return schema.Schema{
+		MarkdownDescription: "Manages the backup schedule of a Capella Columnar analytics cluster.",
+		Attributes:          attrs,
+	}
+}
@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsBackupBuilder = capellaschema.NewSchemaBuilder("analyticsBackup", "GetColumnarAnalyticsBackupResponse")
+
+func AnalyticsBackupSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", analyticsBackupBuilder, stringAttribute([]string{computed, useStateForUnknown}))
+	capellaschema.AddAttr(attrs, "organization_id", analyticsBackupBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", analyticsBackupBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsBackupBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "retention", analyticsBackupBuilder, int64Attribute(optional, computed, useStateForUnknown))
+	capellaschema.AddAttr(attrs, "created_at", analyticsBackupBuilder, stringAttribute([]string{computed, useStateForUnknown}))
+	capellaschema.AddAttr(attrs, "expiration", analyticsBackupBuilder, stringAttribute([]string{computed}))
+	capellaschema.AddAttr(attrs, "database_size", analyticsBackupBuilder, int64Attribute(computed))
+	capellaschema.AddAttr(attrs, "region", analyticsBackupBuilder, stringAttribute([]string{computed, useStateForUnknown}))
+	capellaschema.AddAttr(attrs, "type", analyticsBackupBuilder, stringAttribute([]string{computed, useStateForUnknown}))
+
+	progressAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(progressAttrs, "status", analyticsBackupBuilder, stringAttribute([]string{computed}), "ColumnarAnalyticsBackupProgress")
+	capellaschema.AddAttr(progressAttrs, "time", analyticsBackupBuilder, stringAttribute([]string{computed}), "ColumnarAnalyticsBackupProgress")
+	capellaschema.AddAttr(attrs, "progress", analyticsBackupBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: progressAttrs,
+	})
+
+	// The restore attributes drive the restore endpoint and are not part of the backup payload,
+	// so their descriptions are set here rather than looked up from the spec.
+	restoreTimes := numberAttribute(optional)
+	restoreTimes.MarkdownDescription = "Restores the analytics cluster from this backup each time the value is increased. " +
+		"Must not be set when the backup is created."
+	capellaschema.AddAttr(attrs, "restore_times", analyticsBackupBuilder, restoreTimes)
+
+	restoreId := stringAttribute([]string{computed})
+	restoreId.MarkdownDescription = "The ID of the latest restore started from this resource."
+	capellaschema.AddAttr(attrs, "restore_id", analyticsBackupBuilder, restoreId)
+
+	restoreStatus := stringAttribute([]string{computed})
+	restoreStatus.MarkdownDescription = "The status of the latest restore started from this resource."
+	capellaschema.AddAttr(attrs, "restore_status", analyticsBackupBuilder, restoreStatus)
+
+	return schema.Schema{
+		MarkdownDescription: "Manages an on-demand backup of a Capella Columnar analytics cluster, and restores the analytics cluster from it.",
+		Attributes:          attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
@@ -0,0 +1,633 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AnalyticsCluster{}
+	_ resource.ResourceWithConfigure   = &AnalyticsCluster{}
+	_ resource.ResourceWithImportState = &AnalyticsCluster{}
+)
+
+const errorMessageAfterAnalyticsClusterCreationInitiation = "Analytics cluster creation is initiated, but encountered an error while checking the current" +
+	" state of the analytics cluster. Please run `terraform plan` after 4-5 minutes to know the" +
+	" current status of the analytics cluster. Additionally, run `terraform apply --refresh-only` to update" +
+	" the state from remote, unexpected error: "
+
+const errorMessageWhileAnalyticsClusterCreation = "There is an error during analytics cluster creation. Please check in Capella to see if any hanging resources" +
+	" have been created, unexpected error: "
+
+// analyticsClusterInitialPollDelay and analyticsClusterPollInterval pace the wait for an
+// analytics cluster to settle into the requested state. The initial delay gives Capella time
+// to move the cluster out of its previous state. analyticsClusterTimeout is the default
+// create, update and delete timeout.
+var (
+	analyticsClusterInitialPollDelay = 30 * time.Second
+	analyticsClusterPollInterval     = 10 * time.Second
+	analyticsClusterTimeout          = 60 * time.Minute
+)
+
+// analyticsClusterFailedStates are the states in which an analytics cluster operation has failed.
+var analyticsClusterFailedStates = []apigen.CurrentColumnarState{
+	apigen.CurrentColumnarStateDeploymentFailed,
+	apigen.CurrentColumnarStateScaleFailed,
+	apigen.CurrentColumnarStateDestroyFailed,
+}
+
+// AnalyticsCluster is the Capella Columnar analytics cluster resource implementation.
+type AnalyticsCluster struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsCluster is a helper function to simplify the provider implementation.
+func NewAnalyticsCluster() resource.Resource {
+	return &AnalyticsCluster{}
+}
+
+// Metadata returns the analytics cluster resource type name.
+func (a *AnalyticsCluster) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_cluster"
+}
+
+// Schema defines the schema for the analytics cluster resource.
+func (a *AnalyticsCluster) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AnalyticsClusterSchema()
+}
+
+// Configure adds the provider configured client to the analytics cluster resource.
+func (a *AnalyticsCluster) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ImportState imports a remote analytics cluster that is not created by Terraform.
+// example: id=analytics123,project_id=proj123,organization_id=org123
+func (a *AnalyticsCluster) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create creates a new analytics cluster and waits for it to become healthy.
+// The cluster is turned off afterwards when state is set to off.
+func (a *AnalyticsCluster) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AnalyticsCluster
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.IfMatch.IsNull() && !plan.IfMatch.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Error creating analytics cluster",
+			"Could not create analytics cluster, unexpected error: "+errors.ErrIfMatchCannotBeSetWhileCreate.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+	)
+
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+	orgUUID, projUUID := uuids[0], uuids[1]
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, analyticsClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	createResp, err := a.ClientV2.CreateAnalyticsClusterWithResponse(ctx, orgUUID, projUUID, buildCreateAnalyticsClusterRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating analytics cluster",
+			errorMessageWhileAnalyticsClusterCreation+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON202 == nil {
+		resp.Diagnostics.AddError(
+			"Error creating analytics cluster",
+			errorMessageWhileAnalyticsClusterCreation+fmt.Sprintf("unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+	analyticsClusterUUID := createResp.JSON202.Id
+
+	diags = resp.State.Set(ctx, initializePendingAnalyticsClusterWithPlanAndId(plan, analyticsClusterUUID.String()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = a.checkAnalyticsClusterStatus(ctx, orgUUID, projUUID, analyticsClusterUUID, apigen.CurrentColumnarStateHealthy)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error creating analytics cluster",
+			errorMessageAfterAnalyticsClusterCreationInitiation+err.Error(),
+		)
+		return
+	}
+
+	if plan.State.ValueString() == "off" {
+		if err := a.switchAnalyticsCluster(ctx, orgUUID, projUUID, analyticsClusterUUID, "off"); err != nil {
+			resp.Diagnostics.AddWarning(
+				"Error turning off analytics cluster",
+				"Analytics cluster "+analyticsClusterUUID.String()+" was created but could not be turned off: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	refreshedState, err := a.retrieveAnalyticsCluster(ctx, organizationId, projectId, analyticsClusterUUID.String())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error creating analytics cluster",
+			errorMessageAfterAnalyticsClusterCreationInitiation+err.Error(),
+		)
+		return
+	}
+	refreshedState.State = plan.State
+	refreshedState.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the analytics cluster information.
+func (a *AnalyticsCluster) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AnalyticsCluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Analytics Cluster",
+			"Could not read Capella analytics cluster "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := a.retrieveAnalyticsCluster(ctx, organizationId, projectId, analyticsClusterId)
+	switch {
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Analytics Cluster",
+			"Could not read Capella analytics cluster "+analyticsClusterId+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only report drift on state when it is managed from Terraform and the cluster
+	// is settled in, or moving to, one of the on/off states.
+	refreshedState.State = state.State
+	if !state.State.IsNull() {
+		if powerState := providerschema.AnalyticsClusterPowerState(apigen.CurrentColumnarState(refreshedState.CurrentState.ValueString())); powerState != "" {
+			refreshedState.State = types.StringValue(powerState)
+		}
+	}
+
+	if !state.IfMatch.IsUnknown() && !state.IfMatch.IsNull() {
+		refreshedState.IfMatch = state.IfMatch
+	}
+	refreshedState.Timeouts = state.Timeouts
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the analytics cluster. A cluster that is turned on is switched on
+// before the update is applied, and a cluster that is turned off is switched off after it.
+func (a *AnalyticsCluster) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.AnalyticsCluster
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating analytics cluster",
+			"Could not update analytics cluster id "+state.Id.String()+" unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, analyticsClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	currentPowerState := providerschema.AnalyticsClusterPowerState(apigen.CurrentColumnarState(state.CurrentState.ValueString()))
+	desiredPowerState := plan.State.ValueString()
+
+	if desiredPowerState == "on" && currentPowerState != "on" {
+		if err := a.switchAnalyticsCluster(ctx, orgUUID, projUUID, analyticsClusterUUID, "on"); err != nil {
+			resp.Diagnostics.AddError(
+				"Error turning on analytics cluster",
+				"Could not turn on analytics cluster id "+analyticsClusterId+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if analyticsClusterSettingsChanged(plan, state) {
+		params := &apigen.PutAnalyticsClusterParams{}
+		if !plan.IfMatch.IsUnknown() && !plan.IfMatch.IsNull() {
+			params.IfMatch = plan.IfMatch.ValueStringPointer()
+		}
+
+		putResp, err := a.ClientV2.PutAnalyticsClusterWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, params, buildUpdateAnalyticsClusterRequest(plan))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating analytics cluster",
+				"Could not update analytics cluster id "+analyticsClusterId+": "+errors.ErrExecutingRequest.Error()+": "+err.Error(),
+			)
+			return
+		}
+
+		switch putResp.StatusCode() {
+		case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		case http.StatusNotFound:
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		default:
+			resp.Diagnostics.AddError(
+				"Error updating analytics cluster",
+				fmt.Sprintf("Could not update analytics cluster id %s, unexpected response status %d: %s",
+					analyticsClusterId, putResp.StatusCode(), string(putResp.Body)),
+			)
+			return
+		}
+
+		err = a.checkAnalyticsClusterStatus(ctx, orgUUID, projUUID, analyticsClusterUUID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating analytics cluster",
+				"Could not update analytics cluster id "+analyticsClusterId+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if desiredPowerState == "off" && currentPowerState != "off" {
+		if err := a.switchAnalyticsCluster(ctx, orgUUID, projUUID, analyticsClusterUUID, "off"); err != nil {
+			resp.Diagnostics.AddError(
+				"Error turning off analytics cluster",
+				"Could not turn off analytics cluster id "+analyticsClusterId+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	currentState, err := a.retrieveAnalyticsCluster(ctx, organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating analytics cluster",
+			"Could not update analytics cluster id "+analyticsClusterId+": "+err.Error(),
+		)
+		return
+	}
+	currentState.State = plan.State
+	currentState.Timeouts = plan.Timeouts
+
+	if !plan.IfMatch.IsUnknown() && !plan.IfMatch.IsNull() {
+		currentState.IfMatch = plan.IfMatch
+	}
+
+	diags = resp.State.Set(ctx, currentState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the analytics cluster and waits for it to be removed.
+func (a *AnalyticsCluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AnalyticsCluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting analytics cluster",
+			"Could not delete analytics cluster id "+state.Id.String()+" unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	analyticsClusterId := IDs[providerschema.Id]
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(IDs[providerschema.OrganizationId], IDs[providerschema.ProjectId], analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, analyticsClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	deleteResp, err := a.ClientV2.DeleteAnalyticsClusterWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Capella Analytics Cluster",
+			"Could not delete analytics cluster id "+analyticsClusterId+": "+errors.ErrExecutingRequest.Error()+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusAccepted, http.StatusNoContent:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting Capella Analytics Cluster",
+			fmt.Sprintf("Could not delete analytics cluster id %s, unexpected response status %d: %s",
+				analyticsClusterId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+		return
+	}
+
+	err = a.checkAnalyticsClusterStatus(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	switch {
+	case err == errors.ErrNotFound:
+		// The analytics cluster is gone, as expected.
+		return
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error Deleting Capella Analytics Cluster",
+			"Could not delete analytics cluster id "+analyticsClusterId+": "+err.Error(),
+		)
+		return
+	}
+
+	// This case only occurs when the deletion settled without removing the cluster.
+	resp.Diagnostics.AddError(
+		"Error Deleting Capella Analytics Cluster",
+		"Could not delete analytics cluster id "+analyticsClusterId+", the analytics cluster still exists after deletion",
+	)
+}
+
+// getAnalyticsCluster retrieves the analytics cluster and its ETag from Capella.
+// errors.ErrNotFound is returned when the analytics cluster does not exist.
+func (a *AnalyticsCluster) getAnalyticsCluster(
+	ctx context.Context, orgUUID, projUUID, analyticsClusterUUID uuid.UUID,
+) (*apigen.GetColumnarAnalyticsClusterResponse, string, error) {
+	getResp, err := a.ClientV2.GetAnalyticsClusterWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, "", errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, "", fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	return getResp.JSON200, getResp.HTTPResponse.Header.Get("ETag"), nil
+}
+
+// retrieveAnalyticsCluster retrieves the analytics cluster and converts it to the Terraform state.
+func (a *AnalyticsCluster) retrieveAnalyticsCluster(ctx context.Context, organizationId, projectId, analyticsClusterId string) (*providerschema.AnalyticsCluster, error) {
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, etag, err := a.getAnalyticsCluster(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return providerschema.NewAnalyticsCluster(cluster, organizationId, projectId, etag), nil
+}
+
+// switchAnalyticsCluster turns the analytics cluster on or off and waits for it to reach
+// the matching healthy or turned off state.
+func (a *AnalyticsCluster) switchAnalyticsCluster(ctx context.Context, orgUUID, projUUID, analyticsClusterUUID uuid.UUID, powerState string) error {
+	var (
+		statusCode int
+		body       []byte
+		target     apigen.CurrentColumnarState
+	)
+
+	switch powerState {
+	case "on":
+		onResp, err := a.ClientV2.AnalyticsClusterOnWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body, target = onResp.StatusCode(), onResp.Body, apigen.CurrentColumnarStateHealthy
+	case "off":
+		offResp, err := a.ClientV2.AnalyticsClusterOffWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body, target = offResp.StatusCode(), offResp.Body, apigen.CurrentColumnarStateTurnedOff
+	default:
+		return fmt.Errorf("unsupported analytics cluster state %q", powerState)
+	}
+
+	switch statusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", statusCode, string(body))
+	}
+
+	return a.checkAnalyticsClusterStatus(ctx, orgUUID, projUUID, analyticsClusterUUID, target)
+}
+
+// checkAnalyticsClusterStatus monitors the status of an analytics cluster operation. It periodically
+// fetches the analytics cluster and waits until it reaches one of the target states or, when no target
+// states are given, any settled state. An error is returned if the cluster enters a failed state, ctx
+// is done or the status cannot be retrieved; errors.ErrNotFound is returned as is so that
+// callers waiting for a deletion can detect it.
+func (a *AnalyticsCluster) checkAnalyticsClusterStatus(
+	ctx context.Context, orgUUID, projUUID, analyticsClusterUUID uuid.UUID, targets ...apigen.CurrentColumnarState,
+) error {
+	if len(targets) == 0 {
+		targets = []apigen.CurrentColumnarState{
+			apigen.CurrentColumnarStateHealthy,
+			apigen.CurrentColumnarStateTurnedOff,
+		}
+	}
+
+	timer := time.NewTimer(analyticsClusterInitialPollDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("analytics cluster %s status transition timed out after initiation: %w", analyticsClusterUUID, ctx.Err())
+		case <-timer.C:
+			cluster, _, err := a.getAnalyticsCluster(ctx, orgUUID, projUUID, analyticsClusterUUID)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case slices.Contains(targets, cluster.CurrentState):
+				return nil
+			case slices.Contains(analyticsClusterFailedStates, cluster.CurrentState):
+				return fmt.Errorf("analytics cluster %s is in state %s", analyticsClusterUUID, cluster.CurrentState)
+			}
+
+			tflog.Info(ctx, "waiting for analytics cluster to complete the execution", map[string]interface{}{"current_state": cluster.CurrentState})
+			timer.Reset(analyticsClusterPollInterval)
+		}
+	}
+}
+
+// buildCreateAnalyticsClusterRequest converts the plan to the create analytics cluster payload.
+func buildCreateAnalyticsClusterRequest(plan providerschema.AnalyticsCluster) apigen.CreateColumnarAnalyticsClusterRequest {
+	request := apigen.CreateColumnarAnalyticsClusterRequest{
+		Name:          plan.Name.ValueString(),
+		CloudProvider: apigen.CreateColumnarAnalyticsClusterRequestCloudProvider(plan.CloudProvider.ValueString()),
+		Region:        plan.Region.ValueString(),
+		Nodes:         int(plan.Nodes.ValueInt64()),
+		Availability: apigen.Availability{
+			Type: apigen.AvailabilityType(plan.Availability.Type.ValueString()),
+		},
+		Compute: apigen.Compute{
+			Cpu: int(plan.Compute.Cpu.ValueInt64()),
+			Ram: int(plan.Compute.Ram.ValueInt64()),
+		},
+		Support: apigen.ColumnarSupport{
+			Plan:     apigen.ColumnarSupportPlan(plan.Support.Plan.ValueString()),
+			Timezone: apigen.ColumnarSupportTimezone(plan.Support.Timezone.ValueString()),
+		},
+	}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		request.Description = plan.Description.ValueStringPointer()
+	}
+
+	return request
+}
+
+// buildUpdateAnalyticsClusterRequest converts the plan to the update analytics cluster payload.
+func buildUpdateAnalyticsClusterRequest(plan providerschema.AnalyticsCluster) apigen.UpdateColumnarAnalyticsClusterRequest {
+	return apigen.UpdateColumnarAnalyticsClusterRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Nodes:       int(plan.Nodes.ValueInt64()),
+		Support: apigen.ColumnarSupport{
+			Plan:     apigen.ColumnarSupportPlan(plan.Support.Plan.ValueString()),
+			Timezone: apigen.ColumnarSupportTimezone(plan.Support.Timezone.ValueString()),
+		},
+	}
+}
+
+// analyticsClusterSettingsChanged reports whether any attribute sent in the update payload changed.
+func analyticsClusterSettingsChanged(plan, state providerschema.AnalyticsCluster) bool {
+	return !plan.Name.Equal(state.Name) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.Nodes.Equal(state.Nodes) ||
+		!plan.Support.Plan.Equal(state.Support.Plan) ||
+		!plan.Support.Timezone.Equal(state.Support.Timezone)
+}
+
+// initializePendingAnalyticsClusterWithPlanAndId initializes an instance of providerschema.AnalyticsCluster
+// with the specified plan and ID. It marks all computed fields as null.
+func initializePendingAnalyticsClusterWithPlanAndId(plan providerschema.AnalyticsCluster, id string) providerschema.AnalyticsCluster {
+	plan.Id = types.StringValue(id)
+	plan.CurrentState = types.StringNull()
+	plan.Etag = types.StringNull()
+	if plan.Description.IsNull() || plan.Description.IsUnknown() {
+		plan.Description = types.StringNull()
+	}
+	return plan
+}
+
+// parseAnalyticsClusterUUIDs parses the IDs of an analytics cluster into UUIDs for the generated API client.
+func parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId string) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "analytics_cluster_id", Value: analyticsClusterId},
+	)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids[0], uuids[1], uuids[2], nil
+}
@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsClusterBuilder = capellaschema.NewSchemaBuilder("analyticsCluster", "CreateColumnarAnalyticsClusterRequest")
+
+func AnalyticsClusterSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", analyticsClusterBuilder, stringAttribute([]string{computed, useStateForUnknown}), "GetColumnarAnalyticsClusterResponse")
+	capellaschema.AddAttr(attrs, "organization_id", analyticsClusterBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", analyticsClusterBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "name", analyticsClusterBuilder, stringAttribute([]string{required}))
+	capellaschema.AddAttr(attrs, "description", analyticsClusterBuilder, stringDefaultAttribute("", optional, computed))
+	capellaschema.AddAttr(attrs, "cloud_provider", analyticsClusterBuilder, stringAttribute([]string{required, requiresReplace}))
+	capellaschema.AddAttr(attrs, "region", analyticsClusterBuilder, stringAttribute([]string{required, requiresReplace}))
+	capellaschema.AddAttr(attrs, "nodes", analyticsClusterBuilder, int64Attribute(required))
+
+	computeAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(computeAttrs, "cpu", analyticsClusterBuilder, int64Attribute(required), "Compute")
+	capellaschema.AddAttr(computeAttrs, "ram", analyticsClusterBuilder, int64Attribute(required), "Compute")
+
+	capellaschema.AddAttr(attrs, "compute", analyticsClusterBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: computeAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	})
+
+	availabilityAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(availabilityAttrs, "type", analyticsClusterBuilder, stringAttribute([]string{required}), "Availability")
+
+	capellaschema.AddAttr(attrs, "availability", analyticsClusterBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: availabilityAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	})
+
+	supportAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(supportAttrs, "plan", analyticsClusterBuilder, stringAttribute([]string{required}), "ColumnarSupport")
+	capellaschema.AddAttr(supportAttrs, "timezone", analyticsClusterBuilder, stringAttribute([]string{required}), "ColumnarSupport")
+
+	capellaschema.AddAttr(attrs, "support", analyticsClusterBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: supportAttrs,
+	})
+
+	// State is not part of the analytics cluster payload; it drives the on/off
+	// endpoints, so the description is set here rather than looked up from the spec.
+	state := stringAttribute([]string{optional}, stringvalidator.OneOf("on", "off"))
+	state.MarkdownDescription = "The requested state of the analytics cluster, either `on` or `off`. " +
+		"Leave unset to not manage the state of the analytics cluster from Terraform, for example when it is " +
+		"managed by a `couchbase-capella_analytics_onoff_schedule`."
+	capellaschema.AddAttr(attrs, "state", analyticsClusterBuilder, state)
+
+	capellaschema.AddAttr(attrs, "current_state", analyticsClusterBuilder, stringAttribute([]string{computed}), "GetColumnarAnalyticsClusterResponse")
+	capellaschema.AddAttr(attrs, "if_match", analyticsClusterBuilder, stringAttribute([]string{optional}))
+	capellaschema.AddAttr(attrs, "etag", analyticsClusterBuilder, stringAttribute([]string{computed}))
+
+	return schema.Schema{
+		MarkdownDescription: "Manages a Capella Columnar analytics cluster.",
+		Attributes:          attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
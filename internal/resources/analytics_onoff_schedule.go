@@ -0,0 +1,368 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &AnalyticsOnOffSchedule{}
+	_ resource.ResourceWithConfigure      = &AnalyticsOnOffSchedule{}
+	_ resource.ResourceWithImportState    = &AnalyticsOnOffSchedule{}
+	_ resource.ResourceWithValidateConfig = &AnalyticsOnOffSchedule{}
+)
+
+const errorMessageAfterAnalyticsOnOffScheduleCreation = "Analytics On/Off Schedule creation is successful, but encountered an error while checking the current" +
+	" state of the analytics on/off schedule. Please run `terraform plan` after 1-2 minutes to know the" +
+	" current on/off schedule state. Additionally, run `terraform apply --refresh-only` to update" +
+	" the state from remote, unexpected error: "
+
+const errorMessageWhileAnalyticsOnOffScheduleCreation = "There is an error during analytics on/off schedule creation. Please check in Capella to see if any hanging resources" +
+	" have been created, unexpected error: "
+
+// AnalyticsOnOffSchedule is the analytics cluster on/off schedule resource implementation.
+type AnalyticsOnOffSchedule struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsOnOffSchedule is a helper function to simplify the provider implementation.
+func NewAnalyticsOnOffSchedule() resource.Resource {
+	return &AnalyticsOnOffSchedule{}
+}
+
+// Metadata returns the analytics on/off schedule resource type name.
+func (a *AnalyticsOnOffSchedule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_onoff_schedule"
+}
+
+// Schema defines the schema for the analytics on/off schedule resource.
+func (a *AnalyticsOnOffSchedule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AnalyticsOnOffScheduleSchema()
+}
+
+// ValidateConfig applies the same days list rules as the cluster on/off schedule.
+func (a *AnalyticsOnOffSchedule) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateOnOffScheduleDays(ctx, req, resp)
+}
+
+// Configure adds the provider configured client to the analytics on/off schedule resource.
+func (a *AnalyticsOnOffSchedule) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ImportState imports an already existing analytics on/off schedule that is not created by Terraform.
+// example: analytics_cluster_id=<analyticsClusterId>,project_id=<projId>,organization_id=<orgId>
+func (a *AnalyticsOnOffSchedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("analytics_cluster_id"), req, resp)
+}
+
+// Create creates the on/off schedule of the analytics cluster.
+func (a *AnalyticsOnOffSchedule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AnalyticsOnOffSchedule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId     = plan.OrganizationId.ValueString()
+		projectId          = plan.ProjectId.ValueString()
+		analyticsClusterId = plan.AnalyticsClusterId.ValueString()
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	createResp, err := a.ClientV2.PostAnalyticsOnOffScheduleWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, buildAnalyticsOnOffScheduleRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error executing request",
+			errorMessageWhileAnalyticsOnOffScheduleCreation+err.Error(),
+		)
+		return
+	}
+	if !isAnalyticsOnOffScheduleRequestAccepted(createResp.StatusCode()) {
+		resp.Diagnostics.AddError(
+			"Error executing request",
+			errorMessageWhileAnalyticsOnOffScheduleCreation+fmt.Sprintf("unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, initializeAnalyticsScheduleWithPlan(plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := a.retrieveAnalyticsOnOffSchedule(ctx, orgUUID, projUUID, analyticsClusterUUID, organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error Reading Capella Analytics On/Off Schedule",
+			"Could not read Capella analytics on/off schedule for the analytics cluster "+analyticsClusterId+". "+errorMessageAfterAnalyticsOnOffScheduleCreation+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the on/off schedule of the analytics cluster.
+func (a *AnalyticsOnOffSchedule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AnalyticsOnOffSchedule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading analytics on/off schedule",
+			"Could not read on/off schedule for analytics cluster with id "+state.AnalyticsClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	refreshedState, err := a.retrieveAnalyticsOnOffSchedule(ctx, orgUUID, projUUID, analyticsClusterUUID, organizationId, projectId, analyticsClusterId)
+	switch {
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error reading analytics on/off schedule",
+			"Could not read on/off schedule for analytics cluster with id "+analyticsClusterId+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update replaces the on/off schedule of the analytics cluster.
+func (a *AnalyticsOnOffSchedule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan providerschema.AnalyticsOnOffSchedule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := plan.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating analytics on/off schedule",
+			"Could not update on/off schedule for analytics cluster with id "+plan.AnalyticsClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	updateResp, err := a.ClientV2.PutAnalyticsOnOffScheduleWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, buildAnalyticsOnOffScheduleRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating analytics on/off schedule",
+			"Could not update on/off schedule for analytics cluster with id "+analyticsClusterId+": "+errors.ErrExecutingRequest.Error()+": "+err.Error(),
+		)
+		return
+	}
+	if !isAnalyticsOnOffScheduleRequestAccepted(updateResp.StatusCode()) {
+		resp.Diagnostics.AddError(
+			"Error updating analytics on/off schedule",
+			fmt.Sprintf("Could not update on/off schedule for analytics cluster with id %s, unexpected response status %d: %s",
+				analyticsClusterId, updateResp.StatusCode(), string(updateResp.Body)),
+		)
+		return
+	}
+
+	currentState, err := a.retrieveAnalyticsOnOffSchedule(ctx, orgUUID, projUUID, analyticsClusterUUID, organizationId, projectId, analyticsClusterId)
+	switch {
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error reading analytics on/off schedule",
+			"Could not read on/off schedule for analytics cluster with id "+analyticsClusterId+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, currentState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the on/off schedule of the analytics cluster.
+func (a *AnalyticsOnOffSchedule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AnalyticsOnOffSchedule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting analytics on/off schedule",
+			"Could not delete on/off schedule for analytics cluster with id "+state.AnalyticsClusterId.String()+" unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	analyticsClusterId := IDs[providerschema.AnalyticsClusterId]
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(IDs[providerschema.OrganizationId], IDs[providerschema.ProjectId], analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	deleteResp, err := a.ClientV2.DeleteAnalyticsOnOffScheduleWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting analytics on/off schedule",
+			"Could not delete on/off schedule for analytics cluster with id "+analyticsClusterId+": "+errors.ErrExecutingRequest.Error()+": "+err.Error(),
+		)
+		return
+	}
+
+	switch {
+	case deleteResp.StatusCode() == http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+	case !isAnalyticsOnOffScheduleRequestAccepted(deleteResp.StatusCode()):
+		resp.Diagnostics.AddError(
+			"Error deleting analytics on/off schedule",
+			fmt.Sprintf("Could not delete on/off schedule for analytics cluster with id %s, unexpected response status %d: %s",
+				analyticsClusterId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// retrieveAnalyticsOnOffSchedule retrieves the on/off schedule of the analytics cluster and converts it
+// to the Terraform state. errors.ErrNotFound is returned when no schedule exists.
+func (a *AnalyticsOnOffSchedule) retrieveAnalyticsOnOffSchedule(
+	ctx context.Context,
+	orgUUID, projUUID, analyticsClusterUUID uuid.UUID,
+	organizationId, projectId, analyticsClusterId string,
+) (*providerschema.AnalyticsOnOffSchedule, error) {
+	getResp, err := a.ClientV2.GetAnalyticsOnOffScheduleWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	return providerschema.NewAnalyticsOnOffSchedule(getResp.JSON200, organizationId, projectId, analyticsClusterId), nil
+}
+
+// buildAnalyticsOnOffScheduleRequest converts the plan to the on/off schedule payload.
+func buildAnalyticsOnOffScheduleRequest(plan providerschema.AnalyticsOnOffSchedule) apigen.ColumnarAnalyticsOnOffSchedule {
+	days := make([]apigen.Days, 0, len(plan.Days))
+	for _, d := range plan.Days {
+		days = append(days, apigen.Days{
+			Day:   apigen.DaysDay(d.Day.ValueString()),
+			State: apigen.DaysState(d.State.ValueString()),
+			From:  buildAnalyticsTimeBoundary(d.From),
+			To:    buildAnalyticsTimeBoundary(d.To),
+		})
+	}
+
+	return apigen.ColumnarAnalyticsOnOffSchedule{
+		Timezone: apigen.ColumnarAnalyticsOnOffScheduleTimezone(plan.Timezone.ValueString()),
+		Days:     days,
+	}
+}
+
+// buildAnalyticsTimeBoundary converts a time boundary of the plan to the API payload.
+func buildAnalyticsTimeBoundary(boundary *providerschema.OnTimeBoundary) *apigen.OnTimeBoundary {
+	if boundary == nil {
+		return nil
+	}
+
+	hour := int(boundary.Hour.ValueInt64())
+	minute := int(boundary.Minute.ValueInt64())
+	return &apigen.OnTimeBoundary{
+		Hour:   &hour,
+		Minute: &minute,
+	}
+}
+
+// initializeAnalyticsScheduleWithPlan initializes an instance of providerschema.AnalyticsOnOffSchedule
+// with the specified plan. It marks all computed fields as null.
+func initializeAnalyticsScheduleWithPlan(plan providerschema.AnalyticsOnOffSchedule) providerschema.AnalyticsOnOffSchedule {
+	schedule := initializeScheduleWithPlan(providerschema.ClusterOnOffSchedule{Days: plan.Days})
+	plan.Days = schedule.Days
+	return plan
+}
+
+// isAnalyticsOnOffScheduleRequestAccepted reports whether Capella accepted an on/off schedule request.
+func isAnalyticsOnOffScheduleRequestAccepted(statusCode int) bool {
+	switch statusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+		return true
+	default:
+		return false
+	}
+}
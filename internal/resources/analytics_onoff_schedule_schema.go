@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsOnOffScheduleBuilder = capellaschema.NewSchemaBuilder("analyticsOnOffSchedule", "ColumnarAnalyticsOnOffSchedule")
+
+func AnalyticsOnOffScheduleSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", analyticsOnOffScheduleBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", analyticsOnOffScheduleBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsOnOffScheduleBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "timezone", analyticsOnOffScheduleBuilder, stringAttribute([]string{required}))
+
+	fromAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(fromAttrs, "hour", analyticsOnOffScheduleBuilder, onOffScheduleHourAttribute(), "OnTimeBoundary")
+	capellaschema.AddAttr(fromAttrs, "minute", analyticsOnOffScheduleBuilder, onOffScheduleMinuteAttribute(), "OnTimeBoundary")
+
+	toAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(toAttrs, "hour", analyticsOnOffScheduleBuilder, onOffScheduleHourAttribute(), "OnTimeBoundary")
+	capellaschema.AddAttr(toAttrs, "minute", analyticsOnOffScheduleBuilder, onOffScheduleMinuteAttribute(), "OnTimeBoundary")
+
+	dayAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(dayAttrs, "state", analyticsOnOffScheduleBuilder, stringAttribute([]string{required}), "Days")
+	capellaschema.AddAttr(dayAttrs, "day", analyticsOnOffScheduleBuilder, stringAttribute([]string{required}), "Days")
+	capellaschema.AddAttr(dayAttrs, "from", analyticsOnOffScheduleBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: fromAttrs,
+	}, "Days")
+	capellaschema.AddAttr(dayAttrs, "to", analyticsOnOffScheduleBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: toAttrs,
+	}, "Days")
+
+	capellaschema.AddAttr(attrs, "days", analyticsOnOffScheduleBuilder, &schema.ListNestedAttribute{
+		Required: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: dayAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "This resource allows you to manage the On/Off schedule for a Capella Columnar analytics cluster.",
+		Attributes:          attrs,
+	}
+}
@@ -0,0 +1,368 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AnalyticsPrivateEndpointService{}
+	_ resource.ResourceWithConfigure   = &AnalyticsPrivateEndpointService{}
+	_ resource.ResourceWithImportState = &AnalyticsPrivateEndpointService{}
+)
+
+// AnalyticsPrivateEndpointService is the analytics cluster private endpoint service resource implementation.
+type AnalyticsPrivateEndpointService struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsPrivateEndpointService is a helper function to simplify the provider implementation.
+func NewAnalyticsPrivateEndpointService() resource.Resource {
+	return &AnalyticsPrivateEndpointService{}
+}
+
+// Metadata returns the analytics private endpoint service resource type name.
+func (a *AnalyticsPrivateEndpointService) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_private_endpoint_service"
+}
+
+// Schema defines the schema for the analytics private endpoint service resource.
+func (a *AnalyticsPrivateEndpointService) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AnalyticsPrivateEndpointServiceSchema()
+}
+
+// Create enables the private endpoint service on the analytics cluster.
+func (a *AnalyticsPrivateEndpointService) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AnalyticsPrivateEndpointService
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId     = plan.OrganizationId.ValueString()
+		projectId          = plan.ProjectId.ValueString()
+		analyticsClusterId = plan.AnalyticsClusterId.ValueString()
+	)
+
+	if err := a.setEnabled(ctx, true, organizationId, projectId, analyticsClusterId); err != nil {
+		resp.Diagnostics.AddError(
+			"Error enabling analytics private endpoint service",
+			errorMessageWhileEnablingPrivateEndpointService+err.Error(),
+		)
+		return
+	}
+
+	plan.Status = types.StringNull()
+	plan.ServiceName = types.StringNull()
+	plan.PrivateDns = types.StringNull()
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := a.waitUntilStatusChanges(ctx, true, organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error could not enable analytics private endpoint service",
+			"Error could not enable private endpoint service on analytics cluster "+analyticsClusterId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the private endpoint service status of the analytics cluster.
+func (a *AnalyticsPrivateEndpointService) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AnalyticsPrivateEndpointService
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Analytics Private Endpoint Service in Capella",
+			"Could not read Capella private endpoint service on analytics cluster "+state.AnalyticsClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+	)
+
+	refreshedState, err := a.getServiceState(ctx, organizationId, projectId, analyticsClusterId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error reading analytics private endpoint service status",
+			"Error reading analytics private endpoint service status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// A failed enablement cannot recover in place, so remove it from state to
+	// force a clean re-create on the next apply.
+	if refreshedState.Status.ValueString() == string(apigen.GetPrivateEndpointServiceResponseStatusEnableFailed) {
+		tflog.Info(ctx, "analytics private endpoint service enablement failed; removing from state to force re-create")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update enables or disables the private endpoint service on the analytics cluster.
+func (a *AnalyticsPrivateEndpointService) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan providerschema.AnalyticsPrivateEndpointService
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId     = plan.OrganizationId.ValueString()
+		projectId          = plan.ProjectId.ValueString()
+		analyticsClusterId = plan.AnalyticsClusterId.ValueString()
+		enabled            = plan.Enabled.ValueBool()
+	)
+
+	status := "enabling"
+	if !enabled {
+		status = "disabling"
+	}
+
+	if err := a.setEnabled(ctx, enabled, organizationId, projectId, analyticsClusterId); err != nil {
+		resp.Diagnostics.AddError(
+			"Error "+status+" analytics private endpoint service",
+			"Error "+status+" private endpoint service on analytics cluster "+analyticsClusterId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := a.waitUntilStatusChanges(ctx, enabled, organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error "+status+" analytics private endpoint service",
+			"Error "+status+" private endpoint service on analytics cluster "+analyticsClusterId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete disables the private endpoint service on the analytics cluster.
+func (a *AnalyticsPrivateEndpointService) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AnalyticsPrivateEndpointService
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error validating Analytics Private Endpoint Service in Capella",
+			"Could not validate Capella private endpoint service on analytics cluster "+state.AnalyticsClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+	)
+
+	// If private endpoint service is already disabled, just remove the resource from the state file.
+	if !state.Enabled.ValueBool() {
+		return
+	}
+
+	err = a.setEnabled(ctx, false, organizationId, projectId, analyticsClusterId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error disabling analytics private endpoint service",
+			"Could not disable private endpoint service for analytics cluster "+analyticsClusterId+" unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if _, err = a.waitUntilStatusChanges(ctx, false, organizationId, projectId, analyticsClusterId); err != nil && err != errors.ErrNotFound {
+		resp.Diagnostics.AddError(
+			"Error could not disable analytics private endpoint service",
+			"Error could not disable private endpoint service on analytics cluster "+analyticsClusterId+", unexpected error: "+err.Error(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the analytics private endpoint service resource.
+func (a *AnalyticsPrivateEndpointService) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
+
+// ImportState imports the private endpoint service status of an analytics cluster.
+func (a *AnalyticsPrivateEndpointService) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("analytics_cluster_id"), req, resp)
+}
+
+// setEnabled requests the private endpoint service to be enabled or disabled.
+// errors.ErrNotFound is returned when the analytics cluster does not exist.
+func (a *AnalyticsPrivateEndpointService) setEnabled(ctx context.Context, enabled bool, organizationId, projectId, analyticsClusterId string) error {
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		return err
+	}
+
+	var (
+		statusCode int
+		body       []byte
+	)
+	if enabled {
+		response, err := a.ClientV2.EnableColumnarPrivateEndpointServiceWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body = response.StatusCode(), response.Body
+	} else {
+		response, err := a.ClientV2.DisableColumnarPrivateEndpointServiceWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body = response.StatusCode(), response.Body
+	}
+
+	switch statusCode {
+	case http.StatusAccepted, http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return errors.ErrNotFound
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", statusCode, string(body))
+	}
+}
+
+// waitUntilStatusChanges waits until the private endpoint service of the analytics cluster is
+// enabled or disabled, and returns its refreshed state. It fails fast when Capella
+// reports that enabling or disabling the service failed.
+func (a *AnalyticsPrivateEndpointService) waitUntilStatusChanges(
+	ctx context.Context,
+	enabled bool,
+	organizationId, projectId, analyticsClusterId string,
+) (*providerschema.AnalyticsPrivateEndpointService, error) {
+	ctx, cancel := context.WithTimeout(ctx, statusChangeTimeout)
+	defer cancel()
+
+	targetStatus := string(apigen.GetPrivateEndpointServiceResponseStatusDisabled)
+	failedStatus := string(apigen.GetPrivateEndpointServiceResponseStatusDisableFailed)
+	if enabled {
+		targetStatus = string(apigen.GetPrivateEndpointServiceResponseStatusEnabled)
+		failedStatus = string(apigen.GetPrivateEndpointServiceResponseStatusEnableFailed)
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.ErrPrivateEndpointServiceTimeout
+
+		case <-timer.C:
+			state, err := a.getServiceState(ctx, organizationId, projectId, analyticsClusterId)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, errors.ErrPrivateEndpointServiceTimeout
+				}
+				return nil, err
+			}
+
+			tflog.Info(ctx, fmt.Sprintf("analytics private endpoint service status: %s, waiting for: %s", state.Status.ValueString(), targetStatus))
+
+			switch state.Status.ValueString() {
+			case targetStatus:
+				return state, nil
+			case failedStatus:
+				return nil, fmt.Errorf("private endpoint service reached status %s instead of %s", state.Status.ValueString(), targetStatus)
+			}
+
+			timer.Reset(pollInterval)
+		}
+	}
+}
+
+// getServiceState retrieves the private endpoint service status and converts it into Terraform state.
+// errors.ErrNotFound is returned when the analytics cluster does not exist.
+func (a *AnalyticsPrivateEndpointService) getServiceState(
+	ctx context.Context,
+	organizationId, projectId, analyticsClusterId string,
+) (*providerschema.AnalyticsPrivateEndpointService, error) {
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.ClientV2.GetColumnarPrivateEndpointServiceStatusWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case response.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case response.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", response.StatusCode(), string(response.Body))
+	}
+
+	return providerschema.NewAnalyticsPrivateEndpointService(*response.JSON200, organizationId, projectId, analyticsClusterId), nil
+}
@@ -0,0 +1,35 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	custommodifier "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/resources/custom_plan_modifiers"
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsPrivateEndpointServiceBuilder = capellaschema.NewSchemaBuilder("analyticsPrivateEndpointService", "GetPrivateEndpointServiceResponse")
+
+// AnalyticsPrivateEndpointServiceSchema returns the schema for the analytics_private_endpoint_service resource.
+func AnalyticsPrivateEndpointServiceSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", analyticsPrivateEndpointServiceBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", analyticsPrivateEndpointServiceBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsPrivateEndpointServiceBuilder, requiredUUIDStringAttribute())
+
+	capellaschema.AddAttr(attrs, "enabled", analyticsPrivateEndpointServiceBuilder, &schema.BoolAttribute{
+		Required:      true,
+		PlanModifiers: []planmodifier.Bool{custommodifier.BlockCreateWhenEnabledSetToFalse()},
+	})
+
+	capellaschema.AddAttr(attrs, "status", analyticsPrivateEndpointServiceBuilder, stringAttribute([]string{computed}))
+	capellaschema.AddAttr(attrs, "service_name", analyticsPrivateEndpointServiceBuilder, stringAttribute([]string{computed}))
+	capellaschema.AddAttr(attrs, "private_dns", analyticsPrivateEndpointServiceBuilder, stringAttribute([]string{computed}))
+
+	return schema.Schema{
+		MarkdownDescription: "This resource allows you to manage the private endpoint service for an analytics cluster. " +
+			"The private endpoint service must be enabled before you can accept private endpoints that connect your Cloud Service Provider's private network (VPC/VNET) to your analytics cluster.",
+		Attributes: attrs,
+	}
+}
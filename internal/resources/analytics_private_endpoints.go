@@ -0,0 +1,272 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AnalyticsPrivateEndpoint{}
+	_ resource.ResourceWithConfigure   = &AnalyticsPrivateEndpoint{}
+	_ resource.ResourceWithImportState = &AnalyticsPrivateEndpoint{}
+)
+
+// AnalyticsPrivateEndpoint is the analytics cluster private endpoint resource implementation.
+type AnalyticsPrivateEndpoint struct {
+	*providerschema.Data
+}
+
+// NewAnalyticsPrivateEndpoint is a helper function to simplify the provider implementation.
+func NewAnalyticsPrivateEndpoint() resource.Resource {
+	return &AnalyticsPrivateEndpoint{}
+}
+
+// Metadata returns the analytics private endpoint resource type name.
+func (a *AnalyticsPrivateEndpoint) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analytics_private_endpoints"
+}
+
+// Schema defines the schema for the analytics private endpoint resource.
+func (a *AnalyticsPrivateEndpoint) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AnalyticsPrivateEndpointsSchema()
+}
+
+// Create accepts a pending private endpoint connection to the analytics cluster.
+func (a *AnalyticsPrivateEndpoint) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AnalyticsPrivateEndpoint
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId     = plan.OrganizationId.ValueString()
+		projectId          = plan.ProjectId.ValueString()
+		analyticsClusterId = plan.AnalyticsClusterId.ValueString()
+		endpointId         = plan.EndpointId.ValueString()
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	acceptResp, err := a.ClientV2.AcceptColumnarPrivateEndpointServiceConnectionWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, endpointId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error accepting analytics private endpoint",
+			"Could not accept private endpoint "+endpointId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	switch acceptResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	default:
+		resp.Diagnostics.AddError(
+			"Error accepting analytics private endpoint",
+			fmt.Sprintf("Could not accept private endpoint %s, unexpected response status %d: %s", endpointId, acceptResp.StatusCode(), string(acceptResp.Body)),
+		)
+		return
+	}
+
+	plan.Status = types.StringNull()
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := a.getPrivateEndpointState(ctx, organizationId, projectId, analyticsClusterId, endpointId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading analytics private endpoint status",
+			"Error reading analytics private endpoint status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the status of the analytics private endpoint.
+func (a *AnalyticsPrivateEndpoint) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AnalyticsPrivateEndpoint
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Analytics Private Endpoint",
+			"Could not validate private endpoint "+state.EndpointId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+		endpointId         = IDs[providerschema.EndpointId]
+	)
+
+	refreshedState, err := a.getPrivateEndpointState(ctx, organizationId, projectId, analyticsClusterId, endpointId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error reading analytics private endpoint status",
+			"Error reading analytics private endpoint status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Rejected and failed connections are terminal and cannot recover in place,
+	// so remove them from state to force the connection to be accepted again on
+	// the next apply.
+	switch apigen.GetPrivateEndpointServiceConnectionResponseStatus(refreshedState.Status.ValueString()) {
+	case apigen.GetPrivateEndpointServiceConnectionResponseStatusRejected, apigen.GetPrivateEndpointServiceConnectionResponseStatusFailed:
+		tflog.Info(ctx, "analytics private endpoint connection is "+refreshedState.Status.ValueString()+"; removing from state to force acceptance")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is not supported as there is no update API.
+func (a *AnalyticsPrivateEndpoint) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so Update is never called.
+}
+
+// Delete rejects the private endpoint connection to the analytics cluster.
+func (a *AnalyticsPrivateEndpoint) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AnalyticsPrivateEndpoint
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rejecting analytics private endpoint",
+			"Could not reject endpoint due to validation error: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId     = IDs[providerschema.OrganizationId]
+		projectId          = IDs[providerschema.ProjectId]
+		analyticsClusterId = IDs[providerschema.AnalyticsClusterId]
+		endpointId         = IDs[providerschema.EndpointId]
+	)
+
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	rejectResp, err := a.ClientV2.RejectColumnarPrivateEndpointServiceConnectionWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID, endpointId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rejecting analytics private endpoint",
+			"Could not reject private endpoint "+endpointId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	switch rejectResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error rejecting analytics private endpoint",
+			fmt.Sprintf("Could not reject private endpoint %s, unexpected response status %d: %s", endpointId, rejectResp.StatusCode(), string(rejectResp.Body)),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the analytics private endpoint resource.
+func (a *AnalyticsPrivateEndpoint) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
+
+// ImportState imports an analytics private endpoint to be managed by terraform.
+func (a *AnalyticsPrivateEndpoint) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("endpoint_id"), req, resp)
+}
+
+// getPrivateEndpointState finds the private endpoint in the list of analytics cluster private endpoint
+// connections, as there is no endpoint to get a single one, and converts it into Terraform state.
+// errors.ErrNotFound is returned when the private endpoint does not exist.
+func (a *AnalyticsPrivateEndpoint) getPrivateEndpointState(
+	ctx context.Context,
+	organizationId, projectId, analyticsClusterId, endpointId string,
+) (*providerschema.AnalyticsPrivateEndpoint, error) {
+	orgUUID, projUUID, analyticsClusterUUID, err := parseAnalyticsClusterUUIDs(organizationId, projectId, analyticsClusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	listResp, err := a.ClientV2.ListColumnarPrivateEndpointServiceConnectionWithResponse(ctx, orgUUID, projUUID, analyticsClusterUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case listResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case listResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", listResp.StatusCode(), string(listResp.Body))
+	}
+
+	for _, endpoint := range listResp.JSON200.Endpoints {
+		if endpoint.EndpointId == endpointId {
+			return providerschema.NewAnalyticsPrivateEndpoint(endpoint, organizationId, projectId, analyticsClusterId), nil
+		}
+	}
+
+	return nil, errors.ErrNotFound
+}
@@ -0,0 +1,30 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var analyticsPrivateEndpointsBuilder = capellaschema.NewSchemaBuilder("analyticsPrivateEndpoints", "GetPrivateEndpointServiceConnectionResponse")
+
+// AnalyticsPrivateEndpointsSchema returns the schema for the analytics_private_endpoints resource.
+func AnalyticsPrivateEndpointsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", analyticsPrivateEndpointsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", analyticsPrivateEndpointsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "analytics_cluster_id", analyticsPrivateEndpointsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "endpoint_id", analyticsPrivateEndpointsBuilder, stringAttribute(
+		[]string{required, requiresReplace},
+		stringvalidator.LengthAtLeast(1),
+	))
+	capellaschema.AddAttr(attrs, "status", analyticsPrivateEndpointsBuilder, stringAttribute([]string{computed}))
+
+	return schema.Schema{
+		MarkdownDescription: "This resource accepts a pending private endpoint connection to an analytics cluster. " +
+			"Removing the resource, or the endpoint from the configuration, rejects the connection.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,338 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AppEndpointAuditLogSettings{}
+	_ resource.ResourceWithConfigure   = &AppEndpointAuditLogSettings{}
+	_ resource.ResourceWithImportState = &AppEndpointAuditLogSettings{}
+)
+
+// AppEndpointAuditLogSettings is the App Endpoint audit log settings resource implementation.
+type AppEndpointAuditLogSettings struct {
+	*providerschema.Data
+}
+
+// NewAppEndpointAuditLogSettings is a helper function to simplify the provider implementation.
+func NewAppEndpointAuditLogSettings() resource.Resource {
+	return &AppEndpointAuditLogSettings{}
+}
+
+// Metadata returns the App Endpoint audit log settings resource type name.
+func (a *AppEndpointAuditLogSettings) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_endpoint_audit_log_settings"
+}
+
+// Schema defines the schema for the App Endpoint audit log settings resource.
+func (a *AppEndpointAuditLogSettings) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AppEndpointAuditLogSettingsSchema()
+}
+
+// Configure adds the provider configured client to the App Endpoint audit log settings resource.
+func (a *AppEndpointAuditLogSettings) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
+
+// Create sets the audit log settings of the App Endpoint.
+// There is no create endpoint, so create is treated as an update.
+func (a *AppEndpointAuditLogSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AppEndpointAuditLogSettings
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, diags := a.upsertAuditLogSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the audit log settings of the App Endpoint.
+func (a *AppEndpointAuditLogSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AppEndpointAuditLogSettings
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Endpoint Audit Log Settings",
+			"Could not validate audit log settings of App Endpoint "+state.AppEndpointName.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId  = IDs[providerschema.OrganizationId]
+		projectId       = IDs[providerschema.ProjectId]
+		clusterId       = IDs[providerschema.ClusterId]
+		appServiceId    = IDs[providerschema.AppServiceId]
+		appEndpointName = IDs[providerschema.AppEndpointName]
+	)
+
+	settings, err := a.getAuditLogSettings(ctx, organizationId, projectId, clusterId, appServiceId, appEndpointName)
+	if err != nil {
+		if handled, forbiddenErr := handleAppEndpointForbidden(ctx, err, a.Data, resp, organizationId, projectId, clusterId, appServiceId, appEndpointName); handled {
+			return
+		} else if forbiddenErr != nil {
+			resp.Diagnostics.AddError("Error Reading App Endpoint Audit Log Settings", forbiddenErr.Error())
+			return
+		}
+		resourceNotFound, errString := api.CheckResourceNotFoundError(err)
+		if resourceNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading App Endpoint Audit Log Settings",
+			"Could not read audit log settings of App Endpoint "+appEndpointName+": "+errString,
+		)
+		return
+	}
+
+	refreshedState, diags := providerschema.NewAppEndpointAuditLogSettings(ctx, *settings, organizationId, projectId, clusterId, appServiceId, appEndpointName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the audit log settings of the App Endpoint.
+func (a *AppEndpointAuditLogSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan providerschema.AppEndpointAuditLogSettings
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, diags := a.upsertAuditLogSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete disables audit logging on the App Endpoint.
+func (a *AppEndpointAuditLogSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AppEndpointAuditLogSettings
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting App Endpoint audit log settings",
+			"Could not validate audit log settings of App Endpoint "+state.AppEndpointName.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	appEndpointName := IDs[providerschema.AppEndpointName]
+	auditEnabled := false
+
+	err = a.putAuditLogSettings(
+		ctx,
+		apigen.GetAppEndpointAuditLogResponse{AuditEnabled: &auditEnabled},
+		IDs[providerschema.OrganizationId],
+		IDs[providerschema.ProjectId],
+		IDs[providerschema.ClusterId],
+		IDs[providerschema.AppServiceId],
+		appEndpointName,
+	)
+	if err != nil {
+		resourceNotFound, errString := api.CheckResourceNotFoundError(err)
+		if resourceNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server")
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting App Endpoint audit log settings",
+			"Could not disable audit logging on App Endpoint "+appEndpointName+": "+errString,
+		)
+	}
+}
+
+// ImportState imports the audit log settings of an App Endpoint to be managed by terraform.
+func (a *AppEndpointAuditLogSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("app_endpoint_name"), req, resp)
+}
+
+// upsertAuditLogSettings sends the planned audit log settings to Capella and returns the refreshed state.
+func (a *AppEndpointAuditLogSettings) upsertAuditLogSettings(
+	ctx context.Context,
+	plan providerschema.AppEndpointAuditLogSettings,
+) (*providerschema.AppEndpointAuditLogSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var (
+		organizationId  = plan.OrganizationId.ValueString()
+		projectId       = plan.ProjectId.ValueString()
+		clusterId       = plan.ClusterId.ValueString()
+		appServiceId    = plan.AppServiceId.ValueString()
+		appEndpointName = plan.AppEndpointName.ValueString()
+	)
+
+	auditEnabled := plan.AuditEnabled.ValueBool()
+	settings := apigen.GetAppEndpointAuditLogResponse{AuditEnabled: &auditEnabled}
+
+	// Unknown values were not configured and have no prior state, so they are
+	// left out of the request and Capella keeps its defaults.
+	if !plan.EnabledEventIds.IsUnknown() {
+		var eventIds []int64
+		diags.Append(plan.EnabledEventIds.ElementsAs(ctx, &eventIds, false)...)
+
+		enabledEventIds := make([]struct {
+			Id *int `json:"id,omitempty"`
+		}, len(eventIds))
+		for i, eventId := range eventIds {
+			id := int(eventId)
+			enabledEventIds[i].Id = &id
+		}
+		settings.EnabledEventIds = &enabledEventIds
+	}
+	if !plan.DisabledUsers.IsUnknown() {
+		var disabledUsers []providerschema.AuditSettingsDisabledUser
+		diags.Append(plan.DisabledUsers.ElementsAs(ctx, &disabledUsers, false)...)
+		settings.DisabledUsers = toDisabledUserRoles(disabledUsers)
+	}
+	if !plan.DisabledRoles.IsUnknown() {
+		var disabledRoles []providerschema.AuditSettingsDisabledUser
+		diags.Append(plan.DisabledRoles.ElementsAs(ctx, &disabledRoles, false)...)
+		settings.DisabledRoles = toDisabledUserRoles(disabledRoles)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if err := a.putAuditLogSettings(ctx, settings, organizationId, projectId, clusterId, appServiceId, appEndpointName); err != nil {
+		diags.AddError(
+			"Error setting App Endpoint audit log settings",
+			"Could not set audit log settings of App Endpoint "+appEndpointName+": "+api.ParseError(err),
+		)
+		return nil, diags
+	}
+
+	refreshedSettings, err := a.getAuditLogSettings(ctx, organizationId, projectId, clusterId, appServiceId, appEndpointName)
+	if err != nil {
+		diags.AddError(
+			"Error reading App Endpoint audit log settings",
+			"Could not read audit log settings of App Endpoint "+appEndpointName+": "+api.ParseError(err),
+		)
+		return nil, diags
+	}
+
+	return providerschema.NewAppEndpointAuditLogSettings(ctx, *refreshedSettings, organizationId, projectId, clusterId, appServiceId, appEndpointName)
+}
+
+// putAuditLogSettings replaces the audit log settings of the App Endpoint.
+func (a *AppEndpointAuditLogSettings) putAuditLogSettings(
+	ctx context.Context,
+	settings apigen.GetAppEndpointAuditLogResponse,
+	organizationId, projectId, clusterId, appServiceId, appEndpointName string,
+) error {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		return err
+	}
+
+	putResp, err := a.ClientV2.PutAppEndpointAuditLogConfigWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID, appEndpointName, settings)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch putResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+		return nil
+	default:
+		return &api.Error{
+			HttpStatusCode: putResp.StatusCode(),
+			Message:        "Unexpected status while setting App Endpoint audit log settings: " + string(putResp.Body),
+		}
+	}
+}
+
+// getAuditLogSettings retrieves the audit log settings of the App Endpoint.
+func (a *AppEndpointAuditLogSettings) getAuditLogSettings(
+	ctx context.Context,
+	organizationId, projectId, clusterId, appServiceId, appEndpointName string,
+) (*apigen.GetAppEndpointAuditLogResponse, error) {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetAppEndpointAuditLogConfigWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID, appEndpointName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	if getResp.JSON200 == nil {
+		return nil, &api.Error{
+			HttpStatusCode: getResp.StatusCode(),
+			Message:        "Unexpected status while getting App Endpoint audit log settings: " + string(getResp.Body),
+		}
+	}
+
+	return getResp.JSON200, nil
+}
+
+// toDisabledUserRoles converts the disabled users or roles in the plan into the request model.
+func toDisabledUserRoles(userRoles []providerschema.AuditSettingsDisabledUser) *apigen.DisabledUserRoles {
+	disabled := make(apigen.DisabledUserRoles, len(userRoles))
+	for i, userRole := range userRoles {
+		disabled[i] = apigen.DisabledUserRole{
+			Domain: userRole.Domain.ValueString(),
+			Name:   userRole.Name.ValueString(),
+		}
+	}
+	return &disabled
+}
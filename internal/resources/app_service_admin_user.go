@@ -0,0 +1,429 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &AppServiceAdminUser{}
+	_ resource.ResourceWithConfigure      = &AppServiceAdminUser{}
+	_ resource.ResourceWithImportState    = &AppServiceAdminUser{}
+	_ resource.ResourceWithValidateConfig = &AppServiceAdminUser{}
+)
+
+// AppServiceAdminUser is the App Service admin user resource implementation.
+type AppServiceAdminUser struct {
+	*providerschema.Data
+}
+
+// NewAppServiceAdminUser is a helper function to simplify the provider implementation.
+func NewAppServiceAdminUser() resource.Resource {
+	return &AppServiceAdminUser{}
+}
+
+// Metadata returns the App Service admin user resource type name.
+func (a *AppServiceAdminUser) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_admin_user"
+}
+
+// Schema defines the schema for the App Service admin user resource.
+func (a *AppServiceAdminUser) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AppServiceAdminUserSchema()
+}
+
+// Configure adds the provider configured client to the App Service admin user resource.
+func (a *AppServiceAdminUser) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.Data = data
+}
+
+// ValidateConfig checks that the user is given access to either every App Endpoint or a list of them.
+func (a *AppServiceAdminUser) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config providerschema.AppServiceAdminUser
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Defer validation until Terraform resolves expressions that determine these attribute values.
+	if config.AccessAllEndpoints.IsUnknown() || config.Endpoints.IsUnknown() {
+		return
+	}
+
+	accessAll := config.AccessAllEndpoints.ValueBool()
+	switch {
+	case accessAll && !config.Endpoints.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoints"),
+			"Invalid App Service Admin User Configuration",
+			"endpoints cannot be configured when access_all_endpoints is true.",
+		)
+	case !accessAll && config.Endpoints.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoints"),
+			"Invalid App Service Admin User Configuration",
+			"endpoints must be configured unless access_all_endpoints is true.",
+		)
+	}
+}
+
+// ImportState imports a remote App Service admin user that is not created by Terraform.
+// The password is not returned by Capella, so it is taken from the configuration after import.
+func (a *AppServiceAdminUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create creates the admin user.
+func (a *AppServiceAdminUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AppServiceAdminUser
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+		appServiceId   = plan.AppServiceId.ValueString()
+	)
+
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+		utils.IDField{Name: "app_service_id", Value: appServiceId},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	createReq := apigen.CreateAppServiceAdminUserRequest{
+		Name:     plan.Name.ValueString(),
+		Password: plan.Password.ValueString(),
+	}
+	access, diags := newAppServiceAdminUserAccess(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	switch access := access.(type) {
+	case apigen.UpdateAppServiceAdminUserAllEndpointsRequest:
+		err = createReq.Access.FromUpdateAppServiceAdminUserAllEndpointsRequest(access)
+	case apigen.UpdateAppServiceAdminUserEndpointList:
+		err = createReq.Access.FromUpdateAppServiceAdminUserEndpointList(access)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error building App Service admin user request", err.Error())
+		return
+	}
+
+	createResp, err := a.ClientV2.AddAppServiceAdminUserWithResponse(ctx, uuids[0], uuids[1], uuids[2], uuids[3], createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating App Service admin user",
+			"Could not create App Service admin user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON201 == nil || createResp.JSON201.Id == nil {
+		resp.Diagnostics.AddError(
+			"Error creating App Service admin user",
+			fmt.Sprintf("Could not create App Service admin user, unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	userId := *createResp.JSON201.Id
+
+	refreshedState, err := a.retrieveAppServiceAdminUser(ctx, organizationId, projectId, clusterId, appServiceId, userId, &plan)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error reading App Service admin user",
+			"Could not read App Service admin user with ID "+userId+": "+api.ParseError(err),
+		)
+
+		plan.Id = types.StringValue(userId)
+		plan.Audit = types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the admin user. The password is carried over from state.
+func (a *AppServiceAdminUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AppServiceAdminUser
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Admin User in Capella",
+			"Could not read Capella App Service admin user with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		appServiceId   = IDs[providerschema.AppServiceId]
+		userId         = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := a.retrieveAppServiceAdminUser(ctx, organizationId, projectId, clusterId, appServiceId, userId, &state)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Admin User in Capella",
+			"Could not read Capella App Service admin user with ID "+userId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update changes which App Endpoints the admin user can administer.
+func (a *AppServiceAdminUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.AppServiceAdminUser
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating App Service Admin User in Capella",
+			"Could not update Capella App Service admin user with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		appServiceId   = IDs[providerschema.AppServiceId]
+		userId         = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, clusterUUID, appServiceUUID, userUUID, err := parseAppServiceAdminUserUUIDs(organizationId, projectId, clusterId, appServiceId, userId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	access, diags := newAppServiceAdminUserAccess(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The update body is a oneOf of the two access requests, which the generated client cannot build, so it is sent as raw JSON.
+	body, err := json.Marshal(access)
+	if err != nil {
+		resp.Diagnostics.AddError("Error building App Service admin user request", err.Error())
+		return
+	}
+
+	updateResp, err := a.ClientV2.UpdateAppServiceAdminUserWithBodyWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID, userUUID, "application/json", bytes.NewReader(body))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating App Service Admin User in Capella",
+			"Could not update Capella App Service admin user with ID "+userId+": "+err.Error(),
+		)
+		return
+	}
+
+	switch updateResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	default:
+		resp.Diagnostics.AddError(
+			"Error Updating App Service Admin User in Capella",
+			fmt.Sprintf("Could not update Capella App Service admin user with ID %s, unexpected response status %d: %s", userId, updateResp.StatusCode(), string(updateResp.Body)),
+		)
+		return
+	}
+
+	refreshedState, err := a.retrieveAppServiceAdminUser(ctx, organizationId, projectId, clusterId, appServiceId, userId, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Admin User in Capella",
+			"Could not read Capella App Service admin user with ID "+userId+" after update: "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the admin user.
+func (a *AppServiceAdminUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AppServiceAdminUser
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting App Service Admin User in Capella",
+			"Could not delete Capella App Service admin user with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	userId := IDs[providerschema.Id]
+
+	orgUUID, projUUID, clusterUUID, appServiceUUID, userUUID, err := parseAppServiceAdminUserUUIDs(
+		IDs[providerschema.OrganizationId],
+		IDs[providerschema.ProjectId],
+		IDs[providerschema.ClusterId],
+		IDs[providerschema.AppServiceId],
+		userId,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	deleteResp, err := a.ClientV2.DeleteAppServiceAdminUserWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID, userUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting App Service Admin User in Capella",
+			"Could not delete Capella App Service admin user with ID "+userId+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting App Service Admin User in Capella",
+			fmt.Sprintf("Could not delete Capella App Service admin user with ID %s, unexpected response status %d: %s", userId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// retrieveAppServiceAdminUser retrieves the admin user and converts it into Terraform state.
+// errors.ErrNotFound is returned when the admin user does not exist.
+func (a *AppServiceAdminUser) retrieveAppServiceAdminUser(
+	ctx context.Context,
+	organizationId, projectId, clusterId, appServiceId, userId string,
+	prior *providerschema.AppServiceAdminUser,
+) (*providerschema.AppServiceAdminUser, error) {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, userUUID, err := parseAppServiceAdminUserUUIDs(organizationId, projectId, clusterId, appServiceId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetAppServiceAdminUserWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	user := getResp.JSON200
+
+	auditObj := types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	if user.Audit != nil {
+		audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(*user.Audit))
+		var diags diag.Diagnostics
+		auditObj, diags = types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+		if diags.HasError() {
+			return nil, errors.ErrUnableToConvertAuditData
+		}
+	}
+
+	return providerschema.NewAppServiceAdminUser(*user, organizationId, projectId, clusterId, appServiceId, userId, prior, auditObj), nil
+}
+
+// parseAppServiceAdminUserUUIDs parses the IDs of an admin user into UUIDs for the generated API client.
+func parseAppServiceAdminUserUUIDs(organizationId, projectId, clusterId, appServiceId, userId string) (uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+		utils.IDField{Name: "app_service_id", Value: appServiceId},
+		utils.IDField{Name: "id", Value: userId},
+	)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids[0], uuids[1], uuids[2], uuids[3], uuids[4], nil
+}
+
+// newAppServiceAdminUserAccess builds the access request from the plan, which is either
+// access to every App Endpoint or to a list of App Endpoints.
+func newAppServiceAdminUserAccess(ctx context.Context, plan providerschema.AppServiceAdminUser) (any, diag.Diagnostics) {
+	if plan.AccessAllEndpoints.ValueBool() {
+		return apigen.UpdateAppServiceAdminUserAllEndpointsRequest{AccessAllEndpoints: true}, nil
+	}
+
+	var endpoints apigen.UpdateAppServiceAdminUserEndpointList
+	diags := plan.Endpoints.ElementsAs(ctx, &endpoints.Endpoints, false)
+	return endpoints, diags
+}
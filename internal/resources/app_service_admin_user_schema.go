@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServiceAdminUserBuilder = capellaschema.NewSchemaBuilder("appServiceAdminUser", "CreateAppServiceAdminUserRequest")
+
+// AppServiceAdminUserSchema returns the schema for the app_service_admin_user resource.
+func AppServiceAdminUserSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", appServiceAdminUserBuilder, stringAttribute([]string{computed, useStateForUnknown}), "AppServiceAdminUser")
+	capellaschema.AddAttr(attrs, "organization_id", appServiceAdminUserBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", appServiceAdminUserBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", appServiceAdminUserBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "app_service_id", appServiceAdminUserBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "name", appServiceAdminUserBuilder, requiredNonEmptyStringAttribute())
+
+	// The password cannot be changed, so changing it recreates the user. It is not returned by Capella,
+	// so an imported user takes the configured password without being recreated.
+	passwordAttr := stringAttribute([]string{required, sensitive}, stringvalidator.LengthAtLeast(1))
+	passwordAttr.PlanModifiers = []planmodifier.String{
+		stringplanmodifier.RequiresReplaceIf(
+			func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = !req.StateValue.IsNull()
+			},
+			"Changing the password recreates the admin user.",
+			"Changing the password recreates the admin user.",
+		),
+	}
+	capellaschema.AddAttr(attrs, "password", appServiceAdminUserBuilder, passwordAttr)
+
+	capellaschema.AddAttr(attrs, "access_all_endpoints", appServiceAdminUserBuilder, boolDefaultAttribute(false, optional, computed), "UpdateAppServiceAdminUserAllEndpointsRequest")
+	endpointsAttr := stringSetAttribute(optional)
+	endpointsAttr.Validators = []validator.Set{setvalidator.SizeAtLeast(1)}
+	capellaschema.AddAttr(attrs, "endpoints", appServiceAdminUserBuilder, endpointsAttr, "UpdateAppServiceAdminUserEndpointList")
+
+	capellaschema.AddAttr(attrs, "audit", appServiceAdminUserBuilder, computedAuditAttribute())
+
+	return schema.Schema{
+		MarkdownDescription: "Manages an admin user of the App Endpoints of an App Service. " +
+			"The user either administers every App Endpoint, with `access_all_endpoints`, or the App Endpoints listed in `endpoints`.",
+		Attributes: attrs,
+	}
+}
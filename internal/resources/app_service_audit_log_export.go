@@ -0,0 +1,292 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &AppServiceAuditLogExport{}
+	_ resource.ResourceWithConfigure      = &AppServiceAuditLogExport{}
+	_ resource.ResourceWithImportState    = &AppServiceAuditLogExport{}
+	_ resource.ResourceWithValidateConfig = &AppServiceAuditLogExport{}
+)
+
+// AppServiceAuditLogExport is the App Service audit log export resource implementation.
+type AppServiceAuditLogExport struct {
+	*providerschema.Data
+}
+
+// NewAppServiceAuditLogExport is a helper function to simplify the provider implementation.
+func NewAppServiceAuditLogExport() resource.Resource {
+	return &AppServiceAuditLogExport{}
+}
+
+// Metadata returns the App Service audit log export resource type name.
+func (a *AppServiceAuditLogExport) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_audit_log_export"
+}
+
+// Schema defines the schema for the App Service audit log export resource.
+func (a *AppServiceAuditLogExport) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AppServiceAuditLogExportSchema()
+}
+
+// ValidateConfig checks the end of the export window is not before its start.
+func (a *AppServiceAuditLogExport) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config providerschema.AppServiceAuditLogExport
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Start.IsNull() || config.Start.IsUnknown() || config.End.IsNull() || config.End.IsUnknown() {
+		return
+	}
+
+	start, err := time.Parse(time.RFC3339, config.Start.ValueString())
+	if err != nil {
+		return
+	}
+
+	end, err := time.Parse(time.RFC3339, config.End.ValueString())
+	if err != nil {
+		return
+	}
+
+	if end.Before(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end"),
+			"Invalid Audit Log Export Window",
+			"end must not be earlier than start",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the App Service audit log export resource.
+func (a *AppServiceAuditLogExport) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
+
+// Create creates a new audit log export job for the App Service.
+func (a *AppServiceAuditLogExport) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AppServiceAuditLogExport
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+		appServiceId   = plan.AppServiceId.ValueString()
+	)
+
+	start, err := time.Parse(time.RFC3339, plan.Start.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating App Service audit log export job",
+			"Could not parse start time, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	end, err := time.Parse(time.RFC3339, plan.End.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating App Service audit log export job",
+			"Could not parse end time, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	postResp, err := a.ClientV2.PostAppServiceAuditLogExportWithResponse(
+		ctx,
+		orgUUID,
+		projUUID,
+		clusterUUID,
+		appServiceUUID,
+		apigen.CreateClusterAuditLogExportRequest{Start: start, End: end},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating App Service audit log export job",
+			errorMessageWhileAuditLogExportCreation+err.Error(),
+		)
+		return
+	}
+
+	if postResp.JSON202 == nil {
+		resp.Diagnostics.AddError(
+			"Error creating App Service audit log export job",
+			errorMessageWhileAuditLogExportCreation+fmt.Sprintf("unexpected response status %d: %s", postResp.StatusCode(), string(postResp.Body)),
+		)
+		return
+	}
+
+	exportId := postResp.JSON202.ExportId
+
+	plan.Id = types.StringValue(exportId)
+	plan.Status = types.StringNull()
+	plan.DownloadId = types.StringNull()
+	plan.DownloadExpires = types.StringNull()
+	plan.Audit = types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := a.getAuditLogExport(ctx, organizationId, projectId, clusterId, appServiceId, exportId, plan.Start, plan.End)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error reading App Service audit log export",
+			errorMessageAfterAuditLogExportCreation+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the audit log export job of the App Service.
+func (a *AppServiceAuditLogExport) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AppServiceAuditLogExport
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Audit Log Export",
+			"Could not validate audit log export "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	exportId := IDs[providerschema.Id]
+
+	refreshedState, err := a.getAuditLogExport(
+		ctx,
+		IDs[providerschema.OrganizationId],
+		IDs[providerschema.ProjectId],
+		IDs[providerschema.ClusterId],
+		IDs[providerschema.AppServiceId],
+		exportId,
+		state.Start,
+		state.End,
+	)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Audit Log Export",
+			"Could not read audit log export "+exportId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is not supported as there is no update API.
+func (a *AppServiceAuditLogExport) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"App Service Audit Log Export does not support update",
+		"App Service Audit Log Export does not support update",
+	)
+}
+
+// Delete is a noop as there is no API to delete an audit log export job.
+func (a *AppServiceAuditLogExport) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The framework will automatically remove the resource from the state file. See:
+	// https://developer.hashicorp.com/terraform/plugin/framework/resources/delete#recommendations
+}
+
+// ImportState imports an App Service audit log export job to be managed by terraform.
+func (a *AppServiceAuditLogExport) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getAuditLogExport retrieves the audit log export job and converts it into Terraform state.
+// errors.ErrNotFound is returned when the export job does not exist.
+func (a *AppServiceAuditLogExport) getAuditLogExport(
+	ctx context.Context,
+	organizationId, projectId, clusterId, appServiceId, exportId string,
+	start, end types.String,
+) (*providerschema.AppServiceAuditLogExport, error) {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetAppServiceAuditLogExportByIdWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID, exportId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	export := getResp.JSON200
+	auditObj := types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	if export.Audit != nil {
+		audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(*export.Audit))
+		var diags diag.Diagnostics
+		auditObj, diags = types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+		if diags.HasError() {
+			return nil, errors.ErrUnableToConvertAuditData
+		}
+	}
+
+	return providerschema.NewAppServiceAuditLogExport(*export, organizationId, projectId, clusterId, appServiceId, exportId, start, end, auditObj), nil
+}
@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServiceAuditLogExportBuilder = capellaschema.NewSchemaBuilder("appServiceAuditLogExport", "CreateClusterAuditLogExportRequest")
+
+// AppServiceAuditLogExportSchema returns the schema for the app_service_audit_log_export resource.
+func AppServiceAuditLogExportSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", appServiceAuditLogExportBuilder, stringAttribute([]string{computed, useStateForUnknown}), "GetAuditExportDocResponse")
+	capellaschema.AddAttr(attrs, "organization_id", appServiceAuditLogExportBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", appServiceAuditLogExportBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", appServiceAuditLogExportBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "app_service_id", appServiceAuditLogExportBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "start", appServiceAuditLogExportBuilder, stringAttribute([]string{required, requiresReplace}, rfc3339TimestampValidator{attributeName: "start"}))
+	capellaschema.AddAttr(attrs, "end", appServiceAuditLogExportBuilder, stringAttribute([]string{required, requiresReplace}, rfc3339TimestampValidator{attributeName: "end"}))
+	capellaschema.AddAttr(attrs, "status", appServiceAuditLogExportBuilder, stringAttribute([]string{computed}), "GetAuditExportDocResponse")
+	capellaschema.AddAttr(attrs, "download_id", appServiceAuditLogExportBuilder, stringAttribute([]string{computed}), "GetAuditExportDocResponse")
+	capellaschema.AddAttr(attrs, "download_expires", appServiceAuditLogExportBuilder, stringAttribute([]string{computed}), "GetAuditExportDocResponse")
+	capellaschema.AddAttr(attrs, "audit", appServiceAuditLogExportBuilder, computedAuditAttribute())
+
+	return schema.Schema{
+		MarkdownDescription: "This resource allows you to manage audit log exports for an App Service. " +
+			"This allows you to export the audit logs of the App Service for a specific time period and download them for analysis. " +
+			"Capella does not return the time period of an export, so `start` and `end` are not set when an export is imported.",
+		Attributes: attrs,
+	}
+}
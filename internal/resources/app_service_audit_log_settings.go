@@ -0,0 +1,274 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AppServiceAuditLogSettings{}
+	_ resource.ResourceWithConfigure   = &AppServiceAuditLogSettings{}
+	_ resource.ResourceWithImportState = &AppServiceAuditLogSettings{}
+)
+
+// AppServiceAuditLogSettings is the App Service audit log settings resource implementation.
+type AppServiceAuditLogSettings struct {
+	*providerschema.Data
+}
+
+// NewAppServiceAuditLogSettings is a helper function to simplify the provider implementation.
+func NewAppServiceAuditLogSettings() resource.Resource {
+	return &AppServiceAuditLogSettings{}
+}
+
+// Metadata returns the App Service audit log settings resource type name.
+func (a *AppServiceAuditLogSettings) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_audit_log_settings"
+}
+
+// Schema defines the schema for the App Service audit log settings resource.
+func (a *AppServiceAuditLogSettings) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AppServiceAuditLogSettingsSchema()
+}
+
+// Configure adds the provider configured client to the App Service audit log settings resource.
+func (a *AppServiceAuditLogSettings) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
+
+// Create sets the audit log settings of the App Service.
+// There is no create endpoint, so create is treated as an update.
+func (a *AppServiceAuditLogSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AppServiceAuditLogSettings
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+		appServiceId   = plan.AppServiceId.ValueString()
+	)
+
+	if err := a.putAuditLogState(ctx, plan.AuditEnabled.ValueBool(), organizationId, projectId, clusterId, appServiceId); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating App Service audit log settings",
+			"Could not set audit log settings of App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := a.getAuditLogSettings(ctx, organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading App Service audit log settings",
+			"Could not read audit log settings of App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the audit log settings of the App Service.
+func (a *AppServiceAuditLogSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AppServiceAuditLogSettings
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Audit Log Settings",
+			"Could not validate audit log settings of App Service "+state.AppServiceId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		appServiceId   = IDs[providerschema.AppServiceId]
+	)
+
+	refreshedState, err := a.getAuditLogSettings(ctx, organizationId, projectId, clusterId, appServiceId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Audit Log Settings",
+			"Could not read audit log settings of App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the audit log settings of the App Service.
+func (a *AppServiceAuditLogSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan providerschema.AppServiceAuditLogSettings
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+		appServiceId   = plan.AppServiceId.ValueString()
+	)
+
+	if err := a.putAuditLogState(ctx, plan.AuditEnabled.ValueBool(), organizationId, projectId, clusterId, appServiceId); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating App Service audit log settings",
+			"Could not update audit log settings of App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := a.getAuditLogSettings(ctx, organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading App Service audit log settings",
+			"Could not read audit log settings of App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete disables audit logging on the App Service.
+func (a *AppServiceAuditLogSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AppServiceAuditLogSettings
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting App Service audit log settings",
+			"Could not validate audit log settings of App Service "+state.AppServiceId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	appServiceId := IDs[providerschema.AppServiceId]
+
+	err = a.putAuditLogState(ctx, false, IDs[providerschema.OrganizationId], IDs[providerschema.ProjectId], IDs[providerschema.ClusterId], appServiceId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error deleting App Service audit log settings",
+			"Could not disable audit logging on App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+	}
+}
+
+// ImportState imports the audit log settings of an App Service to be managed by terraform.
+func (a *AppServiceAuditLogSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("app_service_id"), req, resp)
+}
+
+// putAuditLogState enables or disables audit logging on the App Service.
+// errors.ErrNotFound is returned when the App Service does not exist.
+func (a *AppServiceAuditLogSettings) putAuditLogState(ctx context.Context, enabled bool, organizationId, projectId, clusterId, appServiceId string) error {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		return err
+	}
+
+	putResp, err := a.ClientV2.PutAppServiceAuditLogStateWithResponse(
+		ctx,
+		orgUUID,
+		projUUID,
+		clusterUUID,
+		appServiceUUID,
+		apigen.CreateAppServiceAuditLogRequest{AuditEnabled: enabled},
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch putResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+		return nil
+	case http.StatusNotFound:
+		return errors.ErrNotFound
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", putResp.StatusCode(), string(putResp.Body))
+	}
+}
+
+// getAuditLogSettings retrieves the audit log settings of the App Service.
+// errors.ErrNotFound is returned when the App Service does not exist.
+func (a *AppServiceAuditLogSettings) getAuditLogSettings(
+	ctx context.Context,
+	organizationId, projectId, clusterId, appServiceId string,
+) (*providerschema.AppServiceAuditLogSettings, error) {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetAppServiceAuditLogStateWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	return providerschema.NewAppServiceAuditLogSettings(*getResp.JSON200, organizationId, projectId, clusterId, appServiceId), nil
+}
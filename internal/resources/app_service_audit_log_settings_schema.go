@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServiceAuditLogSettingsBuilder = capellaschema.NewSchemaBuilder("appServiceAuditLogSettings", "CreateAppServiceAuditLogRequest")
+
+var appEndpointAuditLogSettingsBuilder = capellaschema.NewSchemaBuilder("appEndpointAuditLogSettings", "GetAppEndpointAuditLogResponse")
+
+// AppServiceAuditLogSettingsSchema returns the schema for the app_service_audit_log_settings resource.
+func AppServiceAuditLogSettingsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appServiceAuditLogSettingsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", appServiceAuditLogSettingsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", appServiceAuditLogSettingsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "app_service_id", appServiceAuditLogSettingsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "audit_enabled", appServiceAuditLogSettingsBuilder, boolAttribute(required))
+
+	return schema.Schema{
+		MarkdownDescription: "This resource allows you to manage the audit log settings of an App Service. " +
+			"Audit logging must be enabled on the App Service before it can be configured for its App Endpoints. " +
+			"Destroying this resource disables audit logging on the App Service.",
+		Attributes: attrs,
+	}
+}
+
+// AppEndpointAuditLogSettingsSchema returns the schema for the app_endpoint_audit_log_settings resource.
+func AppEndpointAuditLogSettingsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appEndpointAuditLogSettingsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", appEndpointAuditLogSettingsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", appEndpointAuditLogSettingsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "app_service_id", appEndpointAuditLogSettingsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "app_endpoint_name", appEndpointAuditLogSettingsBuilder, stringAttribute([]string{required, requiresReplace}, stringvalidator.LengthAtLeast(1)))
+	capellaschema.AddAttr(attrs, "audit_enabled", appEndpointAuditLogSettingsBuilder, boolAttribute(required))
+
+	// useStateForUnknown: the whole configuration is sent on every update, so an unconfigured
+	// value keeps what is in Capella rather than being reset.
+	capellaschema.AddAttr(attrs, "enabled_event_ids", appEndpointAuditLogSettingsBuilder, &schema.SetAttribute{
+		Computed:    true,
+		Optional:    true,
+		ElementType: types.Int64Type,
+		Validators: []validator.Set{
+			setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+		},
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	})
+
+	capellaschema.AddAttr(attrs, "disabled_users", appEndpointAuditLogSettingsBuilder, disabledUserRoleSetAttribute(appEndpointAuditLogSettingsBuilder))
+	capellaschema.AddAttr(attrs, "disabled_roles", appEndpointAuditLogSettingsBuilder, disabledUserRoleSetAttribute(appEndpointAuditLogSettingsBuilder))
+
+	return schema.Schema{
+		MarkdownDescription: "This resource allows you to manage the audit log settings of an App Endpoint. " +
+			"These settings control which audit events are logged and which users and roles are excluded from audit logging. " +
+			"Audit logging must be enabled on the App Service first. Destroying this resource disables audit logging on the App Endpoint.",
+		Attributes: attrs,
+	}
+}
+
+// disabledUserRoleSetAttribute returns a set of users or roles, identified by domain and name,
+// which are excluded from audit logging.
+func disabledUserRoleSetAttribute(builder *capellaschema.SchemaBuilder) *schema.SetNestedAttribute {
+	userRoleAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(userRoleAttrs, "domain", builder, stringAttribute([]string{required}, stringvalidator.LengthAtLeast(1)), "DisabledUserRole")
+	capellaschema.AddAttr(userRoleAttrs, "name", builder, stringAttribute([]string{required}, stringvalidator.LengthAtLeast(1)), "DisabledUserRole")
+
+	// useStateForUnknown: same as enabled_event_ids.
+	return &schema.SetNestedAttribute{
+		Computed: true,
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: userRoleAttrs,
+		},
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
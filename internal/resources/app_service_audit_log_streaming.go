@@ -0,0 +1,401 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &AppServiceAuditLogStreaming{}
+	_ resource.ResourceWithConfigure      = &AppServiceAuditLogStreaming{}
+	_ resource.ResourceWithImportState    = &AppServiceAuditLogStreaming{}
+	_ resource.ResourceWithValidateConfig = &AppServiceAuditLogStreaming{}
+)
+
+// auditLogStreamingEnabledPath is the path patched to pause or resume audit log streaming.
+const auditLogStreamingEnabledPath = "/streamingEnabled"
+
+// AppServiceAuditLogStreaming is the App Service audit log streaming resource implementation.
+type AppServiceAuditLogStreaming struct {
+	*providerschema.Data
+}
+
+// NewAppServiceAuditLogStreaming is a helper function to simplify the provider implementation.
+func NewAppServiceAuditLogStreaming() resource.Resource {
+	return &AppServiceAuditLogStreaming{}
+}
+
+// Metadata returns the App Service audit log streaming resource type name.
+func (a *AppServiceAuditLogStreaming) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_audit_log_streaming"
+}
+
+// Schema defines the schema for the App Service audit log streaming resource.
+func (a *AppServiceAuditLogStreaming) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AppServiceAuditLogStreamingSchema()
+}
+
+// Configure adds the provider configured client to the App Service audit log streaming resource.
+func (a *AppServiceAuditLogStreaming) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
+
+// ValidateConfig checks the output type matches the configured credentials.
+func (a *AppServiceAuditLogStreaming) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config providerschema.AppServiceAuditLogStreaming
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.OutputType.IsUnknown() {
+		return
+	}
+
+	creds, diags := config.AsLogStreamingCredentials(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || creds == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLogStreamingCredentials(config.OutputType.ValueString(), creds)...)
+}
+
+// Create configures and starts audit log streaming on the App Service.
+func (a *AppServiceAuditLogStreaming) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AppServiceAuditLogStreaming
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.putAuditLogStreaming(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating App Service audit log streaming",
+			"Could not configure audit log streaming on App Service "+plan.AppServiceId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := a.getAuditLogStreaming(
+		ctx,
+		plan.OrganizationId.ValueString(),
+		plan.ProjectId.ValueString(),
+		plan.ClusterId.ValueString(),
+		plan.AppServiceId.ValueString(),
+		plan.Credentials,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading App Service audit log streaming",
+			"Could not read audit log streaming of App Service "+plan.AppServiceId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the audit log streaming configuration of the App Service.
+func (a *AppServiceAuditLogStreaming) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AppServiceAuditLogStreaming
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Audit Log Streaming",
+			"Could not validate audit log streaming of App Service "+state.AppServiceId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	appServiceId := IDs[providerschema.AppServiceId]
+
+	refreshedState, err := a.getAuditLogStreaming(
+		ctx,
+		IDs[providerschema.OrganizationId],
+		IDs[providerschema.ProjectId],
+		IDs[providerschema.ClusterId],
+		appServiceId,
+		state.Credentials,
+	)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Audit Log Streaming",
+			"Could not read audit log streaming of App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Without an output type audit log streaming has never been configured.
+	if refreshedState.OutputType.IsNull() {
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the audit log streaming configuration of the App Service.
+// When only streaming_enabled changes, streaming is paused or resumed in place.
+func (a *AppServiceAuditLogStreaming) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.AppServiceAuditLogStreaming
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if plan.OutputType.Equal(state.OutputType) &&
+		plan.Credentials.Equal(state.Credentials) &&
+		plan.DisabledAppEndpoints.Equal(state.DisabledAppEndpoints) {
+		err = a.patchStreamingEnabled(ctx, plan)
+	} else {
+		err = a.putAuditLogStreaming(ctx, plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating App Service audit log streaming",
+			"Could not update audit log streaming on App Service "+plan.AppServiceId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := a.getAuditLogStreaming(
+		ctx,
+		plan.OrganizationId.ValueString(),
+		plan.ProjectId.ValueString(),
+		plan.ClusterId.ValueString(),
+		plan.AppServiceId.ValueString(),
+		plan.Credentials,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading App Service audit log streaming",
+			"Could not read audit log streaming of App Service "+plan.AppServiceId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete disables audit log streaming on the App Service.
+func (a *AppServiceAuditLogStreaming) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AppServiceAuditLogStreaming
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting App Service audit log streaming",
+			"Could not validate audit log streaming of App Service "+state.AppServiceId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	appServiceId := IDs[providerschema.AppServiceId]
+
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(IDs[providerschema.OrganizationId], IDs[providerschema.ProjectId], IDs[providerschema.ClusterId], appServiceId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	putResp, err := a.ClientV2.PutAppServiceAuditLogStreamingWithResponse(
+		ctx,
+		orgUUID,
+		projUUID,
+		clusterUUID,
+		appServiceUUID,
+		apigen.PutAuditLogStreamingRequest{StreamingEnabled: false},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting App Service audit log streaming",
+			"Could not disable audit log streaming on App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	switch putResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error deleting App Service audit log streaming",
+			fmt.Sprintf("Could not disable audit log streaming on App Service %s, unexpected response status %d: %s", appServiceId, putResp.StatusCode(), string(putResp.Body)),
+		)
+	}
+}
+
+// ImportState imports the audit log streaming of an App Service to be managed by terraform.
+func (a *AppServiceAuditLogStreaming) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("app_service_id"), req, resp)
+}
+
+// putAuditLogStreaming sends the whole planned audit log streaming configuration to Capella.
+func (a *AppServiceAuditLogStreaming) putAuditLogStreaming(ctx context.Context, plan providerschema.AppServiceAuditLogStreaming) error {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(
+		plan.OrganizationId.ValueString(),
+		plan.ProjectId.ValueString(),
+		plan.ClusterId.ValueString(),
+		plan.AppServiceId.ValueString(),
+	)
+	if err != nil {
+		return err
+	}
+
+	creds, diags := plan.AsLogStreamingCredentials(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("failed to extract credentials: %s", diags.Errors())
+	}
+	if creds == nil {
+		return fmt.Errorf("credentials are required")
+	}
+
+	var apiCredentials apigen.PutAuditLogStreamingRequest_Credentials
+	if err := setLogStreamingCredentials(ctx, apigen.PostLogStreamingRequestOutputType(plan.OutputType.ValueString()), creds, &apiCredentials); err != nil {
+		return err
+	}
+
+	outputType := apigen.PutAuditLogStreamingRequestOutputType(plan.OutputType.ValueString())
+	streamingRequest := apigen.PutAuditLogStreamingRequest{
+		Credentials:      &apiCredentials,
+		OutputType:       &outputType,
+		StreamingEnabled: plan.StreamingEnabled.ValueBool(),
+	}
+	if !plan.DisabledAppEndpoints.IsNull() && !plan.DisabledAppEndpoints.IsUnknown() {
+		var disabledAppEndpoints []string
+		if diags := plan.DisabledAppEndpoints.ElementsAs(ctx, &disabledAppEndpoints, false); diags.HasError() {
+			return fmt.Errorf("failed to extract disabled_app_endpoints: %s", diags.Errors())
+		}
+		streamingRequest.DisabledAppEndpoints = &disabledAppEndpoints
+	}
+
+	putResp, err := a.ClientV2.PutAppServiceAuditLogStreamingWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID, streamingRequest)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch putResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+		return nil
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", putResp.StatusCode(), string(putResp.Body))
+	}
+}
+
+// patchStreamingEnabled pauses or resumes audit log streaming without changing its configuration.
+func (a *AppServiceAuditLogStreaming) patchStreamingEnabled(ctx context.Context, plan providerschema.AppServiceAuditLogStreaming) error {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(
+		plan.OrganizationId.ValueString(),
+		plan.ProjectId.ValueString(),
+		plan.ClusterId.ValueString(),
+		plan.AppServiceId.ValueString(),
+	)
+	if err != nil {
+		return err
+	}
+
+	patchResp, err := a.ClientV2.PatchAppServiceAuditLogStreamingWithResponse(
+		ctx,
+		orgUUID,
+		projUUID,
+		clusterUUID,
+		appServiceUUID,
+		apigen.PatchAuditLogStreamingRequest{
+			Op:    apigen.Update,
+			Path:  auditLogStreamingEnabledPath,
+			Value: plan.StreamingEnabled.ValueBool(),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch patchResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+		return nil
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", patchResp.StatusCode(), string(patchResp.Body))
+	}
+}
+
+// getAuditLogStreaming retrieves the audit log streaming configuration of the App Service.
+// errors.ErrNotFound is returned when the App Service does not exist.
+func (a *AppServiceAuditLogStreaming) getAuditLogStreaming(
+	ctx context.Context,
+	organizationId, projectId, clusterId, appServiceId string,
+	existingCredentials types.Object,
+) (*providerschema.AppServiceAuditLogStreaming, error) {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := a.ClientV2.GetAppServiceAuditLogStreamingWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	return providerschema.NewAppServiceAuditLogStreaming(*getResp.JSON200, organizationId, projectId, clusterId, appServiceId, existingCredentials), nil
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServiceAuditLogStreamingBuilder = capellaschema.NewSchemaBuilder("appServiceAuditLogStreaming", "PutAuditLogStreamingRequest")
+
+// AppServiceAuditLogStreamingSchema returns the schema for the app_service_audit_log_streaming resource.
+func AppServiceAuditLogStreamingSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appServiceAuditLogStreamingBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", appServiceAuditLogStreamingBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", appServiceAuditLogStreamingBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "app_service_id", appServiceAuditLogStreamingBuilder, requiredUUIDStringAttribute())
+
+	capellaschema.AddAttr(attrs, "output_type", appServiceAuditLogStreamingBuilder, stringAttribute([]string{required}))
+	// The credentials are the same as for App Service log streaming.
+	capellaschema.AddAttr(attrs, "credentials", appServiceAuditLogStreamingBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Sensitive:  true,
+		Attributes: buildCredentialsAttributes(),
+	})
+
+	disabledAppEndpointsAttr := stringSetAttribute(optional, computed)
+	disabledAppEndpointsAttr.PlanModifiers = []planmodifier.Set{setplanmodifier.UseStateForUnknown()}
+	capellaschema.AddAttr(attrs, "disabled_app_endpoints", appServiceAuditLogStreamingBuilder, disabledAppEndpointsAttr)
+	capellaschema.AddAttr(attrs, "streaming_enabled", appServiceAuditLogStreamingBuilder, boolDefaultAttribute(true, optional, computed))
+
+	capellaschema.AddAttr(attrs, "log_streaming_state", appServiceAuditLogStreamingBuilder, stringAttribute([]string{computed}), "GetAuditLogStreamingResponse")
+
+	return schema.Schema{
+		MarkdownDescription: "Manages the audit log streaming of an App Service. " +
+			"This resource streams the audit logs of the App Service to a log collector, configured by its output type and credentials. " +
+			"Setting `streaming_enabled` to false pauses streaming, and destroying this resource disables it.",
+		Attributes: attrs,
+	}
+}
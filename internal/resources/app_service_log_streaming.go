@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -417,6 +418,14 @@ func (r *AppServiceLogStreaming) ValidateConfig(ctx context.Context, req resourc
 		return
 	}
 
+	resp.Diagnostics.Append(validateLogStreamingCredentials(outputType, creds)...)
+}
+
+// validateLogStreamingCredentials checks the output type is valid and matches the configured
+// credentials object, and that no other credentials objects are set.
+func validateLogStreamingCredentials(outputType string, creds *providerschema.LogStreamingCredentials) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	// Map each output_type to a check for whether its matching credential block is provided.
 	type credentialCheck struct {
 		outputType string
@@ -439,31 +448,32 @@ func (r *AppServiceLogStreaming) ValidateConfig(ctx context.Context, req resourc
 		if check.outputType == outputType {
 			matchFound = true
 			if !check.isPresent {
-				resp.Diagnostics.AddAttributeError(
+				diags.AddAttributeError(
 					path.Root("credentials"),
 					"Missing Credential Configuration",
 					fmt.Sprintf("credentials.%s must be configured when output_type is %q", check.outputType, outputType),
 				)
-				return
+				return diags
 			}
 		} else if check.isPresent {
-			resp.Diagnostics.AddAttributeError(
+			diags.AddAttributeError(
 				path.Root("credentials"),
 				"Invalid Credential Configuration",
 				fmt.Sprintf("credentials.%s must not be configured when output_type is %q", check.outputType, outputType),
 			)
-			return
+			return diags
 		}
 	}
 
 	if !matchFound {
-		resp.Diagnostics.AddAttributeError(
+		diags.AddAttributeError(
 			path.Root("output_type"),
 			"Invalid Attribute Configuration",
 			fmt.Sprintf("Unsupported output_type %q. Please read the documentation for supported values.", outputType),
 		)
-		return
 	}
+
+	return diags
 }
 
 // ImportState imports a remote resource that is not managed by Terraform.
@@ -490,47 +500,71 @@ func (r *AppServiceLogStreaming) buildPostLogStreamingRequest(ctx context.Contex
 		return apigen.PostLogStreamingRequest{}, fmt.Errorf("credentials are required")
 	}
 
+	if err := setLogStreamingCredentials(ctx, outputType, creds, &apiCredentials); err != nil {
+		return apigen.PostLogStreamingRequest{}, err
+	}
+
+	return apigen.PostLogStreamingRequest{
+		OutputType:  outputType,
+		Credentials: apiCredentials,
+	}, nil
+}
+
+// logStreamingCredentialsUnion is implemented by the generated credentials of both the
+// log streaming and the audit log streaming requests.
+type logStreamingCredentialsUnion interface {
+	FromDatadog(v apigen.Datadog) error
+	FromDynatrace(v apigen.Dynatrace) error
+	FromElastic(v apigen.Elastic) error
+	FromGenericHttp(v apigen.GenericHttp) error
+	FromLoki(v apigen.Loki) error
+	FromSplunk(v apigen.Splunk) error
+	FromSumologic(v apigen.Sumologic) error
+}
+
+// setLogStreamingCredentials sets the credentials for the given output type on the API request credentials.
+func setLogStreamingCredentials(ctx context.Context, outputType apigen.PostLogStreamingRequestOutputType, creds *providerschema.LogStreamingCredentials, apiCredentials logStreamingCredentialsUnion) error {
 	// Set credentials based on which provider is configured
 	switch outputType {
 	case apigen.PostLogStreamingRequestOutputTypeDatadog:
 		dd, diags := creds.AsDatadogCredentials(ctx)
 		if diags.HasError() {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to extract datadog credentials: %s", diags.Errors())
+			return fmt.Errorf("failed to extract datadog credentials: %s", diags.Errors())
 		}
 		if dd == nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("datadog credentials are required when output_type is 'datadog'")
+			return fmt.Errorf("datadog credentials are required when output_type is 'datadog'")
 		}
 		err := apiCredentials.FromDatadog(apigen.Datadog{
 			Url:    dd.Url.ValueString(),
 			ApiKey: dd.ApiKey.ValueString(),
 		})
 		if err != nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to set datadog credentials: %w", err)
+			return fmt.Errorf("failed to set datadog credentials: %w", err)
 		}
 
 	case apigen.PostLogStreamingRequestOutputTypeDynatrace:
 		dt, diags := creds.AsDynatraceCredentials(ctx)
 		if diags.HasError() {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to extract dynatrace credentials: %s", diags.Errors())
+			return fmt.Errorf("failed to extract dynatrace credentials: %s", diags.Errors())
 		}
 		if dt == nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("dynatrace credentials are required when output_type is 'dynatrace'")
+			return fmt.Errorf("dynatrace credentials are required when output_type is 'dynatrace'")
 		}
 		err := apiCredentials.FromDynatrace(apigen.Dynatrace{
 			Url:      dt.Url.ValueString(),
 			ApiToken: dt.ApiToken.ValueString(),
 		})
 		if err != nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to set dynatrace credentials: %w", err)
+			return fmt.Errorf("failed to set dynatrace credentials: %w", err)
 		}
 
 	case apigen.PostLogStreamingRequestOutputTypeElastic:
 		el, diags := creds.AsElasticCredentials(ctx)
 		if diags.HasError() {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to extract elastic credentials: %s", diags.Errors())
+			return fmt.Errorf("failed to extract elastic credentials: %s", diags.Errors())
 		}
 		if el == nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("elastic credentials are required when output_type is 'elastic'")
+			return fmt.Errorf("elastic credentials are required when output_type is 'elastic'")
 		}
 		err := apiCredentials.FromElastic(apigen.Elastic{
 			Url:      el.Url.ValueString(),
@@ -538,16 +572,16 @@ func (r *AppServiceLogStreaming) buildPostLogStreamingRequest(ctx context.Contex
 			Password: el.Password.ValueString(),
 		})
 		if err != nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to set elastic credentials: %w", err)
+			return fmt.Errorf("failed to set elastic credentials: %w", err)
 		}
 
 	case apigen.PostLogStreamingRequestOutputTypeGenericHttp:
 		gh, diags := creds.AsGenericHttpCredentials(ctx)
 		if diags.HasError() {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to extract generic_http credentials: %s", diags.Errors())
+			return fmt.Errorf("failed to extract generic_http credentials: %s", diags.Errors())
 		}
 		if gh == nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("generic_http credentials are required when output_type is 'generic_http'")
+			return fmt.Errorf("generic_http credentials are required when output_type is 'generic_http'")
 		}
 		genericHttp := apigen.GenericHttp{
 			Url: gh.Url.ValueString(),
@@ -563,16 +597,16 @@ func (r *AppServiceLogStreaming) buildPostLogStreamingRequest(ctx context.Contex
 		}
 		err := apiCredentials.FromGenericHttp(genericHttp)
 		if err != nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to set generic_http credentials: %w", err)
+			return fmt.Errorf("failed to set generic_http credentials: %w", err)
 		}
 
 	case apigen.PostLogStreamingRequestOutputTypeLoki:
 		lk, diags := creds.AsLokiCredentials(ctx)
 		if diags.HasError() {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to extract loki credentials: %s", diags.Errors())
+			return fmt.Errorf("failed to extract loki credentials: %s", diags.Errors())
 		}
 		if lk == nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("loki credentials are required when output_type is 'loki'")
+			return fmt.Errorf("loki credentials are required when output_type is 'loki'")
 		}
 		err := apiCredentials.FromLoki(apigen.Loki{
 			Url:      lk.Url.ValueString(),
@@ -580,48 +614,45 @@ func (r *AppServiceLogStreaming) buildPostLogStreamingRequest(ctx context.Contex
 			Password: lk.Password.ValueString(),
 		})
 		if err != nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to set loki credentials: %w", err)
+			return fmt.Errorf("failed to set loki credentials: %w", err)
 		}
 
 	case apigen.PostLogStreamingRequestOutputTypeSplunk:
 		sp, diags := creds.AsSplunkCredentials(ctx)
 		if diags.HasError() {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to extract splunk credentials: %s", diags.Errors())
+			return fmt.Errorf("failed to extract splunk credentials: %s", diags.Errors())
 		}
 		if sp == nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("splunk credentials are required when output_type is 'splunk'")
+			return fmt.Errorf("splunk credentials are required when output_type is 'splunk'")
 		}
 		err := apiCredentials.FromSplunk(apigen.Splunk{
 			Url:         sp.Url.ValueString(),
 			SplunkToken: sp.SplunkToken.ValueString(),
 		})
 		if err != nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to set splunk credentials: %w", err)
+			return fmt.Errorf("failed to set splunk credentials: %w", err)
 		}
 
 	case apigen.PostLogStreamingRequestOutputTypeSumologic:
 		sl, diags := creds.AsSumologicCredentials(ctx)
 		if diags.HasError() {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to extract sumologic credentials: %s", diags.Errors())
+			return fmt.Errorf("failed to extract sumologic credentials: %s", diags.Errors())
 		}
 		if sl == nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("sumologic credentials are required when output_type is 'sumologic'")
+			return fmt.Errorf("sumologic credentials are required when output_type is 'sumologic'")
 		}
 		err := apiCredentials.FromSumologic(apigen.Sumologic{
 			Url: sl.Url.ValueString(),
 		})
 		if err != nil {
-			return apigen.PostLogStreamingRequest{}, fmt.Errorf("failed to set sumologic credentials: %w", err)
+			return fmt.Errorf("failed to set sumologic credentials: %w", err)
 		}
 
 	default:
-		return apigen.PostLogStreamingRequest{}, fmt.Errorf("unsupported output_type: %s", outputType)
+		return fmt.Errorf("unsupported output_type: %s", outputType)
 	}
 
-	return apigen.PostLogStreamingRequest{
-		OutputType:  outputType,
-		Credentials: apiCredentials,
-	}, nil
+	return nil
 }
 
 // refreshLogStreaming retrieves the current state of the log streaming configuration from the API.
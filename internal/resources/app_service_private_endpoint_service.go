@@ -0,0 +1,384 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AppServicePrivateEndpointService{}
+	_ resource.ResourceWithConfigure   = &AppServicePrivateEndpointService{}
+	_ resource.ResourceWithImportState = &AppServicePrivateEndpointService{}
+)
+
+// AppServicePrivateEndpointService is the App Service private endpoint service resource implementation.
+type AppServicePrivateEndpointService struct {
+	*providerschema.Data
+}
+
+// NewAppServicePrivateEndpointService is a helper function to simplify the provider implementation.
+func NewAppServicePrivateEndpointService() resource.Resource {
+	return &AppServicePrivateEndpointService{}
+}
+
+// Metadata returns the App Service private endpoint service resource type name.
+func (a *AppServicePrivateEndpointService) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_private_endpoint_service"
+}
+
+// Schema defines the schema for the App Service private endpoint service resource.
+func (a *AppServicePrivateEndpointService) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AppServicePrivateEndpointServiceSchema()
+}
+
+// Create enables the private endpoint service on the App Service.
+func (a *AppServicePrivateEndpointService) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AppServicePrivateEndpointService
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+		appServiceId   = plan.AppServiceId.ValueString()
+	)
+
+	if err := a.setEnabled(ctx, true, organizationId, projectId, clusterId, appServiceId); err != nil {
+		resp.Diagnostics.AddError(
+			"Error enabling App Service private endpoint service",
+			errorMessageWhileEnablingPrivateEndpointService+err.Error(),
+		)
+		return
+	}
+
+	plan.State = types.StringNull()
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := a.waitUntilStateChanges(ctx, true, organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error could not enable App Service private endpoint service",
+			"Error could not enable private endpoint service on App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the private endpoint service status of the App Service.
+func (a *AppServicePrivateEndpointService) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AppServicePrivateEndpointService
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Private Endpoint Service in Capella",
+			"Could not read Capella private endpoint service on App Service "+state.AppServiceId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		appServiceId   = IDs[providerschema.AppServiceId]
+	)
+
+	refreshedState, err := a.getServiceState(ctx, organizationId, projectId, clusterId, appServiceId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error reading App Service private endpoint service status",
+			"Error reading App Service private endpoint service status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// A failed enablement cannot recover in place, so remove it from state to
+	// force a clean re-create on the next apply.
+	if refreshedState.Enabled.ValueBool() && refreshedState.State.ValueString() == string(apigen.GetAppServicePrivateEndpointStateResponseStateFailed) {
+		tflog.Info(ctx, "App Service private endpoint service enablement failed; removing from state to force re-create")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update enables or disables the private endpoint service on the App Service.
+func (a *AppServicePrivateEndpointService) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan providerschema.AppServicePrivateEndpointService
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+		appServiceId   = plan.AppServiceId.ValueString()
+		enabled        = plan.Enabled.ValueBool()
+	)
+
+	status := "enabling"
+	if !enabled {
+		status = "disabling"
+	}
+
+	if err := a.setEnabled(ctx, enabled, organizationId, projectId, clusterId, appServiceId); err != nil {
+		resp.Diagnostics.AddError(
+			"Error "+status+" App Service private endpoint service",
+			"Error "+status+" private endpoint service on App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := a.waitUntilStateChanges(ctx, enabled, organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error "+status+" App Service private endpoint service",
+			"Error "+status+" private endpoint service on App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete disables the private endpoint service on the App Service.
+func (a *AppServicePrivateEndpointService) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AppServicePrivateEndpointService
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error validating App Service Private Endpoint Service in Capella",
+			"Could not validate Capella private endpoint service on App Service "+state.AppServiceId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		appServiceId   = IDs[providerschema.AppServiceId]
+	)
+
+	// If private endpoint service is already disabled, just remove the resource from the state file.
+	if !state.Enabled.ValueBool() {
+		return
+	}
+
+	err = a.setEnabled(ctx, false, organizationId, projectId, clusterId, appServiceId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error disabling App Service private endpoint service",
+			"Could not disable private endpoint service for App Service "+appServiceId+" unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if _, err = a.waitUntilStateChanges(ctx, false, organizationId, projectId, clusterId, appServiceId); err != nil && err != errors.ErrNotFound {
+		resp.Diagnostics.AddError(
+			"Error could not disable App Service private endpoint service",
+			"Error could not disable private endpoint service on App Service "+appServiceId+", unexpected error: "+err.Error(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the App Service private endpoint service resource.
+func (a *AppServicePrivateEndpointService) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
+
+// ImportState imports the private endpoint service status of an App Service.
+func (a *AppServicePrivateEndpointService) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("app_service_id"), req, resp)
+}
+
+// setEnabled requests the private endpoint service to be enabled or disabled.
+// errors.ErrNotFound is returned when the App Service does not exist.
+func (a *AppServicePrivateEndpointService) setEnabled(ctx context.Context, enabled bool, organizationId, projectId, clusterId, appServiceId string) error {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		return err
+	}
+
+	var (
+		statusCode int
+		body       []byte
+	)
+	if enabled {
+		response, err := a.ClientV2.PostAppServicePrivateEndpointsWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body = response.StatusCode(), response.Body
+	} else {
+		response, err := a.ClientV2.DeleteAppServicePrivateEndpointsWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body = response.StatusCode(), response.Body
+	}
+
+	switch statusCode {
+	case http.StatusAccepted, http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return errors.ErrNotFound
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", statusCode, string(body))
+	}
+}
+
+// waitUntilStateChanges waits until the private endpoint service of the App Service is
+// enabled or disabled, and returns its refreshed state. It fails fast when Capella
+// reports the service as failed.
+func (a *AppServicePrivateEndpointService) waitUntilStateChanges(
+	ctx context.Context,
+	enabled bool,
+	organizationId, projectId, clusterId, appServiceId string,
+) (*providerschema.AppServicePrivateEndpointService, error) {
+	ctx, cancel := context.WithTimeout(ctx, statusChangeTimeout)
+	defer cancel()
+
+	targetState := string(apigen.GetAppServicePrivateEndpointStateResponseStateDisabled)
+	if enabled {
+		targetState = string(apigen.GetAppServicePrivateEndpointStateResponseStateEnabled)
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.ErrPrivateEndpointServiceTimeout
+
+		case <-timer.C:
+			state, err := a.getServiceState(ctx, organizationId, projectId, clusterId, appServiceId)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, errors.ErrPrivateEndpointServiceTimeout
+				}
+				return nil, err
+			}
+
+			tflog.Info(ctx, fmt.Sprintf("App Service private endpoint service state: %s, waiting for: %s", state.State.ValueString(), targetState))
+
+			switch state.State.ValueString() {
+			case targetState:
+				return state, nil
+			case string(apigen.GetAppServicePrivateEndpointStateResponseStateFailed):
+				return nil, fmt.Errorf("private endpoint service reached state %s instead of %s", state.State.ValueString(), targetState)
+			}
+
+			timer.Reset(pollInterval)
+		}
+	}
+}
+
+// getServiceState retrieves the private endpoint service status and converts it into Terraform state.
+// errors.ErrNotFound is returned when the App Service does not exist.
+func (a *AppServicePrivateEndpointService) getServiceState(
+	ctx context.Context,
+	organizationId, projectId, clusterId, appServiceId string,
+) (*providerschema.AppServicePrivateEndpointService, error) {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.ClientV2.GetAppServicePrivateEndpointsWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case response.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case response.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", response.StatusCode(), string(response.Body))
+	}
+
+	return providerschema.NewAppServicePrivateEndpointService(*response.JSON200, organizationId, projectId, clusterId, appServiceId), nil
+}
+
+// parseAppServiceUUIDs parses the IDs of an App Service into UUIDs for the generated API client.
+func parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId string) (uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+		utils.IDField{Name: "app_service_id", Value: appServiceId},
+	)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids[0], uuids[1], uuids[2], uuids[3], nil
+}
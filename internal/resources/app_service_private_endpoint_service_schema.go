@@ -0,0 +1,37 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	custommodifier "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/resources/custom_plan_modifiers"
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServicePrivateEndpointServiceBuilder = capellaschema.NewSchemaBuilder("appServicePrivateEndpointService", "GetAppServicePrivateEndpointStateResponse")
+
+// AppServicePrivateEndpointServiceSchema returns the schema for the app_service_private_endpoint_service resource.
+func AppServicePrivateEndpointServiceSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appServicePrivateEndpointServiceBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", appServicePrivateEndpointServiceBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", appServicePrivateEndpointServiceBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "app_service_id", appServicePrivateEndpointServiceBuilder, requiredUUIDStringAttribute())
+
+	// enabled is not part of the status response, which reports state and targetState instead.
+	enabledAttr := &schema.BoolAttribute{
+		Required:      true,
+		PlanModifiers: []planmodifier.Bool{custommodifier.BlockCreateWhenEnabledSetToFalse()},
+	}
+	enabledAttr.MarkdownDescription = "Whether the private endpoint service is enabled on the App Service. It cannot be `false` when the resource is created."
+	capellaschema.AddAttr(attrs, "enabled", appServicePrivateEndpointServiceBuilder, enabledAttr)
+
+	capellaschema.AddAttr(attrs, "state", appServicePrivateEndpointServiceBuilder, stringAttribute([]string{computed}))
+
+	return schema.Schema{
+		MarkdownDescription: "This resource allows you to manage the private endpoint service for an App Service. " +
+			"The private endpoint service must be enabled before you can create private endpoints to connect your Cloud Service Provider's private network (VPC/VNET) to your App Service.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,276 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AppServicePrivateEndpoint{}
+	_ resource.ResourceWithConfigure   = &AppServicePrivateEndpoint{}
+	_ resource.ResourceWithImportState = &AppServicePrivateEndpoint{}
+)
+
+// AppServicePrivateEndpoint is the App Service private endpoint resource implementation.
+type AppServicePrivateEndpoint struct {
+	*providerschema.Data
+}
+
+// NewAppServicePrivateEndpoint is a helper function to simplify the provider implementation.
+func NewAppServicePrivateEndpoint() resource.Resource {
+	return &AppServicePrivateEndpoint{}
+}
+
+// Metadata returns the App Service private endpoint resource type name.
+func (a *AppServicePrivateEndpoint) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_service_private_endpoints"
+}
+
+// Schema defines the schema for the App Service private endpoint resource.
+func (a *AppServicePrivateEndpoint) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AppServicePrivateEndpointsSchema()
+}
+
+// Create accepts a private endpoint to the App Service on the CSP.
+func (a *AppServicePrivateEndpoint) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.AppServicePrivateEndpoint
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+		appServiceId   = plan.AppServiceId.ValueString()
+		endpointId     = plan.EndpointId.ValueString()
+	)
+
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	acceptResp, err := a.ClientV2.AcceptPrivateEndpointRequestWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID, endpointId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error accepting App Service private endpoint",
+			"Could not accept private endpoint "+endpointId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	switch acceptResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	default:
+		resp.Diagnostics.AddError(
+			"Error accepting App Service private endpoint",
+			fmt.Sprintf("Could not accept private endpoint %s, unexpected response status %d: %s", endpointId, acceptResp.StatusCode(), string(acceptResp.Body)),
+		)
+		return
+	}
+
+	plan.Status = types.StringNull()
+	plan.ServiceName = types.StringNull()
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := a.getPrivateEndpointState(ctx, organizationId, projectId, clusterId, appServiceId, endpointId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading App Service private endpoint status",
+			"Error reading App Service private endpoint status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the status of the App Service private endpoint.
+func (a *AppServicePrivateEndpoint) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.AppServicePrivateEndpoint
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading App Service Private Endpoint",
+			"Could not validate private endpoint "+state.EndpointId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		appServiceId   = IDs[providerschema.AppServiceId]
+		endpointId     = IDs[providerschema.EndpointId]
+	)
+
+	refreshedState, err := a.getPrivateEndpointState(ctx, organizationId, projectId, clusterId, appServiceId, endpointId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error reading App Service private endpoint status",
+			"Error reading App Service private endpoint status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Both rejected and failed associations are terminal and cannot recover in
+	// place, so remove them from state to force a clean re-association on the
+	// next apply rather than leaving a stuck resource.
+	switch apigen.PrivateEndpointStatus(refreshedState.Status.ValueString()) {
+	case apigen.PrivateEndpointStatusRejected, apigen.PrivateEndpointStatusFailed:
+		tflog.Info(ctx, "App Service private endpoint association is "+refreshedState.Status.ValueString()+"; removing from state to force re-association")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is not supported as there is no update API.
+func (a *AppServicePrivateEndpoint) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so Update is never called.
+}
+
+// Delete rejects the private endpoint to the App Service on the CSP.
+func (a *AppServicePrivateEndpoint) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.AppServicePrivateEndpoint
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rejecting App Service private endpoint",
+			"Could not reject endpoint due to validation error: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		appServiceId   = IDs[providerschema.AppServiceId]
+		endpointId     = IDs[providerschema.EndpointId]
+	)
+
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	deleteResp, err := a.ClientV2.DeletePrivateEndpointRequestWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID, endpointId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rejecting App Service private endpoint",
+			"Could not reject private endpoint "+endpointId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error rejecting App Service private endpoint",
+			fmt.Sprintf("Could not reject private endpoint %s, unexpected response status %d: %s", endpointId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the App Service private endpoint resource.
+func (a *AppServicePrivateEndpoint) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Data = data
+}
+
+// ImportState imports an App Service private endpoint to be managed by terraform.
+func (a *AppServicePrivateEndpoint) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("endpoint_id"), req, resp)
+}
+
+// getPrivateEndpointState finds the private endpoint in the list of App Service private endpoints,
+// as there is no endpoint to get a single one, and converts it into Terraform state.
+// errors.ErrNotFound is returned when the private endpoint does not exist.
+func (a *AppServicePrivateEndpoint) getPrivateEndpointState(
+	ctx context.Context,
+	organizationId, projectId, clusterId, appServiceId, endpointId string,
+) (*providerschema.AppServicePrivateEndpoint, error) {
+	orgUUID, projUUID, clusterUUID, appServiceUUID, err := parseAppServiceUUIDs(organizationId, projectId, clusterId, appServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	listResp, err := a.ClientV2.ListAppServicePrivateEndpointsWithResponse(ctx, orgUUID, projUUID, clusterUUID, appServiceUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case listResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case listResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", listResp.StatusCode(), string(listResp.Body))
+	}
+
+	for _, endpoint := range listResp.JSON200.Endpoints {
+		if endpoint.Id == endpointId {
+			return providerschema.NewAppServicePrivateEndpoint(endpoint, organizationId, projectId, clusterId, appServiceId), nil
+		}
+	}
+
+	return nil, errors.ErrNotFound
+}
@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var appServicePrivateEndpointsBuilder = capellaschema.NewSchemaBuilder("appServicePrivateEndpoints", "PrivateEndpoint")
+
+// AppServicePrivateEndpointsSchema returns the schema for the app_service_private_endpoints resource.
+func AppServicePrivateEndpointsSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", appServicePrivateEndpointsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", appServicePrivateEndpointsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", appServicePrivateEndpointsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "app_service_id", appServicePrivateEndpointsBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "endpoint_id", appServicePrivateEndpointsBuilder, stringAttribute(
+		[]string{required, requiresReplace},
+		stringvalidator.LengthAtLeast(1),
+	))
+	capellaschema.AddAttr(attrs, "status", appServicePrivateEndpointsBuilder, stringAttribute([]string{computed}))
+	capellaschema.AddAttr(attrs, "service_name", appServicePrivateEndpointsBuilder, stringAttribute([]string{computed}))
+
+	return schema.Schema{
+		MarkdownDescription: "This resource allows you to manage private endpoints for an App Service. Private endpoints allow you to securely connect your Cloud Service Provider's private network (VPC/VNET) to your App Service without exposing traffic to the public internet.",
+		Attributes:          attrs,
+	}
+}
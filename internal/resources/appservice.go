@@ -32,6 +32,10 @@ const errorMessageAfterAppServiceCreationInitiation = "App Service creation is i
 	" current status of the app service. Additionally, run `terraform apply --refresh-only` to update" +
 	" the state from remote, unexpected error: "
 
+// appServiceTimeout is how long an app service may take to be created, updated or deleted
+// when the timeouts block does not set a value.
+const appServiceTimeout = 60 * time.Minute
+
 const errorMessageWhileAppServiceCreation = "There is an error during app service creation. Please check in Capella to see if any hanging resources" +
 	" have been created, unexpected error: "
 
@@ -95,6 +99,13 @@ func (a *AppService) Create(ctx context.Context, req resource.CreateRequest, res
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, appServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	err := a.validateCreateAppServiceRequest(plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -196,6 +207,8 @@ func (a *AppService) Create(ctx context.Context, req resource.CreateRequest, res
 		return
 	}
 
+	refreshedState.Timeouts = plan.Timeouts
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, refreshedState)
 	resp.Diagnostics.Append(diags...)
@@ -249,6 +262,8 @@ func (a *AppService) Read(ctx context.Context, req resource.ReadRequest, resp *r
 		refreshedState.IfMatch = state.IfMatch
 	}
 
+	refreshedState.Timeouts = state.Timeouts
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &refreshedState)
 	resp.Diagnostics.Append(diags...)
@@ -271,6 +286,13 @@ func (a *AppService) Update(ctx context.Context, req resource.UpdateRequest, res
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, appServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resourceIDs, err := state.Validate()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -365,6 +387,7 @@ func (a *AppService) Update(ctx context.Context, req resource.UpdateRequest, res
 	if !plan.IfMatch.IsUnknown() && !plan.IfMatch.IsNull() {
 		currentState.IfMatch = plan.IfMatch
 	}
+	currentState.Timeouts = plan.Timeouts
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, currentState)
@@ -383,6 +406,13 @@ func (a *AppService) Delete(ctx context.Context, req resource.DeleteRequest, res
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, appServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resourceIDs, err := state.Validate()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -464,6 +494,7 @@ func (a *AppService) Delete(ctx context.Context, req resource.DeleteRequest, res
 		tflog.Error(ctx, fmt.Sprintf("failed to refresh App Service state after failed deletion: %v", err))
 		return
 	}
+	currentState.Timeouts = state.Timeouts
 
 	diags = resp.State.Set(ctx, currentState)
 	resp.Diagnostics.Append(diags...)
@@ -493,8 +524,8 @@ func (a *AppService) Configure(_ context.Context, req resource.ConfigureRequest,
 // Unfortunately the terraform import CLI doesn't allow us to pass multiple IDs at this point
 // and hence this workaround has been applied.
 func (a *AppService) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Names in the import ID are resolved to IDs before they are saved to the id attribute.
+	importStateByName(ctx, a.Data, req, resp, "App Service", "app_service_id", "project_name", "cluster_name", "app_service_name")
 }
 
 // validateCreateAppServiceRequest validates the payload of create app service request.
@@ -541,19 +572,13 @@ func (a *AppService) refreshAppService(ctx context.Context, organizationId, proj
 // organization, project, cluster and appService ID. It periodically fetches the app service status using the `getAppService`
 // function and waits until the app service reaches a final state or until a specified timeout is reached.
 // The function returns an error if the operation times out or encounters an error during status retrieval.
+// The deadline comes from ctx, which the caller bounds with the resource timeouts.
 func (a *AppService) checkAppServiceStatus(ctx context.Context, organizationId, projectId, clusterId, appServiceId string) error {
 	var (
 		appServiceResp *appservice.GetAppServiceResponse
 		err            error
 	)
 
-	// Assuming 60 minutes is the max time deployment takes, can change after discussion
-	const timeout = time.Minute * 60
-
-	var cancel context.CancelFunc
-	ctx, cancel = context.WithTimeout(ctx, timeout)
-	defer cancel()
-
 	const sleep = time.Second * 3
 
 	timer := time.NewTimer(2 * time.Minute)
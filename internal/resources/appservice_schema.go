@@ -47,5 +47,8 @@ func AppServiceSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "This resource allows you to create and manage an App Service in Capella. App Service is a fully managed application backend designed to provide data synchronization between mobile or IoT applications running Couchbase Lite and your Couchbase Capella database.",
 		Attributes:          attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
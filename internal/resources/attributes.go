@@ -111,6 +111,17 @@ func requiredStringAttributeNoReplace() *schema.StringAttribute {
 	return stringAttribute([]string{required}, validator.String(stringvalidator.LengthAtLeast(1)))
 }
 
+// writeOnlyStringAttribute returns an optional, sensitive string attribute that is write-only.
+// Write-only values are only available from the configuration and are never persisted to the plan or state.
+func writeOnlyStringAttribute(description string) *schema.StringAttribute {
+	return &schema.StringAttribute{
+		Optional:            true,
+		Sensitive:           true,
+		WriteOnly:           true,
+		MarkdownDescription: description,
+	}
+}
+
 // rfc3339Attribute is a variadic function which returns a string attribute with the requested fields set to true
 // if the string satisfies the rfc3339 format, otherwise an error is returned.
 func rfc3339Attribute(fields ...string) *schema.StringAttribute {
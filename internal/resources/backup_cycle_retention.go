@@ -0,0 +1,270 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &BackupCycleRetention{}
+	_ resource.ResourceWithConfigure   = &BackupCycleRetention{}
+	_ resource.ResourceWithImportState = &BackupCycleRetention{}
+	_ resource.ResourceWithModifyPlan  = &BackupCycleRetention{}
+)
+
+// BackupCycleRetention is the backup cycle retention resource implementation.
+type BackupCycleRetention struct {
+	*providerschema.Data
+}
+
+// expiredBackupCycle is a backup cycle older than the configured maximum age.
+// Cycles are deleted through any backup they contain, so one is kept alongside the cycle.
+type expiredBackupCycle struct {
+	cycleId  string
+	backupId uuid.UUID
+}
+
+// NewBackupCycleRetention is a helper function to simplify the provider implementation.
+func NewBackupCycleRetention() resource.Resource {
+	return &BackupCycleRetention{}
+}
+
+// Metadata returns the backup cycle retention resource type name.
+func (b *BackupCycleRetention) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_cycle_retention"
+}
+
+// Schema defines the schema for the backup cycle retention resource.
+func (b *BackupCycleRetention) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = BackupCycleRetentionSchema()
+}
+
+// Configure adds the provider configured client to the backup cycle retention resource.
+func (b *BackupCycleRetention) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	b.Data = data
+}
+
+// ModifyPlan plans expired_cycle_ids as empty, since every apply deletes the expired cycles.
+// Cycles that expired since the last apply are in the state, so the difference triggers an update.
+func (b *BackupCycleRetention) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expired_cycle_ids"), providerschema.NewExpiredCycleIds(nil))...)
+}
+
+// Create deletes the backup cycles that are older than the maximum age.
+func (b *BackupCycleRetention) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.BackupCycleRetention
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := b.deleteExpiredCycles(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating backup cycle retention",
+			"Could not delete expired backup cycles of bucket "+plan.BucketId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the backup cycles that are older than the maximum age.
+func (b *BackupCycleRetention) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.BackupCycleRetention
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading backup cycle retention",
+			"Could not validate backup cycle retention: "+err.Error(),
+		)
+		return
+	}
+
+	state.OrganizationId = types.StringValue(IDs[providerschema.OrganizationId])
+	state.ProjectId = types.StringValue(IDs[providerschema.ProjectId])
+	state.ClusterId = types.StringValue(IDs[providerschema.ClusterId])
+	state.BucketId = types.StringValue(IDs[providerschema.BucketId])
+
+	// max_age_days is unknown to Capella, so nothing is expired until it is configured after an import.
+	var expired []expiredBackupCycle
+	if !state.MaxAgeDays.IsNull() {
+		expired, err = b.listExpiredCycles(ctx, state, time.Now())
+	}
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error reading backup cycle retention",
+			"Could not read backup cycles of bucket "+state.BucketId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	cycleIds := make([]string, 0, len(expired))
+	for _, cycle := range expired {
+		cycleIds = append(cycleIds, cycle.cycleId)
+	}
+	state.ExpiredCycleIds = providerschema.NewExpiredCycleIds(cycleIds)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update deletes the backup cycles that are older than the maximum age.
+func (b *BackupCycleRetention) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan providerschema.BackupCycleRetention
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := b.deleteExpiredCycles(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating backup cycle retention",
+			"Could not delete expired backup cycles of bucket "+plan.BucketId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the resource from the state. Backup cycles that were
+// already deleted cannot be recovered.
+func (b *BackupCycleRetention) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the backup cycle retention of a bucket.
+func (b *BackupCycleRetention) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket_id"), req, resp)
+}
+
+// deleteExpiredCycles deletes every backup cycle of the bucket that is older than
+// max_age_days and clears expired_cycle_ids once all deletions were accepted.
+func (b *BackupCycleRetention) deleteExpiredCycles(ctx context.Context, plan *providerschema.BackupCycleRetention) error {
+	expired, err := b.listExpiredCycles(ctx, *plan, time.Now())
+	if err != nil {
+		return err
+	}
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(plan.OrganizationId.ValueString(), plan.ProjectId.ValueString(), plan.ClusterId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	for _, cycle := range expired {
+		tflog.Info(ctx, "deleting expired backup cycle "+cycle.cycleId)
+
+		deleteResp, err := b.ClientV2.DeleteBackupCycleByIDWithResponse(ctx, orgUUID, projUUID, clusterUUID, cycle.backupId)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+
+		switch deleteResp.StatusCode() {
+		case http.StatusAccepted, http.StatusNoContent, http.StatusOK, http.StatusNotFound:
+		default:
+			return fmt.Errorf("could not delete backup cycle %s, unexpected response status %d: %s", cycle.cycleId, deleteResp.StatusCode(), string(deleteResp.Body))
+		}
+	}
+
+	plan.ExpiredCycleIds = providerschema.NewExpiredCycleIds(nil)
+	return nil
+}
+
+// listExpiredCycles returns the backup cycles whose latest backup is older than max_age_days.
+// errors.ErrNotFound is returned when the bucket does not exist.
+func (b *BackupCycleRetention) listExpiredCycles(ctx context.Context, state providerschema.BackupCycleRetention, now time.Time) ([]expiredBackupCycle, error) {
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(state.OrganizationId.ValueString(), state.ProjectId.ValueString(), state.ClusterId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	bucketId := state.BucketId.ValueString()
+
+	cyclesResp, err := b.ClientV2.ListCyclesWithResponse(ctx, orgUUID, projUUID, clusterUUID, bucketId, &apigen.ListCyclesParams{})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case cyclesResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case cyclesResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", cyclesResp.StatusCode(), string(cyclesResp.Body))
+	}
+
+	cutoff := backupCycleCutoff(now, state.MaxAgeDays.ValueInt64())
+
+	var expired []expiredBackupCycle
+	for _, cycle := range cyclesResp.JSON200.Data {
+		backupsResp, err := b.ClientV2.ListBackupsWithResponse(ctx, orgUUID, projUUID, clusterUUID, bucketId, cycle.CycleID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		if backupsResp.JSON200 == nil {
+			return nil, fmt.Errorf("could not list backups of cycle %s, unexpected response status %d: %s", cycle.CycleID, backupsResp.StatusCode(), string(backupsResp.Body))
+		}
+
+		backups := backupsResp.JSON200.Data
+		// A cycle without backups has nothing left to delete.
+		if len(backups) == 0 || !providerschema.LastBackupTime(cycle, backups).Before(cutoff) {
+			continue
+		}
+
+		expired = append(expired, expiredBackupCycle{cycleId: cycle.CycleID.String(), backupId: backups[0].Id})
+	}
+
+	return expired, nil
+}
+
+// backupCycleCutoff returns the time before which the latest backup of a cycle must
+// have been created for the cycle to be older than maxAgeDays.
+func backupCycleCutoff(now time.Time, maxAgeDays int64) time.Time {
+	return now.AddDate(0, 0, -int(maxAgeDays))
+}
@@ -0,0 +1,35 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var backupCycleRetentionBuilder = capellaschema.NewSchemaBuilder("backupCycleRetention")
+
+func BackupCycleRetentionSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", backupCycleRetentionBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", backupCycleRetentionBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", backupCycleRetentionBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "bucket_id", backupCycleRetentionBuilder, stringAttribute([]string{required, requiresReplace}))
+	capellaschema.AddAttr(attrs, "max_age_days", backupCycleRetentionBuilder, &schema.Int64Attribute{
+		Required: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	})
+	capellaschema.AddAttr(attrs, "expired_cycle_ids", backupCycleRetentionBuilder, stringSetAttribute(computed))
+
+	return schema.Schema{
+		MarkdownDescription: "Deletes the backup cycles of a bucket once their latest backup is older than `max_age_days`. " +
+			"Expired cycles are deleted on create and update, and any cycle that expires later is reported in `expired_cycle_ids` and deleted on the next apply. " +
+			"Measuring the age from the latest backup means a cycle that is still receiving backups is never deleted. " +
+			"Destroying the resource only stops the pruning; deleted cycles cannot be recovered.",
+		Attributes: attrs,
+	}
+}
@@ -5,13 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
 	bucketapi "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/bucket"
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
 	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -32,6 +33,11 @@ const errorMessageAfterBucketCreation = "Bucket creation is successful, but enco
 const errorMessageWhileBucketCreation = "There is an error during bucket creation. Please check in Capella to see if any hanging resources" +
 	" have been created, unexpected error: "
 
+// bucketTimeout is how long a bucket may take to be created, updated or deleted when the
+// timeouts block does not set a value. Migrating the storage backend rewrites all the data
+// of the bucket, so it allows as long as a cluster deployment.
+const bucketTimeout = 60 * time.Minute
+
 // Bucket is the bucket resource implementation.
 type Bucket struct {
 	*providerschema.Data
@@ -54,7 +60,7 @@ func (c *Bucket) Schema(_ context.Context, _ resource.SchemaRequest, resp *resou
 
 // Create creates a new Bucket.
 func (c *Bucket) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan providerschema.Bucket
+	var plan providerschema.BucketWithTimeouts
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 
@@ -62,6 +68,13 @@ func (c *Bucket) Create(ctx context.Context, req resource.CreateRequest, resp *r
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, bucketTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	BucketRequest := bucketapi.CreateBucketRequest{
 		Name: plan.Name.ValueString(),
 	}
@@ -107,7 +120,7 @@ func (c *Bucket) Create(ctx context.Context, req resource.CreateRequest, resp *r
 		BucketRequest.Vbuckets = plan.Vbuckets.ValueInt64()
 	}
 
-	if err := c.validateCreateBucket(plan); err != nil {
+	if err := c.validateCreateBucket(plan.Bucket); err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating bucket",
 			"Could not create bucket, unexpected error: "+err.Error(),
@@ -146,7 +159,10 @@ func (c *Bucket) Create(ctx context.Context, req resource.CreateRequest, resp *r
 		return
 	}
 
-	diags = resp.State.Set(ctx, initializeBucketWithPlanAndId(plan, BucketResponse.Id))
+	diags = resp.State.Set(ctx, providerschema.BucketWithTimeouts{
+		Bucket:   initializeBucketWithPlanAndId(plan.Bucket, BucketResponse.Id),
+		Timeouts: plan.Timeouts,
+	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -162,7 +178,7 @@ func (c *Bucket) Create(ctx context.Context, req resource.CreateRequest, resp *r
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, refreshedState)
+	diags = resp.State.Set(ctx, providerschema.OneBucketWithTimeouts{OneBucket: *refreshedState, Timeouts: plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -191,7 +207,7 @@ func (c *Bucket) Configure(_ context.Context, req resource.ConfigureRequest, res
 
 // Read reads the bucket information.
 func (c *Bucket) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state providerschema.Bucket
+	var state providerschema.BucketWithTimeouts
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -229,7 +245,7 @@ func (c *Bucket) Read(ctx context.Context, req resource.ReadRequest, resp *resou
 		return
 	}
 
-	diags = resp.State.Set(ctx, &refreshedState)
+	diags = resp.State.Set(ctx, providerschema.OneBucketWithTimeouts{OneBucket: *refreshedState, Timeouts: state.Timeouts})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -238,13 +254,20 @@ func (c *Bucket) Read(ctx context.Context, req resource.ReadRequest, resp *resou
 
 // Delete deletes the bucket.
 func (r *Bucket) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state providerschema.Bucket
+	var state providerschema.BucketWithTimeouts
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, bucketTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	if state.OrganizationId.IsNull() {
 		resp.Diagnostics.AddError(
 			"Error deleting bucket",
@@ -307,8 +330,8 @@ func (r *Bucket) Delete(ctx context.Context, req resource.DeleteRequest, resp *r
 
 // ImportState imports a remote cluster that is not created by Terraform.
 func (c *Bucket) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Names in the import ID are resolved to IDs before they are saved to the id attribute.
+	importStateByName(ctx, c.Data, req, resp, "Bucket", "bucket_id", "project_name", "cluster_name", "bucket_name")
 }
 
 // retrieveBucket retrieves bucket information for a specified organization, project, cluster and bucket ID.
@@ -363,13 +386,20 @@ func (c *Bucket) retrieveBucket(
 
 // Update updates the bucket.
 func (c *Bucket) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan providerschema.Bucket
+	var plan providerschema.BucketWithTimeouts
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, bucketTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	IDs, err := plan.Validate()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -419,6 +449,23 @@ func (c *Bucket) Update(ctx context.Context, req resource.UpdateRequest, resp *r
 		return
 	}
 
+	var state providerschema.BucketWithTimeouts
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isStorageBackendMigration(state.StorageBackend.ValueString(), plan.StorageBackend.ValueString()) {
+		if err := c.migrateStorageBackend(ctx, organizationId, projectId, clusterId, bucketId, plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error migrating bucket storage backend",
+				"Could not migrate storage backend of Capella bucket with ID "+bucketId+" to "+storageBackendMagma+": "+err.Error(),
+			)
+			return
+		}
+	}
+
 	currentState, err := c.retrieveBucket(ctx, organizationId, projectId, clusterId, bucketId)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -429,13 +476,78 @@ func (c *Bucket) Update(ctx context.Context, req resource.UpdateRequest, resp *r
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, currentState)
+	diags = resp.State.Set(ctx, providerschema.OneBucketWithTimeouts{OneBucket: *currentState, Timeouts: plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// migrateStorageBackend migrates the bucket from Couchstore to Magma and waits
+// until the migration is complete.
+func (c *Bucket) migrateStorageBackend(ctx context.Context, organizationId, projectId, clusterId, bucketId, bucketName string) error {
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		return err
+	}
+
+	migrateResp, err := c.ClientV2.PutBucketStorageBackendWithResponse(
+		ctx,
+		orgUUID,
+		projUUID,
+		clusterUUID,
+		apigen.UpdateBucketStorageBackendRequest{Buckets: []string{bucketName}},
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch migrateResp.StatusCode() {
+	case http.StatusAccepted, http.StatusNoContent, http.StatusOK:
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", migrateResp.StatusCode(), string(migrateResp.Body))
+	}
+
+	return c.waitForStorageBackendMigration(ctx, organizationId, projectId, clusterId, bucketId)
+}
+
+// waitForStorageBackendMigration polls the bucket until its storage backend is Magma, then
+// waits for the cluster to return to a final state. The bucket reports Magma as soon as the
+// migration is accepted, while the data is still being migrated, and the cluster rejects
+// further changes until the migration is complete.
+// The deadline comes from ctx, which Update bounds with the resource timeouts.
+func (c *Bucket) waitForStorageBackendMigration(ctx context.Context, organizationId, projectId, clusterId, bucketId string) error {
+	var (
+		bucket *providerschema.OneBucket
+		err    error
+	)
+
+	const sleep = time.Second * 10
+
+	timer := time.NewTimer(sleep)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("bucket storage backend migration timed out after initiation: %w", ctx.Err())
+		case <-timer.C:
+			bucket, err = c.retrieveBucket(ctx, organizationId, projectId, clusterId, bucketId)
+			switch err {
+			case nil:
+				if bucket.StorageBackend.ValueString() == storageBackendMagma {
+					cluster := &Cluster{Data: c.Data}
+					return cluster.checkClusterStatus(ctx, organizationId, projectId, clusterId)
+				}
+				const msg = "waiting for bucket storage backend migration to complete"
+				tflog.Info(ctx, msg)
+			default:
+				return err
+			}
+			timer.Reset(sleep)
+		}
+	}
+}
+
 // initializeBucketWithPlanAndId initializes an instance of providerschema.Bucket
 // with the specified plan and ID. It marks all computed fields as null.
 func initializeBucketWithPlanAndId(plan providerschema.Bucket, id string) providerschema.Bucket {
@@ -1,10 +1,13 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
@@ -12,6 +15,11 @@ import (
 
 var bucketBuilder = capellaschema.NewSchemaBuilder("bucket")
 
+const (
+	storageBackendCouchstore = "couchstore"
+	storageBackendMagma      = "magma"
+)
+
 func BucketSchema() schema.Schema {
 	attrs := make(map[string]schema.Attribute)
 
@@ -21,7 +29,22 @@ func BucketSchema() schema.Schema {
 	capellaschema.AddAttr(attrs, "project_id", bucketBuilder, requiredUUIDStringAttribute())
 	capellaschema.AddAttr(attrs, "cluster_id", bucketBuilder, requiredUUIDStringAttribute())
 	capellaschema.AddAttr(attrs, "type", bucketBuilder, stringAttribute([]string{computed, optional, requiresReplace, useStateForUnknown}))
-	capellaschema.AddAttr(attrs, "storage_backend", bucketBuilder, stringAttribute([]string{computed, optional, requiresReplace, useStateForUnknown}))
+
+	// Capella can migrate a bucket from Couchstore to Magma in place; every other change recreates the bucket.
+	// useStateForUnknown runs first so an unconfigured storage_backend is not taken as a change.
+	storageBackendAttr := stringAttribute([]string{computed, optional})
+	storageBackendAttr.PlanModifiers = []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+		stringplanmodifier.RequiresReplaceIf(
+			func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = !isStorageBackendMigration(req.StateValue.ValueString(), req.PlanValue.ValueString())
+			},
+			"Changing the storage backend recreates the bucket, except for a migration from couchstore to magma.",
+			"Changing the storage backend recreates the bucket, except for a migration from `couchstore` to `magma`.",
+		),
+	}
+	capellaschema.AddAttr(attrs, "storage_backend", bucketBuilder, storageBackendAttr)
+
 	// useStateForUnknown on the four attributes below: they go into PutBucketRequest, and
 	// without it an unconfigured one reaches Update as unknown and is sent as "" or 0.
 	capellaschema.AddAttr(attrs, "memory_allocation_in_mb", bucketBuilder, int64Attribute(optional, computed, useStateForUnknown))
@@ -57,5 +80,14 @@ func BucketSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "This resource allows you to manage the buckets for an operational cluster.",
 		Attributes:          attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
+
+// isStorageBackendMigration reports whether a change of storage backend is a
+// migration Capella performs in place.
+func isStorageBackendMigration(from, to string) bool {
+	return from == storageBackendCouchstore && to == storageBackendMagma
+}
@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestBucketSchema_StorageBackendReplacement verifies that only a migration from
+// couchstore to magma is planned as an in-place update of storage_backend.
+func TestBucketSchema_StorageBackendReplacement(t *testing.T) {
+	attr, ok := BucketSchema().Attributes["storage_backend"].(*schema.StringAttribute)
+	if !ok {
+		t.Fatalf("storage_backend is %T, want *StringAttribute", BucketSchema().Attributes["storage_backend"])
+	}
+
+	tests := []struct {
+		name            string
+		state           types.String
+		config          types.String
+		requiresReplace bool
+	}{
+		{name: "couchstore to magma migrates in place", state: types.StringValue("couchstore"), config: types.StringValue("magma")},
+		{name: "magma to couchstore recreates", state: types.StringValue("magma"), config: types.StringValue("couchstore"), requiresReplace: true},
+		{name: "unchanged does not recreate", state: types.StringValue("magma"), config: types.StringValue("magma")},
+		{name: "unconfigured keeps the prior value", state: types.StringValue("couchstore"), config: types.StringNull()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Non-null raw plan and state mark this as an update rather than a create or destroy.
+			raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+			planValue := test.config
+			if test.config.IsNull() {
+				planValue = types.StringUnknown()
+			}
+
+			resp := &planmodifier.StringResponse{PlanValue: planValue}
+			for _, modifier := range attr.PlanModifiers {
+				req := planmodifier.StringRequest{
+					StateValue:  test.state,
+					ConfigValue: test.config,
+					PlanValue:   resp.PlanValue,
+					Plan:        tfsdk.Plan{Raw: raw},
+					State:       tfsdk.State{Raw: raw},
+				}
+				modifier.PlanModifyString(context.Background(), req, resp)
+			}
+
+			if resp.RequiresReplace != test.requiresReplace {
+				t.Errorf("RequiresReplace = %t, want %t", resp.RequiresReplace, test.requiresReplace)
+			}
+			if test.config.IsNull() && !resp.PlanValue.Equal(test.state) {
+				t.Errorf("PlanValue = %s, want prior state %s", resp.PlanValue, test.state)
+			}
+		})
+	}
+}
@@ -33,6 +33,10 @@ const errorMessageAfterClusterCreationInitiation = "Cluster creation is initiate
 	" current status of the cluster. Additionally, run `terraform apply --refresh-only` to update" +
 	" the state from remote, unexpected error: "
 
+// clusterTimeout is how long a cluster may take to be created, updated or deleted
+// when the timeouts block does not set a value.
+const clusterTimeout = 60 * time.Minute
+
 const errorMessageWhileClusterCreation = "There is an error during cluster creation. Please check in Capella to see if any hanging resources" +
 	" have been created, unexpected error: "
 
@@ -58,7 +62,7 @@ func (c *Cluster) Schema(_ context.Context, _ resource.SchemaRequest, resp *reso
 
 // Create creates a new Cluster.
 func (c *Cluster) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan providerschema.Cluster
+	var plan providerschema.ClusterWithTimeouts
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 
@@ -66,7 +70,14 @@ func (c *Cluster) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	if err := c.validateCreateCluster(plan); err != nil {
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, clusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	if err := c.validateCreateCluster(plan.Cluster); err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating cluster",
 			"Could not create cluster, unexpected error: "+err.Error(),
@@ -139,7 +150,7 @@ func (c *Cluster) Create(ctx context.Context, req resource.CreateRequest, resp *
 
 	//check disk values provided for Azure, if Premium type disks, then do not allow setting storage, iops.
 	if plan.CloudProvider.Type.ValueString() == string(clusterapi.Azure) {
-		err := c.checkDisk(plan)
+		err := c.checkDisk(plan.Cluster)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating cluster",
@@ -149,7 +160,7 @@ func (c *Cluster) Create(ctx context.Context, req resource.CreateRequest, resp *
 		}
 	}
 
-	serviceGroups, err := c.morphToApiServiceGroups(plan)
+	serviceGroups, err := c.morphToApiServiceGroups(plan.Cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating cluster",
@@ -205,7 +216,10 @@ func (c *Cluster) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	diags = resp.State.Set(ctx, initializePendingClusterWithPlanAndId(plan, clusterResponse.Id.String()))
+	diags = resp.State.Set(ctx, providerschema.ClusterWithTimeouts{
+		Cluster:  initializePendingClusterWithPlanAndId(plan.Cluster, clusterResponse.Id.String()),
+		Timeouts: plan.Timeouts,
+	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -240,7 +254,7 @@ func (c *Cluster) Create(ctx context.Context, req resource.CreateRequest, resp *
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, refreshedState)
+	diags = resp.State.Set(ctx, providerschema.ClusterWithTimeouts{Cluster: *refreshedState, Timeouts: plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -269,7 +283,7 @@ func (c *Cluster) Configure(_ context.Context, req resource.ConfigureRequest, re
 
 // Read reads the cluster information.
 func (c *Cluster) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state providerschema.Cluster
+	var state providerschema.ClusterWithTimeouts
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -324,7 +338,7 @@ func (c *Cluster) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	}
 
 	// Set refreshed state
-	diags = resp.State.Set(ctx, &refreshedState)
+	diags = resp.State.Set(ctx, providerschema.ClusterWithTimeouts{Cluster: *refreshedState, Timeouts: state.Timeouts})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -334,7 +348,7 @@ func (c *Cluster) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 // Update updates the Cluster.
 func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan, state providerschema.Cluster
+	var plan, state providerschema.ClusterWithTimeouts
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 
@@ -345,6 +359,13 @@ func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, clusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resourceIDs, err := plan.Validate()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -360,7 +381,7 @@ func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		clusterId      = resourceIDs[providerschema.Id]
 	)
 
-	if err := c.validateClusterUpdate(plan, state); err != nil {
+	if err := c.validateClusterUpdate(plan.Cluster, state.Cluster); err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating cluster",
 			"Could not update cluster id "+state.Id.String()+" unexpected error: "+err.Error(),
@@ -391,7 +412,7 @@ func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *
 	//Check disk values provided for Azure, if Premium type disks, then do not allow setting storage, iops.
 	//And if the values in plan are set as default values, then ignore as that is correct configuration.
 	if plan.CloudProvider.Type.ValueString() == string(clusterapi.Azure) {
-		err := c.checkDiskUpdate(plan)
+		err := c.checkDiskUpdate(plan.Cluster)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating cluster",
@@ -401,7 +422,7 @@ func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		}
 	}
 
-	serviceGroups, err := c.morphToApiServiceGroups(plan)
+	serviceGroups, err := c.morphToApiServiceGroups(plan.Cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating cluster",
@@ -474,7 +495,7 @@ func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, currentState)
+	diags = resp.State.Set(ctx, providerschema.ClusterWithTimeouts{Cluster: *currentState, Timeouts: plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -484,7 +505,7 @@ func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *
 // Delete deletes the cluster.
 func (r *Cluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state providerschema.Cluster
+	var state providerschema.ClusterWithTimeouts
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -499,6 +520,13 @@ func (r *Cluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, clusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resourceIDs, err := state.Validate()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -579,8 +607,8 @@ func (r *Cluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 func (c *Cluster) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Names in the import ID are resolved to IDs before they are saved to the id attribute.
+	importStateByName(ctx, c.Data, req, resp, "Cluster", "cluster_id", "project_name", "cluster_name")
 }
 
 // getCluster retrieves cluster information from the specified organization and project
@@ -635,21 +663,15 @@ func (c *Cluster) retrieveCluster(
 
 // checkClusterStatus monitors the status of a cluster creation, update and deletion operation for a specified
 // organization, project, and cluster ID. It periodically fetches the cluster status using the `getCluster`
-// function and waits until the cluster reaches a final state or until a specified timeout is reached.
-// The function returns an error if the operation times out or encounters an error during status retrieval.
+// function and waits until the cluster reaches a final state, returning an error if the status cannot be retrieved.
+// It has no timeout of its own: callers must give ctx a deadline, such as with withTimeout, or the wait
+// never ends for a cluster that is stuck. It returns the context error once ctx is done.
 func (c *Cluster) checkClusterStatus(ctx context.Context, organizationId, projectId, ClusterId string) error {
 	var (
 		clusterResp *clusterapi.GetClusterResponse
 		err         error
 	)
 
-	// Assuming 60 minutes is the max time deployment takes, can change after discussion
-	const timeout = time.Minute * 60
-
-	var cancel context.CancelFunc
-	ctx, cancel = context.WithTimeout(ctx, timeout)
-	defer cancel()
-
 	const sleep = time.Second * 3
 
 	timer := time.NewTimer(2 * time.Minute)
@@ -657,7 +679,7 @@ func (c *Cluster) checkClusterStatus(ctx context.Context, organizationId, projec
 	for {
 		select {
 		case <-ctx.Done():
-			return fmt.Errorf("cluster creation status transition timed out after initiation, unexpected error: %w", err)
+			return fmt.Errorf("cluster status transition timed out after initiation: %w", ctx.Err())
 		case <-timer.C:
 			clusterResp, err = c.getCluster(ctx, organizationId, projectId, ClusterId)
 			switch err {
@@ -0,0 +1,372 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ClusterClone{}
+	_ resource.ResourceWithConfigure   = &ClusterClone{}
+	_ resource.ResourceWithImportState = &ClusterClone{}
+)
+
+// ClusterClone is the cluster clone resource implementation.
+type ClusterClone struct {
+	*providerschema.Data
+}
+
+// NewClusterClone is a helper function to simplify the provider implementation.
+func NewClusterClone() resource.Resource {
+	return &ClusterClone{}
+}
+
+// Metadata returns the cluster clone resource type name.
+func (c *ClusterClone) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_clone"
+}
+
+// Schema defines the schema for the cluster clone resource.
+func (c *ClusterClone) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ClusterCloneSchema()
+}
+
+// Configure adds the provider configured client to the cluster clone resource.
+func (c *ClusterClone) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	c.Data = data
+}
+
+// Create clones a new cluster from the source cloud snapshot backup and waits for it to be deployed.
+func (c *ClusterClone) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.ClusterClone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId   = plan.OrganizationId.ValueString()
+		projectId        = plan.ProjectId.ValueString()
+		sourceSnapshotId = plan.SourceSnapshotId.ValueString()
+	)
+
+	cloneRequest := apigen.CreateCloudSnapshotCloneRequest{
+		Name: plan.Name.ValueString(),
+		Availability: apigen.Availability{
+			Type: apigen.AvailabilityType(plan.Availability.Type.ValueString()),
+		},
+		CloudProvider: apigen.CloudProvider{
+			Region: plan.CloudProvider.Region.ValueString(),
+			Type:   apigen.CloudProviderType(plan.CloudProvider.Type.ValueString()),
+		},
+		Support: apigen.Support{
+			Plan: apigen.SupportPlan(plan.Support.Plan.ValueString()),
+		},
+	}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		cloneRequest.Description = plan.Description.ValueStringPointer()
+	}
+	if !plan.CloudProvider.Cidr.IsNull() && !plan.CloudProvider.Cidr.IsUnknown() {
+		cloneRequest.CloudProvider.Cidr = plan.CloudProvider.Cidr.ValueStringPointer()
+	}
+	if !plan.Support.Timezone.IsNull() && !plan.Support.Timezone.IsUnknown() {
+		timezone := apigen.SupportTimezone(plan.Support.Timezone.ValueString())
+		cloneRequest.Support.Timezone = &timezone
+	}
+	if !plan.Zones.IsNull() && !plan.Zones.IsUnknown() {
+		var zones []string
+		diags = plan.Zones.ElementsAs(ctx, &zones, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		cloneRequest.Zones = &zones
+	}
+
+	orgUUID, projUUID, snapshotUUID, err := parseClusterCloneUUIDs(organizationId, projectId, sourceSnapshotId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	cloneResp, err := c.ClientV2.CloneWithResponse(ctx, orgUUID, projUUID, snapshotUUID, cloneRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error cloning cluster",
+			errorMessageWhileClusterCreation+err.Error(),
+		)
+		return
+	}
+
+	if cloneResp.JSON202 == nil || cloneResp.JSON202.ClusterId == nil {
+		resp.Diagnostics.AddError(
+			"Error cloning cluster",
+			errorMessageWhileClusterCreation+fmt.Sprintf("unexpected response status %d: %s", cloneResp.StatusCode(), string(cloneResp.Body)),
+		)
+		return
+	}
+
+	clusterId := *cloneResp.JSON202.ClusterId
+
+	plan.Id = types.StringValue(clusterId)
+	plan.RestoreId = types.StringPointerValue(cloneResp.JSON202.RestoreId)
+	diags = resp.State.Set(ctx, initializePendingClusterCloneWithPlan(plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The clone has no timeouts block, so the wait is bounded by the default cluster timeout.
+	waitCtx, cancel := context.WithTimeout(ctx, clusterTimeout)
+	defer cancel()
+
+	cluster := &Cluster{Data: c.Data}
+	err = cluster.checkClusterStatus(waitCtx, organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error cloning cluster",
+			errorMessageAfterClusterCreationInitiation+api.ParseError(err),
+		)
+		return
+	}
+
+	refreshedState, err := c.retrieveClusterClone(ctx, organizationId, projectId, clusterId, plan.SourceSnapshotId, plan.RestoreId, plan.Zones)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error cloning cluster",
+			errorMessageAfterClusterCreationInitiation+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the cloned cluster.
+func (c *ClusterClone) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.ClusterClone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Cluster Clone",
+			"Could not read cluster clone "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId   = IDs[providerschema.OrganizationId]
+		projectId        = IDs[providerschema.ProjectId]
+		clusterId        = IDs[providerschema.Id]
+		sourceSnapshotId = IDs[providerschema.SourceSnapshotId]
+	)
+
+	refreshedState, err := c.retrieveClusterClone(
+		ctx,
+		organizationId,
+		projectId,
+		clusterId,
+		types.StringValue(sourceSnapshotId),
+		state.RestoreId,
+		state.Zones,
+	)
+	if err != nil {
+		resourceNotFound, errString := api.CheckResourceNotFoundError(err)
+		if resourceNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Capella Cluster Clone",
+			"Could not read cluster clone "+clusterId+": "+errString,
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is not supported as there is no update API.
+func (c *ClusterClone) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so Update is never called.
+}
+
+// Delete deletes the cloned cluster and waits for the deletion to complete.
+func (c *ClusterClone) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.ClusterClone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting cluster clone",
+			"Could not delete cluster clone "+state.Id.String()+" unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.Id]
+	)
+
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters/%s", c.HostURL, organizationId, projectId, clusterId)
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodDelete, SuccessStatus: http.StatusAccepted}
+	_, err = c.ClientV1.ExecuteWithRetry(
+		ctx,
+		cfg,
+		nil,
+		c.Token,
+		nil,
+	)
+	if err != nil {
+		resourceNotFound, errString := api.CheckResourceNotFoundError(err)
+		if resourceNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Capella Cluster Clone",
+			"Could not delete cluster clone "+clusterId+": "+errString,
+		)
+		return
+	}
+
+	// The clone has no timeouts block, so the wait is bounded by the default cluster timeout.
+	waitCtx, cancel := context.WithTimeout(ctx, clusterTimeout)
+	defer cancel()
+
+	cluster := &Cluster{Data: c.Data}
+	err = cluster.checkClusterStatus(waitCtx, organizationId, projectId, clusterId)
+	if err != nil {
+		resourceNotFound, errString := api.CheckResourceNotFoundError(err)
+		if !resourceNotFound {
+			resp.Diagnostics.AddError(
+				"Error Deleting Capella Cluster Clone",
+				"Could not delete cluster clone "+clusterId+": "+errString,
+			)
+		}
+		// resourceNotFound as expected
+		return
+	}
+
+	// The cluster reached a final state without being removed, so the deletion has failed.
+	clusterResp, err := cluster.getCluster(ctx, organizationId, projectId, clusterId)
+	if err != nil {
+		resourceNotFound, errString := api.CheckResourceNotFoundError(err)
+		if !resourceNotFound {
+			resp.Diagnostics.AddError(
+				"Error Deleting Capella Cluster Clone",
+				"Could not delete cluster clone "+clusterId+": "+errString,
+			)
+		}
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Error deleting cluster clone",
+		fmt.Sprintf("Could not delete cluster clone %s, as current Cluster state: %s", clusterId, clusterResp.CurrentState),
+	)
+}
+
+// ImportState imports a cloned cluster to be managed by terraform.
+// The import ID must include the source_snapshot_id, as Capella does not return it for a cluster.
+func (c *ClusterClone) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// retrieveClusterClone retrieves the cloned cluster and converts it into Terraform state.
+func (c *ClusterClone) retrieveClusterClone(
+	ctx context.Context,
+	organizationId, projectId, clusterId string,
+	sourceSnapshotId, restoreId types.String,
+	zones types.Set,
+) (*providerschema.ClusterClone, error) {
+	cluster := &Cluster{Data: c.Data}
+	clusterResp, err := cluster.getCluster(ctx, organizationId, projectId, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	audit := providerschema.NewCouchbaseAuditData(clusterResp.Audit)
+	auditObj, diags := types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+	if diags.HasError() {
+		return nil, errors.ErrUnableToConvertAuditData
+	}
+
+	return providerschema.NewClusterClone(clusterResp, organizationId, projectId, sourceSnapshotId, restoreId, zones, auditObj), nil
+}
+
+// parseClusterCloneUUIDs parses the organization, project and source snapshot IDs into UUIDs for the generated API client.
+func parseClusterCloneUUIDs(organizationId, projectId, sourceSnapshotId string) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "source_snapshot_id", Value: sourceSnapshotId},
+	)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids[0], uuids[1], uuids[2], nil
+}
+
+// initializePendingClusterCloneWithPlan marks the computed attributes of the planned cluster clone as null,
+// so the clone is tracked in state while its deployment is in progress.
+func initializePendingClusterCloneWithPlan(plan providerschema.ClusterClone) providerschema.ClusterClone {
+	if plan.Description.IsUnknown() {
+		plan.Description = types.StringNull()
+	}
+	if plan.CloudProvider.Cidr.IsUnknown() {
+		plan.CloudProvider.Cidr = types.StringNull()
+	}
+	if plan.Support.Timezone.IsUnknown() {
+		plan.Support.Timezone = types.StringNull()
+	}
+	plan.CurrentState = types.StringNull()
+	plan.ConnectionString = types.StringNull()
+	plan.Audit = types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	return plan
+}
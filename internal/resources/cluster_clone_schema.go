@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var clusterCloneBuilder = capellaschema.NewSchemaBuilder("clusterClone", "CreateCloudSnapshotCloneRequest")
+
+// ClusterCloneSchema returns the schema for the cluster_clone resource.
+func ClusterCloneSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", clusterCloneBuilder, stringAttribute([]string{computed, useStateForUnknown}))
+	capellaschema.AddAttr(attrs, "organization_id", clusterCloneBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", clusterCloneBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "source_snapshot_id", clusterCloneBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "restore_id", clusterCloneBuilder, stringAttribute([]string{computed, useStateForUnknown}), "CreateCloudSnapshotCloneResponse")
+	capellaschema.AddAttr(attrs, "name", clusterCloneBuilder, stringAttribute([]string{required, requiresReplace}))
+	capellaschema.AddAttr(attrs, "description", clusterCloneBuilder, stringAttribute([]string{optional, computed, useStateForUnknown, requiresReplaceIfConfigured}))
+	capellaschema.AddAttr(attrs, "zones", clusterCloneBuilder, stringSetAttribute(optional, requiresReplace))
+
+	cloudProviderAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(cloudProviderAttrs, "type", clusterCloneBuilder, stringAttribute([]string{required}), "CloudProvider")
+	capellaschema.AddAttr(cloudProviderAttrs, "region", clusterCloneBuilder, stringAttribute([]string{required}), "CloudProvider")
+	capellaschema.AddAttr(cloudProviderAttrs, "cidr", clusterCloneBuilder, stringAttribute([]string{optional, computed, useStateForUnknown}), "CloudProvider")
+
+	capellaschema.AddAttr(attrs, "cloud_provider", clusterCloneBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: cloudProviderAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	})
+
+	availabilityAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(availabilityAttrs, "type", clusterCloneBuilder, stringAttribute([]string{required}), "Availability")
+
+	capellaschema.AddAttr(attrs, "availability", clusterCloneBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: availabilityAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	})
+
+	supportAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(supportAttrs, "plan", clusterCloneBuilder, stringAttribute([]string{required}), "Support")
+	capellaschema.AddAttr(supportAttrs, "timezone", clusterCloneBuilder, stringAttribute([]string{optional, computed, useStateForUnknown}), "Support")
+
+	capellaschema.AddAttr(attrs, "support", clusterCloneBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: supportAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	})
+
+	capellaschema.AddAttr(attrs, "current_state", clusterCloneBuilder, stringAttribute([]string{computed}), "GetClusterResponse")
+	capellaschema.AddAttr(attrs, "connection_string", clusterCloneBuilder, stringAttribute([]string{computed}), "GetClusterResponse")
+	capellaschema.AddAttr(attrs, "audit", clusterCloneBuilder, computedAuditAttribute())
+
+	return schema.Schema{
+		MarkdownDescription: "Creates a new operational cluster from a cloud snapshot backup of another cluster. " +
+			"The cloned cluster records the ID of the snapshot it was created from. " +
+			"Changing any configurable attribute clones a new cluster, and destroying the resource deletes the cloned cluster.",
+		Attributes: attrs,
+	}
+}
@@ -68,6 +68,12 @@ func (c *ClusterOnOffSchedule) Schema(_ context.Context, _ resource.SchemaReques
 // days require a from time boundary, non-custom days cannot have time
 // boundaries, and from must not be later than to.
 func (c *ClusterOnOffSchedule) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateOnOffScheduleDays(ctx, req, resp)
+}
+
+// validateOnOffScheduleDays validates the days list of an on/off schedule config.
+// It is shared by the cluster and analytics cluster on/off schedule resources.
+func validateOnOffScheduleDays(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var days types.List
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("days"), &days)...)
 	if resp.Diagnostics.HasError() || days.IsNull() || days.IsUnknown() {
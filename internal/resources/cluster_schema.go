@@ -125,5 +125,8 @@ func ClusterSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Manages the operational cluster resource.",
 		Attributes:          attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
@@ -0,0 +1,409 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &Cmek{}
+	_ resource.ResourceWithConfigure   = &Cmek{}
+	_ resource.ResourceWithImportState = &Cmek{}
+)
+
+// Cmek is the customer-managed encryption key metadata resource implementation.
+type Cmek struct {
+	*providerschema.Data
+}
+
+// NewCmek is a helper function to simplify the provider implementation.
+func NewCmek() resource.Resource {
+	return &Cmek{}
+}
+
+// Metadata returns the cmek resource type name.
+func (c *Cmek) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cmek"
+}
+
+// Schema defines the schema for the cmek resource.
+func (c *Cmek) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = CmekSchema()
+}
+
+// Configure adds the provider configured client to the cmek resource.
+func (c *Cmek) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.Data = data
+}
+
+// ImportState imports a remote key metadata that is not created by Terraform.
+func (c *Cmek) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create registers the key metadata with Capella.
+func (c *Cmek) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.Cmek
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Rotate.IsNull() && !plan.Rotate.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotate"),
+			"Error creating CMEK",
+			"rotate value should not be set during create.",
+		)
+		return
+	}
+
+	organizationId := plan.OrganizationId.ValueString()
+	orgUUID, err := uuid.Parse(organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse organization_id: "+err.Error())
+		return
+	}
+
+	createReq := apigen.CreateCMEKMetadata{
+		Name: plan.Name.ValueString(),
+	}
+	if description := plan.Description.ValueString(); description != "" {
+		createReq.Description = &description
+	}
+	if err := setCmekConfig(&createReq.Config, plan.Config); err != nil {
+		resp.Diagnostics.AddError("Error creating CMEK", "Could not build key config: "+err.Error())
+		return
+	}
+
+	createResp, err := c.ClientV2.PostCMEKMetadataWithResponse(ctx, orgUUID, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating CMEK",
+			"Could not create CMEK, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error creating CMEK",
+			fmt.Sprintf("Could not create CMEK, unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	cmekId := createResp.JSON200.Id.String()
+	refreshedState, err := c.retrieveCmek(ctx, organizationId, cmekId, types.NumberNull(), plan.Config)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error reading CMEK",
+			"Could not read CMEK with ID "+cmekId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the key metadata.
+func (c *Cmek) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.Cmek
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading CMEK in Capella",
+			"Could not read Capella CMEK with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		cmekId         = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := c.retrieveCmek(ctx, organizationId, cmekId, state.Rotate, state.Config)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading CMEK in Capella",
+			"Could not read Capella CMEK with ID "+cmekId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update rotates the key to the key identified by config.
+func (c *Cmek) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.Cmek
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating CMEK",
+			"Could not rotate CMEK with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		cmekId         = IDs[providerschema.Id]
+	)
+
+	if plan.Rotate.IsNull() || plan.Rotate.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Error rotating CMEK",
+			"Could not rotate CMEK with ID "+cmekId+": the key config can only be changed by rotating the key, set rotate to rotate it",
+		)
+		return
+	}
+
+	if !state.Rotate.IsNull() && !state.Rotate.IsUnknown() {
+		planRotate := *plan.Rotate.ValueBigFloat()
+		stateRotate := *state.Rotate.ValueBigFloat()
+		if planRotate.Cmp(&stateRotate) != 1 {
+			resp.Diagnostics.AddError(
+				"Error rotating CMEK",
+				"Could not rotate CMEK with ID "+cmekId+": plan rotate value is not greater than state rotate value",
+			)
+			return
+		}
+	}
+
+	orgUUID, err := uuid.Parse(organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse organization_id: "+err.Error())
+		return
+	}
+	cmekUUID, err := uuid.Parse(cmekId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse CMEK ID: "+err.Error())
+		return
+	}
+
+	var rotateReq apigen.RotateCMEKKey
+	if err := setCmekConfig(&rotateReq.Config, plan.Config); err != nil {
+		resp.Diagnostics.AddError("Error rotating CMEK", "Could not build key config: "+err.Error())
+		return
+	}
+
+	rotateResp, err := c.ClientV2.RotateCMEKKeyWithResponse(ctx, orgUUID, cmekUUID, rotateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating CMEK",
+			"Could not rotate CMEK with ID "+cmekId+": "+err.Error(),
+		)
+		return
+	}
+	switch rotateResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	default:
+		resp.Diagnostics.AddError(
+			"Error rotating CMEK",
+			fmt.Sprintf("Could not rotate CMEK with ID %s, unexpected response status %d: %s", cmekId, rotateResp.StatusCode(), string(rotateResp.Body)),
+		)
+		return
+	}
+
+	refreshedState, err := c.retrieveCmek(ctx, organizationId, cmekId, plan.Rotate, plan.Config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating CMEK",
+			"Could not read CMEK with ID "+cmekId+" after rotation: "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the key metadata from Capella.
+func (c *Cmek) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.Cmek
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting CMEK in Capella",
+			"Could not delete Capella CMEK with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		cmekId         = IDs[providerschema.Id]
+	)
+
+	orgUUID, err := uuid.Parse(organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse organization_id: "+err.Error())
+		return
+	}
+	cmekUUID, err := uuid.Parse(cmekId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse CMEK ID: "+err.Error())
+		return
+	}
+
+	deleteResp, err := c.ClientV2.DeleteKeyMetadataWithResponse(ctx, orgUUID, cmekUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting CMEK in Capella",
+			"Could not delete Capella CMEK with ID "+cmekId+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting CMEK in Capella",
+			fmt.Sprintf("Could not delete Capella CMEK with ID %s, unexpected response status %d: %s", cmekId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// retrieveCmek retrieves the key metadata and converts it into Terraform state.
+// errors.ErrNotFound is returned when the key metadata does not exist.
+func (c *Cmek) retrieveCmek(
+	ctx context.Context,
+	organizationId, cmekId string,
+	rotate types.Number,
+	priorConfig *providerschema.CmekConfig,
+) (*providerschema.Cmek, error) {
+	orgUUID, err := uuid.Parse(organizationId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid organization_id: %w", err)
+	}
+	cmekUUID, err := uuid.Parse(cmekId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CMEK ID: %w", err)
+	}
+
+	getResp, err := c.ClientV2.GetKeyMetadataWithResponse(ctx, orgUUID, cmekUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	metadata := getResp.JSON200
+
+	rawConfig, err := metadata.Config.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	config, err := providerschema.NewCmekConfig(rawConfig)
+	if err != nil {
+		// Keep the configured value when Capella reports a config shape this
+		// provider does not know about, rather than failing every refresh.
+		tflog.Warn(ctx, "could not read CMEK config", map[string]interface{}{"error": err.Error()})
+		config = priorConfig
+	}
+
+	audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(metadata.Audit))
+	auditObj, diags := types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+	if diags.HasError() {
+		return nil, errors.ErrUnableToConvertAuditData
+	}
+
+	return providerschema.NewCmek(
+		metadata.Id.String(),
+		organizationId,
+		metadata.Name,
+		metadata.Description,
+		config,
+		rotate,
+		auditObj,
+	), nil
+}
+
+// cmekConfigUnion is implemented by the generated oneOf config types of the create and rotate requests.
+type cmekConfigUnion interface {
+	FromAWSConfig(apigen.AWSConfig) error
+	FromGCPConfig(apigen.GCPConfig) error
+	FromAzureConfig(apigen.AzureConfig) error
+}
+
+// setCmekConfig copies the configured cloud provider key into a generated request config.
+func setCmekConfig(union cmekConfigUnion, config *providerschema.CmekConfig) error {
+	switch {
+	case config == nil:
+		return fmt.Errorf("config must be set")
+	case config.AWSConfig != nil:
+		return union.FromAWSConfig(apigen.AWSConfig{Arn: config.AWSConfig.Arn.ValueString()})
+	case config.GCPConfig != nil:
+		return union.FromGCPConfig(apigen.GCPConfig{ResourceName: config.GCPConfig.ResourceName.ValueString()})
+	case config.AzureConfig != nil:
+		return union.FromAzureConfig(apigen.AzureConfig{
+			KeyLocation: config.AzureConfig.KeyLocation.ValueString(),
+			Region:      config.AzureConfig.Region.ValueString(),
+		})
+	default:
+		return fmt.Errorf("one of aws_config, gcp_config or azure_config must be set")
+	}
+}
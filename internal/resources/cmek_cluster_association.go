@@ -0,0 +1,239 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &CmekClusterAssociation{}
+	_ resource.ResourceWithConfigure   = &CmekClusterAssociation{}
+	_ resource.ResourceWithImportState = &CmekClusterAssociation{}
+)
+
+// CmekClusterAssociation is the resource implementation for associating a CMEK with a cluster.
+type CmekClusterAssociation struct {
+	*providerschema.Data
+}
+
+// NewCmekClusterAssociation is a helper function to simplify the provider implementation.
+func NewCmekClusterAssociation() resource.Resource {
+	return &CmekClusterAssociation{}
+}
+
+// Metadata returns the cmek_cluster_association resource type name.
+func (c *CmekClusterAssociation) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cmek_cluster_association"
+}
+
+// Schema defines the schema for the cmek_cluster_association resource.
+func (c *CmekClusterAssociation) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = CmekClusterAssociationSchema()
+}
+
+// Configure adds the provider configured client to the cmek_cluster_association resource.
+func (c *CmekClusterAssociation) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.Data = data
+}
+
+// ImportState imports an existing association. The import ID has the form
+// "cluster_id=<id>,cmek_id=<id>,project_id=<id>,organization_id=<id>".
+func (c *CmekClusterAssociation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+}
+
+// Create associates the key with the cluster.
+func (c *CmekClusterAssociation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.CmekClusterAssociation
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgUUID, projUUID, clusterUUID, cmekUUID, err := parseCmekAssociationUUIDs(plan.OrganizationId.ValueString(), plan.ProjectId.ValueString(), plan.ClusterId.ValueString(), plan.CmekId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	associateResp, err := c.ClientV2.AssociateCMEKWithResponse(ctx, orgUUID, projUUID, clusterUUID, cmekUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error associating CMEK with cluster",
+			"Could not associate CMEK "+plan.CmekId.ValueString()+" with cluster "+plan.ClusterId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	switch associateResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	default:
+		resp.Diagnostics.AddError(
+			"Error associating CMEK with cluster",
+			fmt.Sprintf("Could not associate CMEK %s with cluster %s, unexpected response status %d: %s",
+				plan.CmekId.ValueString(), plan.ClusterId.ValueString(), associateResp.StatusCode(), string(associateResp.Body)),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read checks that the key is still associated with the cluster.
+func (c *CmekClusterAssociation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.CmekClusterAssociation
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading CMEK association in Capella",
+			"Could not read CMEK association of cluster "+state.ClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		cmekId         = IDs[providerschema.CmekId]
+	)
+
+	orgUUID, projUUID, clusterUUID, _, err := parseCmekAssociationUUIDs(organizationId, projectId, clusterId, cmekId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	clusterResp, err := c.ClientV2.GetClusterWithResponse(ctx, orgUUID, projUUID, clusterUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading CMEK association in Capella",
+			fmt.Sprintf("Could not read cluster %s: %s: %s", clusterId, errors.ErrExecutingRequest, err),
+		)
+		return
+	}
+
+	switch {
+	case clusterResp.StatusCode() == http.StatusNotFound:
+		tflog.Info(ctx, "cluster doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	case clusterResp.JSON200 == nil:
+		resp.Diagnostics.AddError(
+			"Error Reading CMEK association in Capella",
+			fmt.Sprintf("Could not read cluster %s, unexpected response status %d: %s", clusterId, clusterResp.StatusCode(), string(clusterResp.Body)),
+		)
+		return
+	}
+
+	if clusterResp.JSON200.CmekId == nil || *clusterResp.JSON200.CmekId != cmekId {
+		tflog.Info(ctx, "CMEK is no longer associated with the cluster, removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.OrganizationId = types.StringValue(organizationId)
+	state.ProjectId = types.StringValue(projectId)
+	state.ClusterId = types.StringValue(clusterId)
+	state.CmekId = types.StringValue(cmekId)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is empty because an association cannot be changed in place. Every attribute
+// is marked RequiresReplace, so the framework recreates the resource instead.
+func (c *CmekClusterAssociation) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+// Delete unassociates the key from the cluster.
+func (c *CmekClusterAssociation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.CmekClusterAssociation
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error unassociating CMEK from cluster",
+			"Could not unassociate CMEK from cluster "+state.ClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	orgUUID, projUUID, clusterUUID, cmekUUID, err := parseCmekAssociationUUIDs(
+		IDs[providerschema.OrganizationId], IDs[providerschema.ProjectId], IDs[providerschema.ClusterId], IDs[providerschema.CmekId],
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	unassociateResp, err := c.ClientV2.UnassociateCMEKWithResponse(ctx, orgUUID, projUUID, clusterUUID, cmekUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error unassociating CMEK from cluster",
+			"Could not unassociate CMEK from cluster "+IDs[providerschema.ClusterId]+": "+err.Error(),
+		)
+		return
+	}
+
+	switch unassociateResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error unassociating CMEK from cluster",
+			fmt.Sprintf("Could not unassociate CMEK from cluster %s, unexpected response status %d: %s",
+				IDs[providerschema.ClusterId], unassociateResp.StatusCode(), string(unassociateResp.Body)),
+		)
+	}
+}
+
+// parseCmekAssociationUUIDs parses the IDs of a CMEK cluster association into UUIDs for the generated API client.
+func parseCmekAssociationUUIDs(organizationId, projectId, clusterId, cmekId string) (uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, err
+	}
+	cmekUUID, err := uuid.Parse(cmekId)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, fmt.Errorf("invalid cmek_id: %w", err)
+	}
+	return orgUUID, projUUID, clusterUUID, cmekUUID, nil
+}
@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var cmekClusterAssociationBuilder = capellaschema.NewSchemaBuilder("cmekClusterAssociation")
+
+// CmekClusterAssociationSchema returns the schema for the cmek_cluster_association resource.
+func CmekClusterAssociationSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", cmekClusterAssociationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", cmekClusterAssociationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", cmekClusterAssociationBuilder, requiredUUIDStringAttribute())
+
+	cmekId := requiredUUIDStringAttribute()
+	cmekId.MarkdownDescription = "The ID of the customer-managed encryption key metadata to associate with the cluster."
+	capellaschema.AddAttr(attrs, "cmek_id", cmekClusterAssociationBuilder, cmekId)
+
+	return schema.Schema{
+		MarkdownDescription: "This resource associates a customer-managed encryption key (CMEK) with a cluster. Destroying the resource unassociates the key from the cluster.",
+		Attributes:          attrs,
+	}
+}
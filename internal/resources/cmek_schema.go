@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var cmekBuilder = capellaschema.NewSchemaBuilder("cmek", "CreateCMEKMetadata")
+
+// CmekSchema returns the schema for the cmek resource.
+func CmekSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", cmekBuilder, stringAttribute([]string{computed, useStateForUnknown}), "CreateCMEKMetadataResponse")
+	capellaschema.AddAttr(attrs, "organization_id", cmekBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "name", cmekBuilder, requiredNonEmptyStringAttribute())
+	capellaschema.AddAttr(attrs, "description", cmekBuilder, stringDefaultAttribute("", optional, computed, requiresReplace, useStateForUnknown))
+
+	awsConfigAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(awsConfigAttrs, "arn", cmekBuilder, requiredStringAttributeNoReplace(), "AWSConfig")
+
+	gcpConfigAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(gcpConfigAttrs, "resource_name", cmekBuilder, requiredStringAttributeNoReplace(), "GCPConfig")
+
+	azureConfigAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(azureConfigAttrs, "key_location", cmekBuilder, requiredStringAttributeNoReplace(), "AzureConfig")
+	capellaschema.AddAttr(azureConfigAttrs, "region", cmekBuilder, requiredStringAttributeNoReplace(), "AzureConfig")
+
+	configAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(configAttrs, "aws_config", cmekBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: awsConfigAttrs,
+	})
+	capellaschema.AddAttr(configAttrs, "gcp_config", cmekBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: gcpConfigAttrs,
+	})
+	capellaschema.AddAttr(configAttrs, "azure_config", cmekBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: azureConfigAttrs,
+	})
+
+	// Changing the key config is only applied through a rotation, so it is not
+	// marked RequiresReplace. Update rejects a config change without a rotate bump.
+	capellaschema.AddAttr(attrs, "config", cmekBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: configAttrs,
+	})
+
+	capellaschema.AddAttr(attrs, "audit", cmekBuilder, computedAuditAttribute())
+
+	// Rotate field - Terraform-specific field to trigger key rotation, as for the API key resource.
+	// It does not exist in the OpenAPI spec, so the description is set here.
+	attrs["rotate"] = &schema.NumberAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "\n - Set this value in incremental order from the previously set rotate value (starting from 1) to rotate the key to the key identified by `config`.",
+		PlanModifiers: []planmodifier.Number{
+			numberplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	return schema.Schema{
+		MarkdownDescription: "This resource allows you to register a customer-managed encryption key (CMEK) from your cloud provider's key management service with Capella, and to rotate it.",
+		Attributes:          attrs,
+	}
+}
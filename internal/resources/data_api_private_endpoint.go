@@ -0,0 +1,273 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DataAPIPrivateEndpoint{}
+	_ resource.ResourceWithConfigure   = &DataAPIPrivateEndpoint{}
+	_ resource.ResourceWithImportState = &DataAPIPrivateEndpoint{}
+)
+
+// DataAPIPrivateEndpoint is the Data API private endpoint resource implementation.
+type DataAPIPrivateEndpoint struct {
+	*providerschema.Data
+}
+
+// NewDataAPIPrivateEndpoint is a helper function to simplify the provider implementation.
+func NewDataAPIPrivateEndpoint() resource.Resource {
+	return &DataAPIPrivateEndpoint{}
+}
+
+// Metadata returns the Data API private endpoint resource type name.
+func (d *DataAPIPrivateEndpoint) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_api_private_endpoint"
+}
+
+// Schema defines the schema for the Data API private endpoint resource.
+func (d *DataAPIPrivateEndpoint) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = DataAPIPrivateEndpointSchema()
+}
+
+// Create associates a private endpoint with the Data API of the cluster.
+func (d *DataAPIPrivateEndpoint) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.DataAPIPrivateEndpoint
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+		endpointId     = plan.EndpointId.ValueString()
+	)
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	associateResp, err := d.ClientV2.AssociateDataAPIPrivateEndpointRequestWithResponse(ctx, orgUUID, projUUID, clusterUUID, endpointId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error associating Data API private endpoint",
+			"Could not associate private endpoint "+endpointId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	switch associateResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	default:
+		resp.Diagnostics.AddError(
+			"Error associating Data API private endpoint",
+			fmt.Sprintf("Could not associate private endpoint %s, unexpected response status %d: %s", endpointId, associateResp.StatusCode(), string(associateResp.Body)),
+		)
+		return
+	}
+
+	plan.Status = types.StringNull()
+	plan.ServiceName = types.StringNull()
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := d.getPrivateEndpointState(ctx, organizationId, projectId, clusterId, endpointId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Data API private endpoint status",
+			"Error reading Data API private endpoint status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the status of the Data API private endpoint.
+func (d *DataAPIPrivateEndpoint) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.DataAPIPrivateEndpoint
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Data API Private Endpoint",
+			"Could not validate private endpoint "+state.EndpointId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		endpointId     = IDs[providerschema.EndpointId]
+	)
+
+	refreshedState, err := d.getPrivateEndpointState(ctx, organizationId, projectId, clusterId, endpointId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error reading Data API private endpoint status",
+			"Error reading Data API private endpoint status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Both rejected and failed associations are terminal and cannot recover in
+	// place, so remove them from state to force a clean re-association on the
+	// next apply rather than leaving a stuck resource.
+	switch apigen.PrivateEndpointStatus(refreshedState.Status.ValueString()) {
+	case apigen.PrivateEndpointStatusRejected, apigen.PrivateEndpointStatusFailed:
+		tflog.Info(ctx, "Data API private endpoint association is "+refreshedState.Status.ValueString()+"; removing from state to force re-association")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is not supported as there is no update API.
+func (d *DataAPIPrivateEndpoint) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so Update is never called.
+}
+
+// Delete disassociates the private endpoint from the Data API of the cluster.
+func (d *DataAPIPrivateEndpoint) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.DataAPIPrivateEndpoint
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error disassociating Data API private endpoint",
+			"Could not disassociate endpoint due to validation error: "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		endpointId     = IDs[providerschema.EndpointId]
+	)
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	disassociateResp, err := d.ClientV2.DisassociateDataAPIPrivateEndpointWithResponse(ctx, orgUUID, projUUID, clusterUUID, endpointId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error disassociating Data API private endpoint",
+			"Could not disassociate private endpoint "+endpointId+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	switch disassociateResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error disassociating Data API private endpoint",
+			fmt.Sprintf("Could not disassociate private endpoint %s, unexpected response status %d: %s", endpointId, disassociateResp.StatusCode(), string(disassociateResp.Body)),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the Data API private endpoint resource.
+func (d *DataAPIPrivateEndpoint) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Data = data
+}
+
+// ImportState imports a Data API private endpoint to be managed by terraform.
+func (d *DataAPIPrivateEndpoint) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("endpoint_id"), req, resp)
+}
+
+// getPrivateEndpointState finds the private endpoint in the list of Data API private endpoints,
+// as there is no endpoint to get a single one, and converts it into Terraform state.
+// errors.ErrNotFound is returned when the private endpoint does not exist.
+func (d *DataAPIPrivateEndpoint) getPrivateEndpointState(
+	ctx context.Context,
+	organizationId, projectId, clusterId, endpointId string,
+) (*providerschema.DataAPIPrivateEndpoint, error) {
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	listResp, err := d.ClientV2.ListDataAPIPrivateEndpointsWithResponse(ctx, orgUUID, projUUID, clusterUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case listResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case listResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", listResp.StatusCode(), string(listResp.Body))
+	}
+
+	for _, endpoint := range listResp.JSON200.Endpoints {
+		if endpoint.Id == endpointId {
+			return providerschema.NewDataAPIPrivateEndpoint(endpoint, organizationId, projectId, clusterId), nil
+		}
+	}
+
+	return nil, errors.ErrNotFound
+}
@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var dataAPIPrivateEndpointBuilder = capellaschema.NewSchemaBuilder("dataAPIPrivateEndpoint", "PrivateEndpoint")
+
+// DataAPIPrivateEndpointSchema returns the schema for the data_api_private_endpoint resource.
+func DataAPIPrivateEndpointSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", dataAPIPrivateEndpointBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", dataAPIPrivateEndpointBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", dataAPIPrivateEndpointBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "endpoint_id", dataAPIPrivateEndpointBuilder, stringAttribute(
+		[]string{required, requiresReplace},
+		stringvalidator.LengthAtLeast(1),
+	))
+	capellaschema.AddAttr(attrs, "status", dataAPIPrivateEndpointBuilder, stringAttribute([]string{computed}))
+	capellaschema.AddAttr(attrs, "service_name", dataAPIPrivateEndpointBuilder, stringAttribute([]string{computed}))
+
+	return schema.Schema{
+		MarkdownDescription: "This resource allows you to associate an AWS PrivateLink endpoint with the Data API of a cluster. " +
+			"The Data API must be enabled on the cluster before a private endpoint can be associated with it.",
+		Attributes: attrs,
+	}
+}
@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                = &DatabaseCredential{}
 	_ resource.ResourceWithConfigure   = &DatabaseCredential{}
 	_ resource.ResourceWithImportState = &DatabaseCredential{}
+	_ resource.ResourceWithModifyPlan  = &DatabaseCredential{}
 )
 
 const errorMessageAfterDatabaseCredentialCreation = "Bucket creation is successful, but encountered an error while checking the current" +
@@ -72,9 +73,11 @@ func (r *DatabaseCredential) Configure(_ context.Context, req resource.Configure
 // Create creates a new database credential. This function will validate the mandatory fields in the resource.CreateRequest
 // before invoking the Capella V4 API.
 func (r *DatabaseCredential) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan providerschema.DatabaseCredential
+	var plan, config providerschema.DatabaseCredential
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	// Write-only values are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -96,7 +99,11 @@ func (r *DatabaseCredential) Create(ctx context.Context, req resource.CreateRequ
 		Name: plan.Name.ValueString(),
 	}
 
-	if !plan.Password.IsNull() {
+	writeOnlyPassword := !config.PasswordWo.IsNull()
+	switch {
+	case writeOnlyPassword:
+		dbCredRequest.Password = config.PasswordWo.ValueString()
+	case !plan.Password.IsNull():
 		dbCredRequest.Password = plan.Password.ValueString()
 	}
 
@@ -129,7 +136,12 @@ func (r *DatabaseCredential) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	diags = resp.State.Set(ctx, initializeDataBaseCredentialWithPlanPasswordAndId(plan, dbResponse.Password, dbResponse.Id.String()))
+	initialState := initializeDataBaseCredentialWithPlanPasswordAndId(plan, dbResponse.Password, dbResponse.Id.String())
+	if writeOnlyPassword {
+		// the write-only password must not end up in state through the create response.
+		initialState.Password = types.StringNull()
+	}
+	diags = resp.State.Set(ctx, initialState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -144,7 +156,8 @@ func (r *DatabaseCredential) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	refreshedState.Password = types.StringValue(dbResponse.Password)
+	refreshedState.Password = initialState.Password
+	refreshedState.PasswordWoVersion = plan.PasswordWoVersion
 
 	// todo: there is a bug in cp-open-api where the access field is empty in the GET API response,
 	// we are going to work around this for private preview.
@@ -163,6 +176,24 @@ func (r *DatabaseCredential) Create(ctx context.Context, req resource.CreateRequ
 	}
 }
 
+// ModifyPlan clears the planned password when the write-only password is configured,
+// so the password previously stored in state is dropped rather than kept.
+func (r *DatabaseCredential) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var passwordWo types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || passwordWo.IsNull() {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("password"), types.StringNull())
+	resp.Diagnostics.Append(diags...)
+}
+
 // Read reads database credential information.
 func (r *DatabaseCredential) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state providerschema.DatabaseCredential
@@ -206,6 +237,7 @@ func (r *DatabaseCredential) Read(ctx context.Context, req resource.ReadRequest,
 
 	// if the user had provided the password in the input, we store that in the terraform state file.
 	refreshedState.Password = state.Password
+	refreshedState.PasswordWoVersion = state.PasswordWoVersion
 
 	// todo: there is a bug in cp-open-api where the access field is empty in the GET API response,
 	// we are going to work around this for private preview.
@@ -221,11 +253,14 @@ func (r *DatabaseCredential) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
-// Update updates the database credential.
+// Update updates the database credential. The write-only password is only sent again
+// when password_wo_version has changed.
 func (r *DatabaseCredential) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state providerschema.DatabaseCredential
+	var state, priorState, config providerschema.DatabaseCredential
 	diags := req.Plan.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -255,9 +290,13 @@ func (r *DatabaseCredential) Update(ctx context.Context, req resource.UpdateRequ
 	)
 
 	dbCredRequest := api.PutDatabaseCredentialRequest{
-		// it is expected that the password in the state file will never be empty.
+		// it is expected that the password in the state file will never be empty,
+		// unless the write-only password is used.
 		Password: state.Password.ValueString(),
 	}
+	if !config.PasswordWo.IsNull() && !state.PasswordWoVersion.Equal(priorState.PasswordWoVersion) {
+		dbCredRequest.Password = config.PasswordWo.ValueString()
+	}
 
 	dbCredRequest.Access = createAccess(state)
 
@@ -294,6 +333,7 @@ func (r *DatabaseCredential) Update(ctx context.Context, req resource.UpdateRequ
 
 	// this will ensure that the state file stores the new updated password, if password is not to be updated, it will retain the older one.
 	currentState.Password = state.Password
+	currentState.PasswordWoVersion = state.PasswordWoVersion
 
 	// todo: there is a bug in cp-open-api where the access field is empty in the GET API response,
 	// we are going to work around this for private preview.
@@ -367,8 +407,8 @@ func (r *DatabaseCredential) Delete(ctx context.Context, req resource.DeleteRequ
 // Unfortunately the terraform import CLI doesn't allow us to pass multiple IDs at this point
 // and hence this workaround has been applied.
 func (r *DatabaseCredential) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Names in the import ID are resolved to IDs before they are saved to the id attribute.
+	importStateByName(ctx, r.Data, req, resp, "Database Credential", "database_credential_id", "project_name", "cluster_name", "database_credential_name")
 }
 
 // retrieveDatabaseCredential fetches the database credential by making a GET API call to the Capella V4 Public API.
@@ -1,9 +1,13 @@
 package resources
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
 )
@@ -24,6 +28,18 @@ func DatabaseCredentialSchema() schema.Schema {
 	})
 	capellaschema.AddAttr(attrs, "name", databaseCredentialBuilder, stringAttribute([]string{required, requiresReplace}))
 	capellaschema.AddAttr(attrs, "password", databaseCredentialBuilder, stringAttribute([]string{optional, computed, sensitive, useStateForUnknown}))
+
+	// The write-only password is never stored in state.
+	passwordWoAttr := writeOnlyStringAttribute(
+		"The password of the database credential, sent to Capella without being stored in the plan or state. Conflicts with password.",
+	)
+	passwordWoAttr.Validators = []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("password"))}
+	capellaschema.AddAttr(attrs, "password_wo", databaseCredentialBuilder, passwordWoAttr)
+
+	passwordVersionAttr := int64Attribute(optional)
+	passwordVersionAttr.MarkdownDescription = "Change this value to send the write-only password to Capella again, for example after rotating it."
+	passwordVersionAttr.Validators = []validator.Int64{int64validator.AtLeast(0)}
+	capellaschema.AddAttr(attrs, "password_wo_version", databaseCredentialBuilder, passwordVersionAttr)
 	capellaschema.AddAttr(attrs, "organization_id", databaseCredentialBuilder, requiredUUIDStringAttribute())
 	capellaschema.AddAttr(attrs, "project_id", databaseCredentialBuilder, requiredUUIDStringAttribute())
 	capellaschema.AddAttr(attrs, "cluster_id", databaseCredentialBuilder, requiredUUIDStringAttribute())
@@ -30,6 +30,10 @@ var (
 	_ resource.ResourceWithImportState = (*EventingFunction)(nil)
 )
 
+// eventingFunctionTimeout is how long an eventing function may take to be created, updated
+// or deleted, including any activation state change, when the timeouts block does not set a value.
+const eventingFunctionTimeout = 10 * time.Minute
+
 // EventingFunction is the eventing function resource implementation.
 type EventingFunction struct {
 	*providerschema.Data
@@ -68,6 +72,13 @@ func (e *EventingFunction) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, eventingFunctionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	var (
 		organizationId = plan.OrganizationId.ValueString()
 		projectId      = plan.ProjectId.ValueString()
@@ -427,6 +438,13 @@ func (e *EventingFunction) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, eventingFunctionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	plannedSettings, d := eventingSettingsFromObject(ctx, plan.Settings)
 	resp.Diagnostics.Append(d...)
 	stateSettings, sd := eventingSettingsFromObject(ctx, state.Settings)
@@ -482,6 +500,7 @@ func (e *EventingFunction) Update(ctx context.Context, req resource.UpdateReques
 		}
 
 		state.State = plan.State
+		state.Timeouts = plan.Timeouts
 		diags := resp.State.Set(ctx, state)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -545,7 +564,7 @@ func (e *EventingFunction) Update(ctx context.Context, req resource.UpdateReques
 		refreshedState = &plan
 	}
 
-	diags := resp.State.Set(ctx, refreshedState)
+	diags = resp.State.Set(ctx, refreshedState)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -568,6 +587,13 @@ func (e *EventingFunction) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, eventingFunctionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	var (
 		organizationId = IDs[providerschema.OrganizationId]
 		projectId      = IDs[providerschema.ProjectId]
@@ -695,16 +721,12 @@ func (e *EventingFunction) setActivationState(
 }
 
 // waitForStatus polls the eventing function every 5 seconds until its runtime status equals target,
-// returning an error if the target is not reached within 5 minutes.
+// returning an error if the target is not reached before the deadline of ctx.
 func (e *EventingFunction) waitForStatus(
 	ctx context.Context, organizationId, projectId, clusterId, name, target string,
 ) error {
-	const timeout = 5 * time.Minute
 	const retryInterval = 5 * time.Second
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
-
 	ticker := time.NewTicker(retryInterval)
 	defer ticker.Stop()
 
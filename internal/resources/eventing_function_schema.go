@@ -77,6 +77,9 @@ func EventingFunctionSchema() schema.Schema {
 		MarkdownDescription: "Manages an eventing function on a Capella cluster, including its JavaScript code, " +
 			"source and metadata keyspaces, runtime settings, bindings, and deployment state.",
 		Attributes: attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
 
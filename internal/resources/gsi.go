@@ -30,6 +30,10 @@ var (
 	_ resource.ResourceWithValidateConfig = (*GSI)(nil)
 )
 
+// gsiTimeout is how long an index may take to be created, altered or dropped,
+// including the wait for deferred indexes to build, when the timeouts block does not set a value.
+const gsiTimeout = 60 * time.Minute
+
 // GSI is the GSI resource implementation.
 type GSI struct {
 	*providerschema.Data
@@ -57,6 +61,13 @@ func (g *GSI) Create(ctx context.Context, req resource.CreateRequest, resp *reso
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, gsiTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	// initialize computed attributes to null.
 	if plan.With != nil {
 		if plan.With.NumReplica.IsNull() || plan.With.NumReplica.IsUnknown() {
@@ -65,7 +76,7 @@ func (g *GSI) Create(ctx context.Context, req resource.CreateRequest, resp *reso
 	}
 	plan.Status = types.StringNull()
 
-	diags := resp.State.Set(ctx, plan)
+	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -470,6 +481,13 @@ func (g *GSI) Update(ctx context.Context, req resource.UpdateRequest, resp *reso
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, gsiTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	// Update only supports changing num_replica. Validate it's provided.
 	if plan.With == nil || plan.With.NumReplica.IsNull() || plan.With.NumReplica.IsUnknown() {
 		resp.Diagnostics.AddError(
@@ -535,6 +553,13 @@ func (g *GSI) Delete(ctx context.Context, req resource.DeleteRequest, resp *reso
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, gsiTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	var indexName string
 	if state.IsPrimary.ValueBool() && state.IndexName.IsNull() {
 		indexName = "#primary"
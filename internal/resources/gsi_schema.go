@@ -76,5 +76,8 @@ func GsiSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "This resource allows you to manage Query Indexes in Couchbase Capella.",
 		Attributes:          attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
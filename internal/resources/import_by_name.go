@@ -0,0 +1,324 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	appserviceapi "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/appservice"
+	bucketapi "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/bucket"
+	clusterapi "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/cluster"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// importNameLookup resolves a name given in an import ID to the ID of the object it names.
+type importNameLookup struct {
+	// key is the name key of the import ID, such as "cluster_name".
+	key string
+
+	// idKey is the ID key the name resolves to, such as "cluster_id". When the name
+	// refers to the imported resource itself, it resolves to "id" instead.
+	idKey string
+
+	// kind is the kind of object named, used in error messages.
+	kind string
+
+	// parents are the ID keys needed to list the objects, from the organization down.
+	parents []string
+
+	// list returns the IDs of the objects with the given name under the parent IDs.
+	list func(ctx context.Context, data *providerschema.Data, ids map[string]string, name string) ([]string, error)
+}
+
+// importNameLookups are the supported name keys, in the order they are resolved so that
+// each lookup can use the IDs resolved before it.
+var importNameLookups = []importNameLookup{
+	{
+		key:     "project_name",
+		idKey:   "project_id",
+		kind:    "project",
+		parents: []string{"organization_id"},
+		list:    listProjectsByName,
+	},
+	{
+		key:     "cluster_name",
+		idKey:   "cluster_id",
+		kind:    "cluster",
+		parents: []string{"organization_id", "project_id"},
+		list:    listClustersByName,
+	},
+	{
+		key:     "bucket_name",
+		idKey:   "bucket_id",
+		kind:    "bucket",
+		parents: []string{"organization_id", "project_id", "cluster_id"},
+		list:    listBucketsByName,
+	},
+	{
+		key:     "app_service_name",
+		idKey:   "app_service_id",
+		kind:    "app service",
+		parents: []string{"organization_id", "project_id", "cluster_id"},
+		list:    listAppServicesByName,
+	},
+	{
+		key:     "database_credential_name",
+		idKey:   "database_credential_id",
+		kind:    "database credential",
+		parents: []string{"organization_id", "project_id", "cluster_id"},
+		list:    listDatabaseCredentialsByName,
+	},
+	{
+		key:     "user_email",
+		idKey:   "user_id",
+		kind:    "user",
+		parents: []string{"organization_id"},
+		list:    listUsersByEmail,
+	},
+	{
+		key:     "user_name",
+		idKey:   "user_id",
+		kind:    "user",
+		parents: []string{"organization_id"},
+		list:    listUsersByName,
+	},
+}
+
+// importStateByName imports a resource whose import ID may name the resource and its parents
+// instead of giving their IDs, for example "cluster_name=orders,project_name=prod,organization_id=<uuid>".
+// The names are resolved through the list APIs and the id attribute is set to the usual import ID,
+// so Read splits it as if the IDs had been given. ownIdKey is the ID key of the resource itself,
+// such as "cluster_id", and names are the name keys the resource accepts.
+func importStateByName(
+	ctx context.Context,
+	data *providerschema.Data,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+	kind string,
+	ownIdKey string,
+	names ...string,
+) {
+	importId, err := resolveImportNames(ctx, data, req.ID, ownIdKey, names...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Capella "+kind,
+			"Could not resolve the names in import ID "+req.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
+}
+
+// resolveImportNames returns the import ID with its name keys replaced by the IDs they
+// resolve to. An import ID without name keys is returned unchanged.
+func resolveImportNames(ctx context.Context, data *providerschema.Data, importId, ownIdKey string, names ...string) (string, error) {
+	if !hasImportName(importId) {
+		return importId, nil
+	}
+
+	ids, err := providerschema.ParseImportString(importId)
+	if err != nil {
+		return "", err
+	}
+
+	allowed := make(map[string]bool, len(names))
+	for _, name := range names {
+		allowed[name] = true
+	}
+	for _, lookup := range importNameLookups {
+		if _, ok := ids[lookup.key]; ok && !allowed[lookup.key] {
+			return "", fmt.Errorf("%s cannot be used to import this resource, supported names are: %s", lookup.key, strings.Join(names, ", "))
+		}
+	}
+
+	for _, lookup := range importNameLookups {
+		name, ok := ids[lookup.key]
+		if !ok || !allowed[lookup.key] {
+			continue
+		}
+
+		target := lookup.idKey
+		if target == ownIdKey {
+			target = "id"
+		}
+		if _, ok := ids[target]; ok {
+			return "", fmt.Errorf("%s and %s cannot both be set", lookup.key, target)
+		}
+
+		for _, parent := range lookup.parents {
+			if _, ok := ids[parent]; !ok {
+				return "", fmt.Errorf("%s requires %s, or the name it can be resolved from", lookup.key, parent)
+			}
+			if _, err := utils.ParseUUID(parent, ids[parent]); err != nil {
+				return "", err
+			}
+		}
+
+		matches, err := lookup.list(ctx, data, ids, name)
+		if err != nil {
+			return "", fmt.Errorf("could not resolve %s %q: %w", lookup.key, name, err)
+		}
+		switch len(matches) {
+		case 0:
+			return "", fmt.Errorf("no %s named %q was found", lookup.kind, name)
+		case 1:
+		default:
+			return "", fmt.Errorf(
+				"%s %q is ambiguous, %d %ss have this name (IDs: %s); set %s instead",
+				lookup.key, name, len(matches), lookup.kind, strings.Join(matches, ", "), target,
+			)
+		}
+
+		delete(ids, lookup.key)
+		ids[target] = matches[0]
+	}
+
+	return providerschema.BuildImportString(ids)
+}
+
+// hasImportName reports whether the import ID contains a name key. It only looks at the keys,
+// so an import ID that is otherwise invalid is left for Read to report as it always has.
+func hasImportName(importId string) bool {
+	for _, pair := range strings.Split(importId, ",") {
+		key, _, _ := strings.Cut(pair, "=")
+		for _, lookup := range importNameLookups {
+			if key == lookup.key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func listProjectsByName(ctx context.Context, data *providerschema.Data, ids map[string]string, name string) ([]string, error) {
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects", data.HostURL, ids["organization_id"])
+	projects, err := listForImport[[]api.GetProjectResponse](ctx, data, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, project := range projects {
+		if project.Name == name {
+			matches = append(matches, project.Id.String())
+		}
+	}
+	return matches, nil
+}
+
+func listClustersByName(ctx context.Context, data *providerschema.Data, ids map[string]string, name string) ([]string, error) {
+	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters", data.HostURL, ids["organization_id"], ids["project_id"])
+	clusters, err := listForImport[[]clusterapi.GetClusterResponse](ctx, data, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, cluster := range clusters {
+		if cluster.Name == name {
+			matches = append(matches, cluster.Id.String())
+		}
+	}
+	return matches, nil
+}
+
+func listBucketsByName(ctx context.Context, data *providerschema.Data, ids map[string]string, name string) ([]string, error) {
+	url := fmt.Sprintf(
+		"%s/v4/organizations/%s/projects/%s/clusters/%s/buckets",
+		data.HostURL, ids["organization_id"], ids["project_id"], ids["cluster_id"],
+	)
+	buckets, err := listForImport[[]bucketapi.GetBucketResponse](ctx, data, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, bucket := range buckets {
+		if bucket.Name == name {
+			matches = append(matches, bucket.Id)
+		}
+	}
+	return matches, nil
+}
+
+func listAppServicesByName(ctx context.Context, data *providerschema.Data, ids map[string]string, name string) ([]string, error) {
+	// App services are only listed per organization, so they are filtered by cluster here.
+	url := fmt.Sprintf("%s/v4/organizations/%s/appservices", data.HostURL, ids["organization_id"])
+	appServices, err := listForImport[[]appserviceapi.GetAppServiceResponse](ctx, data, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, appService := range appServices {
+		if appService.Name == name && appService.ClusterId == ids["cluster_id"] {
+			matches = append(matches, appService.Id.String())
+		}
+	}
+	return matches, nil
+}
+
+func listDatabaseCredentialsByName(ctx context.Context, data *providerschema.Data, ids map[string]string, name string) ([]string, error) {
+	url := fmt.Sprintf(
+		"%s/v4/organizations/%s/projects/%s/clusters/%s/users",
+		data.HostURL, ids["organization_id"], ids["project_id"], ids["cluster_id"],
+	)
+	credentials, err := listForImport[[]api.GetDatabaseCredentialResponse](ctx, data, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, credential := range credentials {
+		if credential.Name == name {
+			matches = append(matches, credential.Id.String())
+		}
+	}
+	return matches, nil
+}
+
+func listUsersByEmail(ctx context.Context, data *providerschema.Data, ids map[string]string, email string) ([]string, error) {
+	return listUsers(ctx, data, ids, func(user api.GetUserResponse) bool {
+		// Email addresses are not case sensitive.
+		return strings.EqualFold(user.Email, email)
+	})
+}
+
+func listUsersByName(ctx context.Context, data *providerschema.Data, ids map[string]string, name string) ([]string, error) {
+	return listUsers(ctx, data, ids, func(user api.GetUserResponse) bool {
+		return user.Name != nil && *user.Name == name
+	})
+}
+
+func listUsers(ctx context.Context, data *providerschema.Data, ids map[string]string, match func(api.GetUserResponse) bool) ([]string, error) {
+	url := fmt.Sprintf("%s/v4/organizations/%s/users", data.HostURL, ids["organization_id"])
+	users, err := listForImport[[]api.GetUserResponse](ctx, data, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, user := range users {
+		if match(user) {
+			matches = append(matches, user.Id.String())
+		}
+	}
+	return matches, nil
+}
+
+// listForImport lists every page of the given list endpoint.
+func listForImport[DataSchema ~[]T, T any](ctx context.Context, data *providerschema.Data, url string) (DataSchema, error) {
+	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
+	response, err := api.GetPaginated[DataSchema](ctx, data.ClientV1, data.Token, cfg, api.SortById)
+	if err != nil {
+		return nil, fmt.Errorf("%s", api.ParseError(err))
+	}
+	return response, nil
+}
@@ -0,0 +1,242 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+const (
+	importOrgID      = "11111111-1111-4111-8111-111111111111"
+	importProjectID  = "22222222-2222-4222-8222-222222222222"
+	importProject2ID = "22222222-2222-4222-8222-333333333333"
+	importClusterID  = "33333333-3333-4333-8333-333333333333"
+	importCluster2ID = "33333333-3333-4333-8333-444444444444"
+	importAppSvcID   = "44444444-4444-4444-8444-444444444444"
+	importAppSvc2ID  = "44444444-4444-4444-8444-555555555555"
+	importCredID     = "55555555-5555-4555-8555-555555555555"
+	importUserID     = "66666666-6666-4666-8666-666666666666"
+	importBucketID   = "b3JkZXJz"
+)
+
+// fakeListBackend serves the list endpoints used to resolve names, one item per page
+// so the resolution is exercised across several pages.
+type fakeListBackend struct {
+	mu       sync.Mutex
+	lists    map[string][]map[string]any
+	requests int
+}
+
+func (b *fakeListBackend) handler(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.requests++
+
+	items, ok := b.lists[r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	var data []map[string]any
+	if page <= len(items) {
+		data = items[page-1 : page]
+	}
+	next := 0
+	if page < len(items) {
+		next = page + 1
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"data":   data,
+		"cursor": map[string]any{"pages": map[string]any{"page": page, "next": next}},
+	})
+}
+
+func newImportTestData(t *testing.T) (*providerschema.Data, *fakeListBackend) {
+	t.Helper()
+
+	organization := "/v4/organizations/" + importOrgID
+	cluster := organization + "/projects/" + importProjectID + "/clusters/" + importClusterID
+	backend := &fakeListBackend{lists: map[string][]map[string]any{
+		organization + "/projects": {
+			{"id": importProjectID, "name": "prod"},
+			{"id": importProject2ID, "name": "dev"},
+			{"id": "22222222-2222-4222-8222-444444444444", "name": "dev"},
+		},
+		organization + "/projects/" + importProjectID + "/clusters": {
+			{"id": importClusterID, "name": "orders"},
+			{"id": importCluster2ID, "name": "payments"},
+		},
+		cluster + "/buckets": {
+			{"id": importBucketID, "name": "orders"},
+		},
+		cluster + "/users": {
+			{"id": importCredID, "name": "app"},
+		},
+		organization + "/appservices": {
+			{"id": importAppSvc2ID, "name": "sync", "clusterId": importCluster2ID},
+			{"id": importAppSvcID, "name": "sync", "clusterId": importClusterID},
+		},
+		organization + "/users": {
+			{"id": importUserID, "name": "Jane Doe", "email": "Jane.Doe@example.com"},
+		},
+	}}
+
+	server := httptest.NewServer(http.HandlerFunc(backend.handler))
+	t.Cleanup(server.Close)
+
+	return &providerschema.Data{
+		HostURL:  server.URL,
+		Token:    "token",
+		ClientV1: &api.Client{Client: server.Client()},
+	}, backend
+}
+
+func TestResolveImportNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		importId string
+		ownIdKey string
+		names    []string
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "[POSITIVE] project by name",
+			importId: "project_name=prod,organization_id=" + importOrgID,
+			ownIdKey: "project_id",
+			names:    []string{"project_name"},
+			expected: "id=" + importProjectID + ",organization_id=" + importOrgID,
+		},
+		{
+			name:     "[POSITIVE] cluster by project and cluster name",
+			importId: "organization_id=" + importOrgID + ",project_name=prod,cluster_name=payments",
+			ownIdKey: "cluster_id",
+			names:    []string{"project_name", "cluster_name"},
+			expected: "id=" + importCluster2ID + ",project_id=" + importProjectID + ",organization_id=" + importOrgID,
+		},
+		{
+			name:     "[POSITIVE] bucket by name under a cluster ID",
+			importId: "bucket_name=orders,cluster_id=" + importClusterID + ",project_id=" + importProjectID + ",organization_id=" + importOrgID,
+			ownIdKey: "bucket_id",
+			names:    []string{"project_name", "cluster_name", "bucket_name"},
+			expected: "id=" + importBucketID + ",cluster_id=" + importClusterID + ",project_id=" + importProjectID + ",organization_id=" + importOrgID,
+		},
+		{
+			name:     "[POSITIVE] app service filtered by cluster",
+			importId: "app_service_name=sync,cluster_name=orders,project_name=prod,organization_id=" + importOrgID,
+			ownIdKey: "app_service_id",
+			names:    []string{"project_name", "cluster_name", "app_service_name"},
+			expected: "id=" + importAppSvcID + ",cluster_id=" + importClusterID + ",project_id=" + importProjectID + ",organization_id=" + importOrgID,
+		},
+		{
+			name:     "[POSITIVE] database credential by name",
+			importId: "database_credential_name=app,cluster_name=orders,project_name=prod,organization_id=" + importOrgID,
+			ownIdKey: "database_credential_id",
+			names:    []string{"project_name", "cluster_name", "database_credential_name"},
+			expected: "id=" + importCredID + ",cluster_id=" + importClusterID + ",project_id=" + importProjectID + ",organization_id=" + importOrgID,
+		},
+		{
+			name:     "[POSITIVE] user by email ignoring case",
+			importId: "user_email=jane.doe@example.com,organization_id=" + importOrgID,
+			ownIdKey: "user_id",
+			names:    []string{"user_email", "user_name"},
+			expected: "id=" + importUserID + ",organization_id=" + importOrgID,
+		},
+		{
+			name:     "[POSITIVE] user by name",
+			importId: "user_name=Jane Doe,organization_id=" + importOrgID,
+			ownIdKey: "user_id",
+			names:    []string{"user_email", "user_name"},
+			expected: "id=" + importUserID + ",organization_id=" + importOrgID,
+		},
+		{
+			name:     "[NEGATIVE] ambiguous name",
+			importId: "project_name=dev,organization_id=" + importOrgID,
+			ownIdKey: "project_id",
+			names:    []string{"project_name"},
+			wantErr:  `project_name "dev" is ambiguous, 2 projects have this name`,
+		},
+		{
+			name:     "[NEGATIVE] unknown name",
+			importId: "cluster_name=missing,project_id=" + importProjectID + ",organization_id=" + importOrgID,
+			ownIdKey: "cluster_id",
+			names:    []string{"project_name", "cluster_name"},
+			wantErr:  `no cluster named "missing" was found`,
+		},
+		{
+			name:     "[NEGATIVE] name not supported by the resource",
+			importId: "cluster_name=orders,project_id=" + importProjectID + ",organization_id=" + importOrgID,
+			ownIdKey: "project_id",
+			names:    []string{"project_name"},
+			wantErr:  "cluster_name cannot be used to import this resource",
+		},
+		{
+			name:     "[NEGATIVE] name and ID of the same object",
+			importId: "id=" + importClusterID + ",cluster_name=orders,project_id=" + importProjectID + ",organization_id=" + importOrgID,
+			ownIdKey: "cluster_id",
+			names:    []string{"project_name", "cluster_name"},
+			wantErr:  "cluster_name and id cannot both be set",
+		},
+		{
+			name:     "[NEGATIVE] missing parent",
+			importId: "cluster_name=orders,organization_id=" + importOrgID,
+			ownIdKey: "cluster_id",
+			names:    []string{"project_name", "cluster_name"},
+			wantErr:  "cluster_name requires project_id",
+		},
+		{
+			name:     "[NEGATIVE] invalid organization ID",
+			importId: "project_name=prod,organization_id=org",
+			ownIdKey: "project_id",
+			names:    []string{"project_name"},
+			wantErr:  "invalid organization_id",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, _ := newImportTestData(t)
+
+			importId, err := resolveImportNames(context.Background(), data, test.importId, test.ownIdKey, test.names...)
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, importId)
+		})
+	}
+}
+
+func TestResolveImportNamesWithoutNames(t *testing.T) {
+	data, backend := newImportTestData(t)
+
+	// Import IDs without names, valid or not, are left for Read to split as before.
+	for _, importId := range []string{
+		"id=" + importClusterID + ",project_id=" + importProjectID + ",organization_id=" + importOrgID,
+		"not an import ID",
+	} {
+		resolved, err := resolveImportNames(context.Background(), data, importId, "cluster_id", "project_name", "cluster_name")
+		require.NoError(t, err)
+		assert.Equal(t, importId, resolved)
+	}
+	assert.Zero(t, backend.requests)
+}
@@ -0,0 +1,400 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+const (
+	// mtlsCertificateLoadTimeout bounds the wait for a certificate to be loaded onto the cluster.
+	mtlsCertificateLoadTimeout = 10 * time.Minute
+
+	// mtlsCertificatePollInterval is the time between certificate status checks.
+	mtlsCertificatePollInterval = 10 * time.Second
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &MtlsCertificate{}
+	_ resource.ResourceWithConfigure   = &MtlsCertificate{}
+	_ resource.ResourceWithImportState = &MtlsCertificate{}
+)
+
+// MtlsCertificate is the mTLS certificate resource implementation.
+type MtlsCertificate struct {
+	*providerschema.Data
+}
+
+// NewMtlsCertificate is a helper function to simplify the provider implementation.
+func NewMtlsCertificate() resource.Resource {
+	return &MtlsCertificate{}
+}
+
+// Metadata returns the mTLS certificate resource type name.
+func (m *MtlsCertificate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mtls_certificate"
+}
+
+// Schema defines the schema for the mTLS certificate resource.
+func (m *MtlsCertificate) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = MtlsCertificateSchema()
+}
+
+// Configure adds the provider configured client to the mTLS certificate resource.
+func (m *MtlsCertificate) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	m.Data = data
+}
+
+// ImportState imports a remote mTLS certificate that is not created by Terraform.
+func (m *MtlsCertificate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create adds the certificate to the cluster and waits for it to be loaded.
+func (m *MtlsCertificate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.MtlsCertificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+	)
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	createResp, err := m.ClientV2.AddMtlsCertificateWithResponse(ctx, orgUUID, projUUID, clusterUUID, newMtlsCertificateRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating mTLS certificate",
+			"Could not create mTLS certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.JSON201 == nil {
+		resp.Diagnostics.AddError(
+			"Error creating mTLS certificate",
+			fmt.Sprintf("Could not create mTLS certificate, unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	certificateId := createResp.JSON201.Id
+
+	// Save the ID so a certificate that fails to load is still tracked and can be destroyed.
+	initialState := plan
+	initialState.Id = types.StringValue(certificateId)
+	initialState.Status = types.StringNull()
+	initialState.Audit = types.ObjectNull(providerschema.CouchbaseAuditData{}.AttributeTypes())
+	diags = resp.State.Set(ctx, initialState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, err := m.waitForMtlsCertificateLoaded(ctx, organizationId, projectId, clusterId, certificateId, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating mTLS certificate",
+			"Could not load mTLS certificate with ID "+certificateId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the mTLS certificate.
+func (m *MtlsCertificate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.MtlsCertificate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading mTLS Certificate in Capella",
+			"Could not read Capella mTLS certificate with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		certificateId  = IDs[providerschema.Id]
+	)
+
+	refreshedState, err := m.retrieveMtlsCertificate(ctx, organizationId, projectId, clusterId, certificateId, &state)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading mTLS Certificate in Capella",
+			"Could not read Capella mTLS certificate with ID "+certificateId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update replaces the certificate on the cluster and waits for the new certificate to be loaded.
+func (m *MtlsCertificate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.MtlsCertificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating mTLS certificate",
+			"Could not update mTLS certificate with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		certificateId  = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+	certificateUUID, err := utils.ParseUUID("id", certificateId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	updateResp, err := m.ClientV2.PutMtlsCertificateWithResponse(ctx, orgUUID, projUUID, clusterUUID, certificateUUID, newMtlsCertificateRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating mTLS certificate",
+			"Could not update mTLS certificate with ID "+certificateId+": "+err.Error(),
+		)
+		return
+	}
+	switch updateResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	default:
+		resp.Diagnostics.AddError(
+			"Error updating mTLS certificate",
+			fmt.Sprintf("Could not update mTLS certificate with ID %s, unexpected response status %d: %s", certificateId, updateResp.StatusCode(), string(updateResp.Body)),
+		)
+		return
+	}
+
+	refreshedState, err := m.waitForMtlsCertificateLoaded(ctx, organizationId, projectId, clusterId, certificateId, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating mTLS certificate",
+			"Could not load mTLS certificate with ID "+certificateId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the certificate from the cluster.
+func (m *MtlsCertificate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.MtlsCertificate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting mTLS Certificate in Capella",
+			"Could not delete Capella mTLS certificate with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		certificateId  = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+	certificateUUID, err := utils.ParseUUID("id", certificateId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", err.Error())
+		return
+	}
+
+	deleteResp, err := m.ClientV2.DeleteMtlsCertificateWithResponse(ctx, orgUUID, projUUID, clusterUUID, certificateUUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting mTLS Certificate in Capella",
+			"Could not delete Capella mTLS certificate with ID "+certificateId+": "+err.Error(),
+		)
+		return
+	}
+
+	switch deleteResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK, http.StatusAccepted:
+	case http.StatusNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting mTLS Certificate in Capella",
+			fmt.Sprintf("Could not delete Capella mTLS certificate with ID %s, unexpected response status %d: %s", certificateId, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+}
+
+// waitForMtlsCertificateLoaded polls the certificate until Capella has loaded it onto the cluster,
+// then returns the refreshed resource state. A failed load is returned as an error.
+func (m *MtlsCertificate) waitForMtlsCertificateLoaded(
+	ctx context.Context,
+	organizationId, projectId, clusterId, certificateId string,
+	prior *providerschema.MtlsCertificate,
+) (*providerschema.MtlsCertificate, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithTimeout(ctx, mtlsCertificateLoadTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(mtlsCertificatePollInterval)
+	defer ticker.Stop()
+
+	for {
+		state, err := m.retrieveMtlsCertificate(ctx, organizationId, projectId, clusterId, certificateId, prior)
+		if err != nil {
+			return nil, err
+		}
+
+		switch apigen.MtlsCertificateStatus(state.Status.ValueString()) {
+		case apigen.MtlsCertificateStatusLoaded:
+			return state, nil
+		case apigen.MtlsCertificateStatusLoadFailed, apigen.MtlsCertificateStatusUpdateFailed:
+			return nil, fmt.Errorf("certificate status is %s", state.Status.ValueString())
+		default:
+			tflog.Info(ctx, "waiting for mTLS certificate to be loaded", map[string]interface{}{
+				"status": state.Status.ValueString(),
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out while waiting for the mTLS certificate to be loaded: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// retrieveMtlsCertificate retrieves the certificate and converts it into Terraform state.
+// errors.ErrNotFound is returned when the certificate does not exist.
+func (m *MtlsCertificate) retrieveMtlsCertificate(
+	ctx context.Context,
+	organizationId, projectId, clusterId, certificateId string,
+	prior *providerschema.MtlsCertificate,
+) (*providerschema.MtlsCertificate, error) {
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	certificateUUID, err := utils.ParseUUID("id", certificateId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := m.ClientV2.GetMtlsCertificateWithResponse(ctx, orgUUID, projUUID, clusterUUID, certificateUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	certificate := getResp.JSON200
+
+	audit := providerschema.NewCouchbaseAuditData(api.CouchbaseAuditData(certificate.Audit))
+	auditObj, diags := types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+	if diags.HasError() {
+		return nil, errors.ErrUnableToConvertAuditData
+	}
+
+	return providerschema.NewMtlsCertificate(*certificate, organizationId, projectId, clusterId, prior, auditObj), nil
+}
+
+// newMtlsCertificateRequest builds the create and update request body from the plan.
+func newMtlsCertificateRequest(plan providerschema.MtlsCertificate) apigen.MtlsCertificateRequest {
+	request := apigen.MtlsCertificateRequest{
+		Name:        plan.Name.ValueString(),
+		Certificate: plan.Certificate.ValueString(),
+	}
+	if !plan.Intermediates.IsNull() && !plan.Intermediates.IsUnknown() {
+		intermediates := plan.Intermediates.ValueString()
+		request.Intermediates = &intermediates
+	}
+	return request
+}
@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	customvalidator "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema/validator"
+)
+
+var mtlsCertificateBuilder = capellaschema.NewSchemaBuilder("mtlsCertificate", "MtlsCertificateRequest")
+
+// MtlsCertificateSchema returns the schema for the mtls_certificate resource.
+func MtlsCertificateSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", mtlsCertificateBuilder, stringAttribute([]string{computed, useStateForUnknown}), "MtlsCertificate")
+	capellaschema.AddAttr(attrs, "organization_id", mtlsCertificateBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", mtlsCertificateBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", mtlsCertificateBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "name", mtlsCertificateBuilder, requiredStringAttributeNoReplace())
+
+	// The certificates are parsed at plan time so a malformed PEM fails before any API call.
+	capellaschema.AddAttr(attrs, "certificate", mtlsCertificateBuilder, stringAttribute([]string{required}, customvalidator.PEMCertificate()))
+	capellaschema.AddAttr(attrs, "intermediates", mtlsCertificateBuilder, stringAttribute([]string{optional}, customvalidator.PEMCertificateChain()))
+
+	capellaschema.AddAttr(attrs, "status", mtlsCertificateBuilder, stringAttribute([]string{computed}), "MtlsCertificate")
+	capellaschema.AddAttr(attrs, "audit", mtlsCertificateBuilder, computedAuditAttribute())
+
+	return schema.Schema{
+		MarkdownDescription: "Manages a client CA certificate trusted by a cluster for mutual TLS. " +
+			"The certificate and any intermediates must be PEM encoded.",
+		Attributes: attrs,
+	}
+}
@@ -0,0 +1,301 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &MtlsConfiguration{}
+	_ resource.ResourceWithConfigure   = &MtlsConfiguration{}
+	_ resource.ResourceWithImportState = &MtlsConfiguration{}
+)
+
+// MtlsConfiguration is the mTLS configuration resource implementation.
+type MtlsConfiguration struct {
+	*providerschema.Data
+}
+
+// NewMtlsConfiguration is a helper function to simplify the provider implementation.
+func NewMtlsConfiguration() resource.Resource {
+	return &MtlsConfiguration{}
+}
+
+// Metadata returns the mTLS configuration resource type name.
+func (m *MtlsConfiguration) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mtls_configuration"
+}
+
+// Schema defines the schema for the mTLS configuration resource.
+func (m *MtlsConfiguration) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = MtlsConfigurationSchema()
+}
+
+// Configure adds the provider configured client to the mTLS configuration resource.
+func (m *MtlsConfiguration) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	m.Data = data
+}
+
+// ImportState imports the mTLS configuration of a cluster.
+func (m *MtlsConfiguration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to cluster_id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+}
+
+// Create applies the mTLS configuration to the cluster. The configuration always exists,
+// so creating the resource takes over the current configuration.
+func (m *MtlsConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.MtlsConfiguration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+	)
+
+	if err := m.updateMtlsConfiguration(ctx, organizationId, projectId, clusterId, newMtlsConfigRequest(plan)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating mTLS configuration",
+			"Could not configure mTLS for cluster "+clusterId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := m.retrieveMtlsConfiguration(ctx, organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error reading mTLS configuration",
+			"Could not read mTLS configuration for cluster "+clusterId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the mTLS configuration of the cluster.
+func (m *MtlsConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.MtlsConfiguration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading mTLS Configuration in Capella",
+			"Could not read Capella mTLS configuration for cluster "+state.ClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+	)
+
+	refreshedState, err := m.retrieveMtlsConfiguration(ctx, organizationId, projectId, clusterId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading mTLS Configuration in Capella",
+			"Could not read Capella mTLS configuration for cluster "+clusterId+": "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update applies the changed mTLS configuration to the cluster.
+func (m *MtlsConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan providerschema.MtlsConfiguration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := plan.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating mTLS configuration",
+			"Could not update mTLS configuration for cluster "+plan.ClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+	)
+
+	if err := m.updateMtlsConfiguration(ctx, organizationId, projectId, clusterId, newMtlsConfigRequest(plan)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating mTLS configuration",
+			"Could not update mTLS configuration for cluster "+clusterId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := m.retrieveMtlsConfiguration(ctx, organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating mTLS configuration",
+			"Could not read mTLS configuration for cluster "+clusterId+" after update: "+api.ParseError(err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete disables mTLS on the cluster and clears the prefixes.
+func (m *MtlsConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.MtlsConfiguration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting mTLS Configuration in Capella",
+			"Could not disable mTLS for cluster "+state.ClusterId.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+	)
+
+	disable := apigen.UpdateMtlsConfigRequest{State: apigen.UpdateMtlsConfigRequestStateDisable}
+	if err := m.updateMtlsConfiguration(ctx, organizationId, projectId, clusterId, disable); err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server")
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting mTLS Configuration in Capella",
+			"Could not disable mTLS for cluster "+clusterId+": "+err.Error(),
+		)
+	}
+}
+
+// updateMtlsConfiguration sends the mTLS configuration to Capella.
+// errors.ErrNotFound is returned when the cluster does not exist.
+func (m *MtlsConfiguration) updateMtlsConfiguration(
+	ctx context.Context,
+	organizationId, projectId, clusterId string,
+	request apigen.UpdateMtlsConfigRequest,
+) error {
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		return err
+	}
+
+	updateResp, err := m.ClientV2.UpdateMtlsConfigurationWithResponse(ctx, orgUUID, projUUID, clusterUUID, request)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch updateResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return errors.ErrNotFound
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", updateResp.StatusCode(), string(updateResp.Body))
+	}
+}
+
+// retrieveMtlsConfiguration retrieves the mTLS configuration and converts it into Terraform state.
+// errors.ErrNotFound is returned when the cluster does not exist.
+func (m *MtlsConfiguration) retrieveMtlsConfiguration(
+	ctx context.Context,
+	organizationId, projectId, clusterId string,
+) (*providerschema.MtlsConfiguration, error) {
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := m.ClientV2.GetMtlsConfigurationWithResponse(ctx, orgUUID, projUUID, clusterUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	return providerschema.NewMtlsConfiguration(*getResp.JSON200, organizationId, projectId, clusterId), nil
+}
+
+// newMtlsConfigRequest builds the update request body from the plan.
+func newMtlsConfigRequest(plan providerschema.MtlsConfiguration) apigen.UpdateMtlsConfigRequest {
+	request := apigen.UpdateMtlsConfigRequest{
+		State: apigen.UpdateMtlsConfigRequestState(plan.State.ValueString()),
+	}
+
+	if len(plan.Prefixes) > 0 {
+		prefixes := make([]apigen.MtlsPrefix, 0, len(plan.Prefixes))
+		for _, prefix := range plan.Prefixes {
+			prefixes = append(prefixes, apigen.MtlsPrefix{
+				Path:      apigen.MtlsPrefixPath(prefix.Path.ValueString()),
+				Prefix:    prefix.Prefix.ValueStringPointer(),
+				Delimiter: prefix.Delimiter.ValueStringPointer(),
+			})
+		}
+		request.Prefixes = &prefixes
+	}
+
+	return request
+}
@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var mtlsConfigurationBuilder = capellaschema.NewSchemaBuilder("mtlsConfiguration", "UpdateMtlsConfigRequest")
+
+// MtlsConfigurationSchema returns the schema for the mtls_configuration resource.
+func MtlsConfigurationSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", mtlsConfigurationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", mtlsConfigurationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", mtlsConfigurationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "state", mtlsConfigurationBuilder, stringAttribute([]string{required}, stringvalidator.OneOf(
+		string(apigen.UpdateMtlsConfigRequestStateDisable),
+		string(apigen.UpdateMtlsConfigRequestStateHybrid),
+		string(apigen.UpdateMtlsConfigRequestStateMandatory),
+	)))
+
+	prefixAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(prefixAttrs, "path", mtlsConfigurationBuilder, stringAttribute([]string{required}, stringvalidator.OneOf(
+		string(apigen.SubjectCn),
+		string(apigen.SanDns),
+		string(apigen.SanEmail),
+		string(apigen.SanUri),
+	)), "MtlsPrefix")
+	capellaschema.AddAttr(prefixAttrs, "prefix", mtlsConfigurationBuilder, stringAttribute([]string{optional}), "MtlsPrefix")
+	capellaschema.AddAttr(prefixAttrs, "delimiter", mtlsConfigurationBuilder, stringAttribute([]string{optional}, stringvalidator.LengthBetween(1, 1)), "MtlsPrefix")
+
+	// Couchbase Server tries the prefixes in order, so they are a list rather than a set.
+	capellaschema.AddAttr(attrs, "prefixes", mtlsConfigurationBuilder, &schema.ListNestedAttribute{
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: prefixAttrs,
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "Manages the mutual TLS configuration of a cluster. " +
+			"Destroying this resource disables mTLS on the cluster.",
+		Attributes: attrs,
+	}
+}
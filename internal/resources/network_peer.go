@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,6 +28,10 @@ var (
 	_ resource.ResourceWithImportState = &NetworkPeer{}
 )
 
+// networkPeerTimeout is how long a network peer may take to be created or deleted
+// when the timeouts block does not set a value.
+const networkPeerTimeout = 10 * time.Minute
+
 const errorMessageWhileNetworkPeerCreation = "There is an error during network peer creation. Please check in Capella to see if any hanging resources" +
 	" have been created, unexpected error: "
 
@@ -64,6 +69,13 @@ func (n *NetworkPeer) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, networkPeerTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	networkPeerRequest := network_peer_api.CreateNetworkPeeringRequest{
 		Name:         plan.Name.ValueString(),
 		ProviderType: plan.ProviderType.ValueString(),
@@ -162,7 +174,7 @@ func (n *NetworkPeer) Create(ctx context.Context, req resource.CreateRequest, re
 			return
 		}
 
-		n.setRefreshedStateOrWarn(ctx, resp, organizationId, projectId, clusterId, peerID, plan.ProviderType.ValueString(), err)
+		n.setRefreshedStateOrWarn(ctx, resp, organizationId, projectId, clusterId, peerID, plan.ProviderType.ValueString(), plan.Timeouts, err)
 		return
 	}
 
@@ -182,13 +194,13 @@ func (n *NetworkPeer) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	n.setRefreshedStateOrWarn(ctx, resp, organizationId, projectId, clusterId, networkPeerResponse.Id.String(), plan.ProviderType.ValueString(), nil)
+	n.setRefreshedStateOrWarn(ctx, resp, organizationId, projectId, clusterId, networkPeerResponse.Id.String(), plan.ProviderType.ValueString(), plan.Timeouts, nil)
 }
 
 // setRefreshedStateOrWarn attempts to read the full peer state and set it on the response.
 // If the read fails (e.g. peer is in failed state with incomplete providerConfig), a warning
 // is emitted instead of an error so that the partial state (containing the ID) is preserved.
-func (n *NetworkPeer) setRefreshedStateOrWarn(ctx context.Context, resp *resource.CreateResponse, organizationId, projectId, clusterId, peerID, providerType string, originalErr error) {
+func (n *NetworkPeer) setRefreshedStateOrWarn(ctx context.Context, resp *resource.CreateResponse, organizationId, projectId, clusterId, peerID, providerType string, planTimeouts timeouts.Value, originalErr error) {
 	refreshedState, err := n.retrieveNetworkPeer(ctx, organizationId, projectId, clusterId, peerID, providerType)
 	if err != nil {
 		detail := "The network peer was created but its current status could not be fully read: " + err.Error()
@@ -202,6 +214,7 @@ func (n *NetworkPeer) setRefreshedStateOrWarn(ctx context.Context, resp *resourc
 		return
 	}
 
+	refreshedState.Timeouts = planTimeouts
 	diags := resp.State.Set(ctx, refreshedState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -308,6 +321,7 @@ func (n *NetworkPeer) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	refreshedState.Timeouts = state.Timeouts
 	diags = resp.State.Set(ctx, &refreshedState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -351,6 +365,13 @@ func (n *NetworkPeer) Delete(ctx context.Context, req resource.DeleteRequest, re
 		peerId         = IDs[providerschema.Id]
 	)
 
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, networkPeerTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	url := fmt.Sprintf(
 		"%s/v4/organizations/%s/projects/%s/clusters/%s/networkPeers/%s",
 		n.HostURL,
@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -93,5 +96,12 @@ func NetworkPeerSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "This resource allows you to manage network peering for an operational cluster.",
 		Attributes:          attrs,
+		Blocks: map[string]schema.Block{
+			// Network peers cannot be updated in place, so there is no update timeout.
+			"timeouts": timeouts.Block(context.Background(), timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
 	}
 }
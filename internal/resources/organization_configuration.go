@@ -0,0 +1,256 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &OrganizationConfiguration{}
+	_ resource.ResourceWithConfigure   = &OrganizationConfiguration{}
+	_ resource.ResourceWithImportState = &OrganizationConfiguration{}
+)
+
+// defaultSubdomain is the subdomain an organization has before it is configured.
+const defaultSubdomain = ""
+
+// OrganizationConfiguration is the organization configuration resource implementation.
+type OrganizationConfiguration struct {
+	*providerschema.Data
+}
+
+// NewOrganizationConfiguration is a helper function to simplify the provider implementation.
+func NewOrganizationConfiguration() resource.Resource {
+	return &OrganizationConfiguration{}
+}
+
+// Metadata returns the organization configuration resource type name.
+func (o *OrganizationConfiguration) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_configuration"
+}
+
+// Schema defines the schema for the organization configuration resource.
+func (o *OrganizationConfiguration) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = OrganizationConfigurationSchema()
+}
+
+// Configure adds the provider configured client to the organization configuration resource.
+func (o *OrganizationConfiguration) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	o.Data = data
+}
+
+// Create applies the organization configuration. The configuration always exists,
+// so creating the resource updates it in place.
+func (o *OrganizationConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.OrganizationConfiguration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := plan.OrganizationId.ValueString()
+	if err := o.putConfiguration(ctx, organizationId, plan.Subdomain.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization configuration",
+			"Could not update configuration of organization "+organizationId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := o.retrieveConfiguration(ctx, organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization configuration",
+			"Could not read configuration of organization "+organizationId+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the organization configuration.
+func (o *OrganizationConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.OrganizationConfiguration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization configuration",
+			"Could not validate organization configuration: "+err.Error(),
+		)
+		return
+	}
+
+	organizationId := IDs[providerschema.OrganizationId]
+	refreshedState, err := o.retrieveConfiguration(ctx, organizationId)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+		resp.State.RemoveResource(ctx)
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error reading organization configuration",
+			"Could not read configuration of organization "+organizationId+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the organization configuration.
+func (o *OrganizationConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan providerschema.OrganizationConfiguration
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := plan.OrganizationId.ValueString()
+	if err := o.putConfiguration(ctx, organizationId, plan.Subdomain.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating organization configuration",
+			"Could not update configuration of organization "+organizationId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := o.retrieveConfiguration(ctx, organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization configuration",
+			"Could not read configuration of organization "+organizationId+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete restores the default organization configuration, as the configuration
+// itself cannot be deleted.
+func (o *OrganizationConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.OrganizationConfiguration
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting organization configuration",
+			"Could not validate organization configuration: "+err.Error(),
+		)
+		return
+	}
+
+	organizationId := IDs[providerschema.OrganizationId]
+	err = o.putConfiguration(ctx, organizationId, defaultSubdomain)
+	switch {
+	case err == nil:
+	case err == errors.ErrNotFound:
+		tflog.Info(ctx, "resource doesn't exist in remote server")
+	default:
+		resp.Diagnostics.AddError(
+			"Error deleting organization configuration",
+			"Could not restore default configuration of organization "+organizationId+": "+err.Error(),
+		)
+	}
+}
+
+// ImportState imports the organization configuration using the organization ID.
+func (o *OrganizationConfiguration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("organization_id"), req, resp)
+}
+
+// putConfiguration replaces the configuration of the organization.
+// errors.ErrNotFound is returned when the organization does not exist.
+func (o *OrganizationConfiguration) putConfiguration(ctx context.Context, organizationId, subdomain string) error {
+	orgUUID, err := uuid.Parse(organizationId)
+	if err != nil {
+		return fmt.Errorf("could not parse organization_id: %w", err)
+	}
+
+	putResp, err := o.ClientV2.PutOrganizationConfigurationWithResponse(
+		ctx,
+		orgUUID,
+		&apigen.PutOrganizationConfigurationParams{},
+		apigen.UpdateOrganizationConfigurationRequest{Subdomain: subdomain},
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch putResp.StatusCode() {
+	case http.StatusNoContent, http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return errors.ErrNotFound
+	default:
+		return fmt.Errorf("unexpected response status %d: %s", putResp.StatusCode(), string(putResp.Body))
+	}
+}
+
+// retrieveConfiguration reads the organization configuration from the organization,
+// as there is no endpoint to get the configuration on its own.
+// errors.ErrNotFound is returned when the organization does not exist.
+func (o *OrganizationConfiguration) retrieveConfiguration(ctx context.Context, organizationId string) (*providerschema.OrganizationConfiguration, error) {
+	orgUUID, err := uuid.Parse(organizationId)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse organization_id: %w", err)
+	}
+
+	getResp, err := o.ClientV2.GetOrganizationByIDWithResponse(ctx, orgUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	return providerschema.NewOrganizationConfiguration(*getResp.JSON200, organizationId), nil
+}
@@ -0,0 +1,30 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var organizationConfigurationBuilder = capellaschema.NewSchemaBuilder("organizationConfiguration", "UpdateOrganizationConfigurationRequest")
+
+// maxSubdomainLength is the maximum length of an organization subdomain accepted by Capella.
+const maxSubdomainLength = 30
+
+func OrganizationConfigurationSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "organization_id", organizationConfigurationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "subdomain", organizationConfigurationBuilder, stringAttribute(
+		[]string{required},
+		stringvalidator.LengthBetween(1, maxSubdomainLength),
+	))
+
+	return schema.Schema{
+		MarkdownDescription: "Manages the organization-wide configuration of a Capella organization. " +
+			"There is exactly one configuration per organization, so only one instance of this resource should exist for a given organization. " +
+			"Destroying the resource clears the subdomain, restoring the organization to its default configuration.",
+		Attributes: attrs,
+	}
+}
@@ -57,7 +57,7 @@ var (
 	pollInterval = 30 * time.Second
 
 	// statusChangeTimeout bounds how long we wait for the service to reach the
-	// desired lifecycle state, end-to-end.
+	// desired lifecycle state, end-to-end, when the timeouts block does not set a value.
 	statusChangeTimeout = 60 * time.Minute
 )
 
@@ -83,14 +83,21 @@ func (p *PrivateEndpointService) Schema(_ context.Context, _ resource.SchemaRequ
 
 // Create enables private endpoint service.
 func (p *PrivateEndpointService) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan providerschema.PrivateEndpointService
+	var plan providerschema.PrivateEndpointServiceWithTimeouts
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := validateCreateEndpointService(plan)
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, statusChangeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	err := validateCreateEndpointService(plan.PrivateEndpointService)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error validating private endpoint service request",
@@ -128,7 +135,10 @@ func (p *PrivateEndpointService) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	diags = resp.State.Set(ctx, initializePrivateEndpointServicePlan(plan))
+	diags = resp.State.Set(ctx, providerschema.PrivateEndpointServiceWithTimeouts{
+		PrivateEndpointService: initializePrivateEndpointServicePlan(plan.PrivateEndpointService),
+		Timeouts:               plan.Timeouts,
+	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -159,7 +169,10 @@ func (p *PrivateEndpointService) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	diags = resp.State.Set(ctx, refreshedState)
+	diags = resp.State.Set(ctx, providerschema.PrivateEndpointServiceWithTimeouts{
+		PrivateEndpointService: *refreshedState,
+		Timeouts:               plan.Timeouts,
+	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -168,7 +181,7 @@ func (p *PrivateEndpointService) Create(ctx context.Context, req resource.Create
 
 // Read reads the private endpoint service status.
 func (p *PrivateEndpointService) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state providerschema.PrivateEndpointService
+	var state providerschema.PrivateEndpointServiceWithTimeouts
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -212,7 +225,10 @@ func (p *PrivateEndpointService) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	diags = resp.State.Set(ctx, refreshedState)
+	diags = resp.State.Set(ctx, providerschema.PrivateEndpointServiceWithTimeouts{
+		PrivateEndpointService: *refreshedState,
+		Timeouts:               state.Timeouts,
+	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -221,13 +237,20 @@ func (p *PrivateEndpointService) Read(ctx context.Context, req resource.ReadRequ
 
 // Update will enable/disable the private endpoint service.
 func (p *PrivateEndpointService) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var config providerschema.PrivateEndpointService
+	var config providerschema.PrivateEndpointServiceWithTimeouts
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, config.Timeouts.Update, statusChangeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	url := fmt.Sprintf(
 		"%s/v4/organizations/%s/projects/%s/clusters/%s/privateEndpointService",
 		p.HostURL,
@@ -295,7 +318,10 @@ func (p *PrivateEndpointService) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	diags = resp.State.Set(ctx, refreshedState)
+	diags = resp.State.Set(ctx, providerschema.PrivateEndpointServiceWithTimeouts{
+		PrivateEndpointService: *refreshedState,
+		Timeouts:               config.Timeouts,
+	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -304,7 +330,7 @@ func (p *PrivateEndpointService) Update(ctx context.Context, req resource.Update
 
 // Delete disables private endpoint service on the cluster.
 func (p *PrivateEndpointService) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state providerschema.PrivateEndpointService
+	var state providerschema.PrivateEndpointServiceWithTimeouts
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -331,6 +357,13 @@ func (p *PrivateEndpointService) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, statusChangeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	url := fmt.Sprintf(
 		"%s/v4/organizations/%s/projects/%s/clusters/%s/privateEndpointService",
 		p.HostURL,
@@ -442,12 +475,15 @@ func initializePrivateEndpointServicePlan(plan providerschema.PrivateEndpointSer
 // not mistaken for failure of the operation we just issued. When the status is
 // absent — which happens on GCP, when the private endpoint status feature flag
 // is disabled, or on older control planes — it falls back to the Enabled
-// boolean, preserving the previous behavior. The overall timeout remains as a
-// backstop.
+// boolean, preserving the previous behavior. The deadline of ctx, set from the
+// timeouts block, remains as a backstop; statusChangeTimeout applies when ctx
+// has none.
 func (p *PrivateEndpointService) waitUntilStatusChanges(ctx context.Context, finalState bool, organizationId, projectId, clusterId string) error {
-	var cancel context.CancelFunc
-	ctx, cancel = context.WithTimeout(ctx, statusChangeTimeout)
-	defer cancel()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, statusChangeTimeout)
+		defer cancel()
+	}
 
 	timer := time.NewTimer(0)
 	defer timer.Stop()
@@ -613,7 +649,9 @@ func (p *PrivateEndpointService) cleanupFailedEnable(ctx context.Context, organi
 func (p *PrivateEndpointService) handleFailedEnable(ctx context.Context, state *tfsdk.State, diags *diag.Diagnostics, organizationId, projectId, clusterId string, cause error) {
 	tflog.Error(ctx, "private endpoint service enablement failed; triggering cleanup and removing from state")
 
-	cleanupErr := p.cleanupFailedEnable(ctx, organizationId, projectId, clusterId)
+	// The enable may have failed by running out its create or update timeout, so the
+	// cleanup is detached from that deadline and bounded by cleanupTimeout instead.
+	cleanupErr := p.cleanupFailedEnable(context.WithoutCancel(ctx), organizationId, projectId, clusterId)
 	state.RemoveResource(ctx)
 
 	if cleanupErr != nil {
@@ -38,5 +38,8 @@ func PrivateEndpointServiceSchema() schema.Schema {
 			"This is intentional: the resource disappearing from state is expected, and the next `terraform apply` performs a clean re-create. " +
 			"If the automatic cleanup cannot complete, the error will say so — contact Couchbase Capella Support to check for orphaned resources in your cloud account.",
 		Attributes: attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
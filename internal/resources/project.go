@@ -12,7 +12,6 @@ import (
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
 	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -343,8 +342,8 @@ func (r *Project) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 // Unfortunately the terraform import CLI doesn't allow us to pass multiple IDs at this point
 // and hence this workaround has been applied.
 func (r *Project) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Names in the import ID are resolved to IDs before they are saved to the id attribute.
+	importStateByName(ctx, r.Data, req, resp, "Project", "project_id", "project_name")
 }
 
 func (r *Project) retrieveProject(ctx context.Context, organizationId, projectId string) (*providerschema.OneProject, error) {
@@ -0,0 +1,704 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &Replication{}
+	_ resource.ResourceWithConfigure   = &Replication{}
+	_ resource.ResourceWithImportState = &Replication{}
+)
+
+const errorMessageAfterReplicationCreation = "Replication creation is successful, but encountered an error while checking the current" +
+	" state of the replication. Please run `terraform plan` after 1-2 minutes to know the" +
+	" current replication state. Additionally, run `terraform apply --refresh-only` to update" +
+	" the state from remote, unexpected error: "
+
+const errorMessageWhileReplicationCreation = "There is an error during replication creation. Please check in Capella to see if any hanging resources" +
+	" have been created, unexpected error: "
+
+// replicationPollInterval is how often a replication job and the replication status are
+// polled. replicationTimeout is the default create and update timeout.
+var (
+	replicationPollInterval = 10 * time.Second
+	replicationTimeout      = 30 * time.Minute
+)
+
+// Replication is the XDCR replication resource implementation.
+type Replication struct {
+	*providerschema.Data
+}
+
+// NewReplication is a helper function to simplify the provider implementation.
+func NewReplication() resource.Resource {
+	return &Replication{}
+}
+
+// Metadata returns the replication resource type name.
+func (r *Replication) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication"
+}
+
+// Schema defines the schema for the replication resource.
+func (r *Replication) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ReplicationSchema()
+}
+
+// Configure adds the provider configured client to the replication resource.
+func (r *Replication) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerschema.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerschema.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.Data = data
+}
+
+// ImportState imports a remote replication that is not created by Terraform.
+func (r *Replication) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create creates a new replication and waits for it to reach a final status.
+func (r *Replication) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan providerschema.Replication
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organizationId = plan.OrganizationId.ValueString()
+		projectId      = plan.ProjectId.ValueString()
+		clusterId      = plan.ClusterId.ValueString()
+	)
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, replicationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	createReq := buildCreateReplicationRequest(plan)
+	createResp, err := r.ClientV2.CreateReplicationWithResponse(ctx, orgUUID, projUUID, clusterUUID, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating replication",
+			errorMessageWhileReplicationCreation+err.Error(),
+		)
+		return
+	}
+
+	var replicationId, reverseReplicationId string
+	switch {
+	case createResp.JSON201 != nil:
+		replicationId = createResp.JSON201.ReplicationId
+		if createResp.JSON201.ReverseReplicationId != nil {
+			reverseReplicationId = *createResp.JSON201.ReverseReplicationId
+		}
+	case createResp.JSON202 != nil:
+		jobUUID, err := uuid.Parse(createResp.JSON202.JobId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating replication",
+				errorMessageWhileReplicationCreation+"invalid job ID "+createResp.JSON202.JobId,
+			)
+			return
+		}
+		job, err := r.waitForReplicationJob(ctx, orgUUID, projUUID, clusterUUID, jobUUID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating replication",
+				errorMessageWhileReplicationCreation+err.Error(),
+			)
+			return
+		}
+		replicationId = *job.ReplicationId
+		if job.ReverseReplicationId != nil {
+			reverseReplicationId = *job.ReverseReplicationId
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Error creating replication",
+			errorMessageWhileReplicationCreation+fmt.Sprintf("unexpected response status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	// Persist the IDs straight away so that a failure in the wait below
+	// does not orphan the replication outside of Terraform state.
+	plan.Id = types.StringValue(replicationId)
+	plan.ReverseReplicationId = types.StringNull()
+	if reverseReplicationId != "" {
+		plan.ReverseReplicationId = types.StringValue(reverseReplicationId)
+	}
+	interim := plan
+	interim.Status = types.StringNull()
+	interim.Error = types.StringNull()
+	interim.ChangesLeft = types.Int64Null()
+	interim.Audit = types.ObjectNull(providerschema.ReplicationAudit{}.AttributeTypes())
+	if interim.Priority.IsUnknown() {
+		interim.Priority = types.StringNull()
+	}
+	if interim.NetworkUsageLimit.IsUnknown() {
+		interim.NetworkUsageLimit = types.Int64Null()
+	}
+	diags = resp.State.Set(ctx, interim)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Paused.ValueBool() {
+		if err := r.setReplicationPaused(ctx, orgUUID, projUUID, clusterUUID, replicationId, true); err != nil {
+			resp.Diagnostics.AddError(
+				"Error pausing replication",
+				errorMessageAfterReplicationCreation+err.Error(),
+			)
+			return
+		}
+	}
+
+	refreshedState, err := r.checkReplicationStatus(ctx, organizationId, projectId, clusterId, replicationId, plan.Paused.ValueBool(), &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating replication",
+			errorMessageAfterReplicationCreation+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read reads the replication information.
+func (r *Replication) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state providerschema.Replication
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Replication in Capella",
+			"Could not read Capella replication with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		replicationId  = IDs[providerschema.Id]
+	)
+
+	replicationResp, err := r.getReplication(ctx, organizationId, projectId, clusterId, replicationId)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			tflog.Info(ctx, "resource doesn't exist in remote server removing resource from state file")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Replication in Capella",
+			"Could not read Capella replication with ID "+replicationId+": "+err.Error(),
+		)
+		return
+	}
+
+	refreshedState, err := r.morphReplication(ctx, replicationResp, organizationId, projectId, clusterId, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Replication in Capella",
+			"Could not read Capella replication with ID "+replicationId+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the replication settings and pauses or resumes the replication as requested.
+func (r *Replication) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state providerschema.Replication
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Replication in Capella",
+			"Could not update Capella replication with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		replicationId  = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, replicationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	if replicationSettingsChanged(plan, state) {
+		updateResp, err := r.ClientV2.UpdateReplicationWithResponse(ctx, orgUUID, projUUID, clusterUUID, replicationId, buildUpdateReplicationRequest(plan))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Replication in Capella",
+				"Could not update Capella replication with ID "+replicationId+": "+err.Error(),
+			)
+			return
+		}
+		if !isReplicationRequestAccepted(updateResp.StatusCode()) {
+			resp.Diagnostics.AddError(
+				"Error Updating Replication in Capella",
+				"Could not update Capella replication with ID "+replicationId+": "+string(updateResp.Body),
+			)
+			return
+		}
+	}
+
+	if !plan.Paused.Equal(state.Paused) {
+		if err := r.setReplicationPaused(ctx, orgUUID, projUUID, clusterUUID, replicationId, plan.Paused.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Replication in Capella",
+				"Could not change the activation status of replication with ID "+replicationId+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	refreshedState, err := r.checkReplicationStatus(ctx, organizationId, projectId, clusterId, replicationId, plan.Paused.ValueBool(), &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Replication in Capella",
+			"Could not update Capella replication with ID "+replicationId+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the replication, and for twoWay replications also its reverse replication.
+func (r *Replication) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state providerschema.Replication
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs, err := state.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Replication in Capella",
+			"Could not delete Capella replication with ID "+state.Id.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	var (
+		organizationId = IDs[providerschema.OrganizationId]
+		projectId      = IDs[providerschema.ProjectId]
+		clusterId      = IDs[providerschema.ClusterId]
+		replicationId  = IDs[providerschema.Id]
+	)
+
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing IDs", "Could not parse resource IDs: "+err.Error())
+		return
+	}
+
+	if err := r.deleteReplication(ctx, orgUUID, projUUID, clusterUUID, replicationId); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Replication in Capella",
+			"Could not delete Capella replication with ID "+replicationId+": "+err.Error(),
+		)
+		return
+	}
+
+	// The reverse replication of a twoWay replication lives on the target cluster,
+	// which may belong to a different project. Only delete it when it is still
+	// visible from the source cluster; otherwise Capella removes it together with
+	// the forward replication.
+	if reverseId := state.ReverseReplicationId.ValueString(); reverseId != "" {
+		if err := r.deleteReplication(ctx, orgUUID, projUUID, clusterUUID, reverseId); err != nil {
+			tflog.Warn(ctx, "could not delete reverse replication", map[string]interface{}{
+				"reverse_replication_id": reverseId,
+				"err":                    err.Error(),
+			})
+		}
+	}
+}
+
+// deleteReplication deletes a single replication. A replication that no longer exists is not an error.
+func (r *Replication) deleteReplication(ctx context.Context, orgUUID, projUUID, clusterUUID uuid.UUID, replicationId string) error {
+	deleteResp, err := r.ClientV2.DeleteReplicationWithResponse(ctx, orgUUID, projUUID, clusterUUID, replicationId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case deleteResp.StatusCode() == http.StatusNotFound:
+		tflog.Info(ctx, "replication has already been deleted", map[string]interface{}{"replication_id": replicationId})
+		return nil
+	case !isReplicationRequestAccepted(deleteResp.StatusCode()):
+		return fmt.Errorf("unexpected response status %d: %s", deleteResp.StatusCode(), string(deleteResp.Body))
+	}
+	return nil
+}
+
+// getReplication retrieves the replication from Capella. errors.ErrNotFound is returned
+// when the replication does not exist.
+func (r *Replication) getReplication(ctx context.Context, organizationId, projectId, clusterId, replicationId string) (*apigen.GetReplicationResponse, error) {
+	orgUUID, projUUID, clusterUUID, err := parseClusterUUIDs(organizationId, projectId, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	getResp, err := r.ClientV2.GetReplicationWithResponse(ctx, orgUUID, projUUID, clusterUUID, replicationId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+	}
+
+	switch {
+	case getResp.StatusCode() == http.StatusNotFound:
+		return nil, errors.ErrNotFound
+	case getResp.JSON200 == nil:
+		return nil, fmt.Errorf("unexpected response status %d: %s", getResp.StatusCode(), string(getResp.Body))
+	}
+
+	return getResp.JSON200, nil
+}
+
+// setReplicationPaused pauses or resumes the replication.
+func (r *Replication) setReplicationPaused(ctx context.Context, orgUUID, projUUID, clusterUUID uuid.UUID, replicationId string, paused bool) error {
+	var (
+		statusCode int
+		body       []byte
+	)
+
+	if paused {
+		pauseResp, err := r.ClientV2.PauseReplicationWithResponse(ctx, orgUUID, projUUID, clusterUUID, replicationId)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body = pauseResp.StatusCode(), pauseResp.Body
+	} else {
+		resumeResp, err := r.ClientV2.ResumeReplicationWithResponse(ctx, orgUUID, projUUID, clusterUUID, replicationId)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errors.ErrExecutingRequest, err)
+		}
+		statusCode, body = resumeResp.StatusCode(), resumeResp.Body
+	}
+
+	if !isReplicationRequestAccepted(statusCode) {
+		return fmt.Errorf("unexpected response status %d: %s", statusCode, string(body))
+	}
+	return nil
+}
+
+// waitForReplicationJob polls an asynchronous replication creation job until it completes
+// or ctx is done.
+func (r *Replication) waitForReplicationJob(ctx context.Context, orgUUID, projUUID, clusterUUID, jobId uuid.UUID) (*apigen.GetReplicationJobResponse, error) {
+	ticker := time.NewTicker(replicationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("replication creation job %s timed out: %w", jobId, ctx.Err())
+		case <-ticker.C:
+			jobResp, err := r.ClientV2.GetReplicationJobWithResponse(ctx, orgUUID, projUUID, clusterUUID, jobId)
+			if err != nil || jobResp.JSON200 == nil {
+				tflog.Info(ctx, "retrying after error polling replication job", map[string]interface{}{"job_id": jobId})
+				continue
+			}
+
+			job := jobResp.JSON200
+			switch job.State {
+			case apigen.GetReplicationJobResponseStateComplete:
+				if job.ReplicationId == nil {
+					return nil, fmt.Errorf("replication creation job %s completed without a replication ID", jobId)
+				}
+				return job, nil
+			case apigen.GetReplicationJobResponseStateFailed,
+				apigen.GetReplicationJobResponseStateKilled,
+				apigen.GetReplicationJobResponseStateSkipped,
+				apigen.GetReplicationJobResponseStateNotfound:
+				lastError := ""
+				if job.LastError != nil {
+					lastError = *job.LastError
+				}
+				return nil, fmt.Errorf("replication creation job %s ended in state %s: %s", jobId, job.State, lastError)
+			}
+
+			tflog.Info(ctx, "waiting for replication creation job to complete", map[string]interface{}{"job_id": jobId, "state": job.State})
+		}
+	}
+}
+
+// checkReplicationStatus polls the replication until it settles into the requested
+// running or paused status, or ctx is done, and returns the refreshed state.
+func (r *Replication) checkReplicationStatus(
+	ctx context.Context,
+	organizationId, projectId, clusterId, replicationId string,
+	paused bool,
+	prior *providerschema.Replication,
+) (*providerschema.Replication, error) {
+	want := apigen.GetReplicationResponseStatusRunning
+	if paused {
+		want = apigen.GetReplicationResponseStatusPaused
+	}
+
+	ticker := time.NewTicker(replicationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("replication status transition timed out: %w", ctx.Err())
+		case <-ticker.C:
+			replicationResp, err := r.getReplication(ctx, organizationId, projectId, clusterId, replicationId)
+			if err != nil {
+				tflog.Info(ctx, "retrying after error polling replication status", map[string]interface{}{"error": err.Error()})
+				continue
+			}
+
+			switch replicationResp.Status {
+			case want:
+				return r.morphReplication(ctx, replicationResp, organizationId, projectId, clusterId, prior)
+			case apigen.GetReplicationResponseStatusFailed:
+				message := ""
+				if replicationResp.Error != nil {
+					message = *replicationResp.Error
+				}
+				return nil, fmt.Errorf("replication %s failed: %s", replicationId, message)
+			}
+
+			tflog.Info(ctx, "waiting for replication to reach status "+string(want), map[string]interface{}{"status": replicationResp.Status})
+		}
+	}
+}
+
+// morphReplication converts the API response into Terraform state.
+func (r *Replication) morphReplication(
+	ctx context.Context,
+	replicationResp *apigen.GetReplicationResponse,
+	organizationId, projectId, clusterId string,
+	prior *providerschema.Replication,
+) (*providerschema.Replication, error) {
+	audit := providerschema.NewReplicationAudit(replicationResp.Audit)
+	auditObj, diags := types.ObjectValueFrom(ctx, audit.AttributeTypes(), audit)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s: %s", errors.ErrUnableToConvertAuditData, diags.Errors())
+	}
+
+	return providerschema.NewReplication(replicationResp, organizationId, projectId, clusterId, prior, auditObj), nil
+}
+
+// buildCreateReplicationRequest builds the create request from the Terraform plan.
+func buildCreateReplicationRequest(plan providerschema.Replication) apigen.CreateReplicationRequest {
+	mode := apigen.Async
+	createReq := apigen.CreateReplicationRequest{
+		Mode:         &mode,
+		SourceBucket: plan.SourceBucket.ValueString(),
+		Filter:       buildReplicationFilter(plan.Filter),
+		Mappings:     buildReplicationMappings(plan.Mappings),
+	}
+
+	createReq.Target.Cluster = plan.Target.Cluster.ValueString()
+	createReq.Target.Bucket = plan.Target.Bucket.ValueString()
+	if targetType := utils.StringPointerIfKnown(plan.Target.Type); targetType != nil {
+		t := apigen.CreateReplicationRequestTargetType(*targetType)
+		createReq.Target.Type = &t
+	}
+	if direction := utils.StringPointerIfKnown(plan.Direction); direction != nil {
+		d := apigen.CreateReplicationRequestDirection(*direction)
+		createReq.Direction = &d
+	}
+	if priority := utils.StringPointerIfKnown(plan.Priority); priority != nil {
+		p := apigen.CreateReplicationRequestPriority(*priority)
+		createReq.Priority = &p
+	}
+	if limit := utils.Int64PointerIfKnown(plan.NetworkUsageLimit); limit != nil {
+		l := int(*limit)
+		createReq.NetworkUsageLimit = &l
+	}
+
+	return createReq
+}
+
+// buildUpdateReplicationRequest builds the update request from the Terraform plan.
+func buildUpdateReplicationRequest(plan providerschema.Replication) apigen.UpdateReplicationRequest {
+	// Without mappings the whole bucket is replicated.
+	allScopes := len(plan.Mappings) == 0
+	updateReq := apigen.UpdateReplicationRequest{
+		AllScopes: &allScopes,
+		Filter:    buildReplicationFilter(plan.Filter),
+		Mappings:  buildReplicationMappings(plan.Mappings),
+	}
+
+	if priority := utils.StringPointerIfKnown(plan.Priority); priority != nil {
+		p := apigen.UpdateReplicationRequestPriority(*priority)
+		updateReq.Priority = &p
+	}
+	if limit := utils.Int64PointerIfKnown(plan.NetworkUsageLimit); limit != nil {
+		l := int(*limit)
+		updateReq.NetworkUsageLimit = &l
+	}
+
+	return updateReq
+}
+
+func buildReplicationMappings(mappings []providerschema.ReplicationMapping) *apigen.Mappings {
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	apiMappings := make(apigen.Mappings, len(mappings))
+	for i, m := range mappings {
+		apiMappings[i].SourceScope = m.SourceScope.ValueString()
+		apiMappings[i].TargetScope = m.TargetScope.ValueString()
+		if len(m.Collections) == 0 {
+			continue
+		}
+		collections := make([]struct {
+			SourceCollection string `json:"sourceCollection"`
+			TargetCollection string `json:"targetCollection"`
+		}, len(m.Collections))
+		for j, c := range m.Collections {
+			collections[j].SourceCollection = c.SourceCollection.ValueString()
+			collections[j].TargetCollection = c.TargetCollection.ValueString()
+		}
+		apiMappings[i].Collections = &collections
+	}
+	return &apiMappings
+}
+
+func buildReplicationFilter(filter *providerschema.ReplicationFilter) *apigen.Filter {
+	if filter == nil {
+		return nil
+	}
+
+	apiFilter := apigen.Filter{}
+	if opts := filter.DocumentExcludeOptions; opts != nil {
+		apiFilter.DocumentExcludeOptions = &struct {
+			Binary     *bool `json:"binary,omitempty"`
+			Deletion   *bool `json:"deletion,omitempty"`
+			Expiration *bool `json:"expiration,omitempty"`
+			Ttl        *bool `json:"ttl,omitempty"`
+		}{
+			Binary:     utils.BoolPointerIfKnown(opts.Binary),
+			Deletion:   utils.BoolPointerIfKnown(opts.Deletion),
+			Expiration: utils.BoolPointerIfKnown(opts.Expiration),
+			Ttl:        utils.BoolPointerIfKnown(opts.Ttl),
+		}
+	}
+	if expr := filter.Expressions; expr != nil {
+		apiFilter.Expressions = &struct {
+			RegEx        *string `json:"regEx,omitempty"`
+			SkipRestream *bool   `json:"skipRestream,omitempty"`
+		}{
+			RegEx:        utils.StringPointerIfKnown(expr.RegEx),
+			SkipRestream: utils.BoolPointerIfKnown(expr.SkipRestream),
+		}
+	}
+	return &apiFilter
+}
+
+// replicationSettingsChanged reports whether any attribute handled by UpdateReplication changed.
+func replicationSettingsChanged(plan, state providerschema.Replication) bool {
+	if !plan.Priority.IsUnknown() && !plan.Priority.Equal(state.Priority) {
+		return true
+	}
+	if !plan.NetworkUsageLimit.IsUnknown() && !plan.NetworkUsageLimit.Equal(state.NetworkUsageLimit) {
+		return true
+	}
+	return !reflect.DeepEqual(buildReplicationMappings(plan.Mappings), buildReplicationMappings(state.Mappings)) ||
+		!reflect.DeepEqual(buildReplicationFilter(plan.Filter), buildReplicationFilter(state.Filter))
+}
+
+// isReplicationRequestAccepted reports whether an update, pause, resume or delete
+// request was accepted by Capella.
+func isReplicationRequestAccepted(statusCode int) bool {
+	switch statusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseClusterUUIDs parses the organization, project and cluster IDs into UUIDs for the generated API client.
+func parseClusterUUIDs(organizationId, projectId, clusterId string) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	uuids, err := utils.ParseUUIDs(
+		utils.IDField{Name: "organization_id", Value: organizationId},
+		utils.IDField{Name: "project_id", Value: projectId},
+		utils.IDField{Name: "cluster_id", Value: clusterId},
+	)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids[0], uuids[1], uuids[2], nil
+}
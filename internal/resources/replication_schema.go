@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	capellaschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+var replicationBuilder = capellaschema.NewSchemaBuilder("replication")
+
+// ReplicationSchema returns the schema for the replication resource.
+func ReplicationSchema() schema.Schema {
+	attrs := make(map[string]schema.Attribute)
+
+	capellaschema.AddAttr(attrs, "id", replicationBuilder, stringAttribute([]string{computed, useStateForUnknown}), "GetReplicationResponse")
+	capellaschema.AddAttr(attrs, "organization_id", replicationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "project_id", replicationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "cluster_id", replicationBuilder, requiredUUIDStringAttribute())
+	capellaschema.AddAttr(attrs, "source_bucket", replicationBuilder, requiredNonEmptyStringAttribute(), "CreateReplicationRequest")
+
+	targetAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(targetAttrs, "cluster", replicationBuilder, requiredNonEmptyStringAttribute(), "target")
+	capellaschema.AddAttr(targetAttrs, "bucket", replicationBuilder, requiredNonEmptyStringAttribute(), "target")
+	capellaschema.AddAttr(targetAttrs, "type", replicationBuilder, stringDefaultAttribute("capella", optional, computed, requiresReplace), "target")
+
+	capellaschema.AddAttr(attrs, "target", replicationBuilder, &schema.SingleNestedAttribute{
+		Required:   true,
+		Attributes: targetAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}, "CreateReplicationRequest")
+
+	capellaschema.AddAttr(attrs, "direction", replicationBuilder, stringDefaultAttribute("oneWay", optional, computed, requiresReplace), "CreateReplicationRequest")
+	capellaschema.AddAttr(attrs, "priority", replicationBuilder, &schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}, "CreateReplicationRequest")
+	capellaschema.AddAttr(attrs, "network_usage_limit", replicationBuilder, &schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}, "CreateReplicationRequest")
+
+	collectionMappingAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(collectionMappingAttrs, "source_collection", replicationBuilder, requiredStringAttributeNoReplace(), "Mappings")
+	capellaschema.AddAttr(collectionMappingAttrs, "target_collection", replicationBuilder, requiredStringAttributeNoReplace(), "Mappings")
+
+	mappingAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(mappingAttrs, "source_scope", replicationBuilder, requiredStringAttributeNoReplace(), "Mappings")
+	capellaschema.AddAttr(mappingAttrs, "target_scope", replicationBuilder, requiredStringAttributeNoReplace(), "Mappings")
+	capellaschema.AddAttr(mappingAttrs, "collections", replicationBuilder, &schema.ListNestedAttribute{
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: collectionMappingAttrs,
+		},
+	}, "Mappings")
+
+	capellaschema.AddAttr(attrs, "mappings", replicationBuilder, &schema.ListNestedAttribute{
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: mappingAttrs,
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}, "CreateReplicationRequest")
+
+	excludeAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(excludeAttrs, "binary", replicationBuilder, boolDefaultAttribute(false, optional, computed), "documentExcludeOptions")
+	capellaschema.AddAttr(excludeAttrs, "deletion", replicationBuilder, boolDefaultAttribute(false, optional, computed), "documentExcludeOptions")
+	capellaschema.AddAttr(excludeAttrs, "expiration", replicationBuilder, boolDefaultAttribute(false, optional, computed), "documentExcludeOptions")
+	capellaschema.AddAttr(excludeAttrs, "ttl", replicationBuilder, boolDefaultAttribute(false, optional, computed), "documentExcludeOptions")
+
+	expressionAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(expressionAttrs, "regex", replicationBuilder, stringAttribute([]string{optional}, stringvalidator.LengthAtLeast(1)), "expressions")
+	capellaschema.AddAttr(expressionAttrs, "skip_restream", replicationBuilder, boolDefaultAttribute(false, optional, computed), "expressions")
+
+	filterAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(filterAttrs, "document_exclude_options", replicationBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: excludeAttrs,
+	}, "Filter")
+	capellaschema.AddAttr(filterAttrs, "expressions", replicationBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: expressionAttrs,
+	}, "Filter")
+
+	capellaschema.AddAttr(attrs, "filter", replicationBuilder, &schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: filterAttrs,
+	}, "CreateReplicationRequest")
+
+	// Paused is not part of the replication payload; it drives the activationStatus
+	// endpoint, so the description is set here rather than looked up from the spec.
+	paused := boolDefaultAttribute(false, optional, computed)
+	paused.MarkdownDescription = "Set to true to pause the replication and back to false to resume it."
+	capellaschema.AddAttr(attrs, "paused", replicationBuilder, paused)
+
+	capellaschema.AddAttr(attrs, "status", replicationBuilder, stringAttribute([]string{computed}), "GetReplicationResponse")
+	capellaschema.AddAttr(attrs, "error", replicationBuilder, stringAttribute([]string{computed}), "GetReplicationResponse")
+	capellaschema.AddAttr(attrs, "changes_left", replicationBuilder, int64Attribute(computed), "GetReplicationResponse")
+	capellaschema.AddAttr(attrs, "reverse_replication_id", replicationBuilder, stringAttribute([]string{computed, useStateForUnknown}), "CreateReplicationJSONResponse")
+
+	auditAttrs := make(map[string]schema.Attribute)
+	capellaschema.AddAttr(auditAttrs, "created_at", replicationBuilder, stringAttribute([]string{computed}), "ReplicationAuditData")
+	capellaschema.AddAttr(auditAttrs, "created_by", replicationBuilder, stringAttribute([]string{computed}), "ReplicationAuditData")
+
+	capellaschema.AddAttr(attrs, "audit", replicationBuilder, &schema.SingleNestedAttribute{
+		Computed:   true,
+		Attributes: auditAttrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+	})
+
+	return schema.Schema{
+		MarkdownDescription: "Manages an XDCR replication from a bucket on a Capella cluster to a target bucket on another Capella or external cluster.",
+		Attributes:          attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
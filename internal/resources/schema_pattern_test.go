@@ -18,6 +18,7 @@ func TestAllSchemasUseAddAttrPattern(t *testing.T) {
 		// Terraform-specific fields that don't exist in OpenAPI spec
 		AllowLegacyAttributes: []string{
 			"apikey_schema.go:rotate", // Terraform lifecycle field for API key rotation
+			"cmek_schema.go:rotate",   // Terraform lifecycle field for CMEK rotation
 		},
 	}
 
@@ -33,6 +33,11 @@ var (
 	_ resource.ResourceWithImportState = &SnapshotBackup{}
 )
 
+// snapshotBackupTimeout is how long a snapshot backup may take to be created, updated
+// or deleted when the timeouts block does not set a value. It covers waiting for
+// in-progress restores on the cluster before the operation can start.
+const snapshotBackupTimeout = 60 * time.Minute
+
 // SnapshotBackup is the Snapshot Backup resource implementation.
 type SnapshotBackup struct {
 	*providerschema.Data
@@ -71,6 +76,13 @@ func (s *SnapshotBackup) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Create, snapshotBackupTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	var (
 		organizationId = plan.OrganizationId.ValueString()
 		projectId      = plan.ProjectID.ValueString()
@@ -101,19 +113,17 @@ func (s *SnapshotBackup) Create(ctx context.Context, req resource.CreateRequest,
 	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters/%s/cloudsnapshotbackups", s.HostURL, organizationId, projectId, clusterId)
 	cfg := api.EndpointCfg{Url: url, Method: http.MethodPost, SuccessStatus: http.StatusAccepted}
 
-	const (
-		maxRestoringWait   = 20 * time.Minute
-		restoringPollEvery = 30 * time.Second
-	)
-	restoreDeadline := time.Now().Add(maxRestoringWait)
+	const restoringPollEvery = 30 * time.Second
 
+	// A cluster being restored rejects new backups, so keep retrying until the
+	// restore finishes or the create timeout expires.
 	var (
 		createResp *api.Response
 		err        error
 	)
 	for {
 		createResp, err = s.ClientV1.ExecuteWithRetry(ctx, cfg, createSnapshotBackupRequest, s.Token, nil)
-		if err == nil || !isRestoringError(err) || time.Now().After(restoreDeadline) {
+		if err == nil || !isRestoringError(err) || ctx.Err() != nil {
 			break
 		}
 		tflog.Debug(ctx, "cluster is temporarily unavailable (restoring); waiting before retrying backup create", map[string]interface{}{
@@ -175,6 +185,7 @@ func (s *SnapshotBackup) Create(ctx context.Context, req resource.CreateRequest,
 		partial.RegionsToCopy = plan.RegionsToCopy
 		partial.CrossRegionRestorePreference = plan.CrossRegionRestorePreference
 		partial.RestoreTimes = plan.RestoreTimes
+		partial.Timeouts = plan.Timeouts
 		diags = resp.State.Set(ctx, partial)
 		resp.Diagnostics.Append(diags...)
 		resp.Diagnostics.AddWarning(
@@ -193,6 +204,7 @@ func (s *SnapshotBackup) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	refreshedState.RegionsToCopy = plan.RegionsToCopy
+	refreshedState.Timeouts = plan.Timeouts
 
 	// Sets state to fully populated data.
 	diags = resp.State.Set(ctx, refreshedState)
@@ -266,6 +278,7 @@ func (s *SnapshotBackup) Read(ctx context.Context, req resource.ReadRequest, res
 	refreshedState.RegionsToCopy = state.RegionsToCopy
 	refreshedState.RestoreTimes = state.RestoreTimes
 	refreshedState.CrossRegionRestorePreference = state.CrossRegionRestorePreference
+	refreshedState.Timeouts = state.Timeouts
 
 	diags = resp.State.Set(ctx, refreshedState)
 	resp.Diagnostics.Append(diags...)
@@ -286,6 +299,13 @@ func (s *SnapshotBackup) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, plan.Timeouts.Update, snapshotBackupTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	IDs, err := plan.Validate()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -384,6 +404,7 @@ func (s *SnapshotBackup) Update(ctx context.Context, req resource.UpdateRequest,
 	state.RegionsToCopy = plan.RegionsToCopy
 	state.RestoreTimes = plan.RestoreTimes
 	state.CrossRegionRestorePreference = plan.CrossRegionRestorePreference
+	state.Timeouts = plan.Timeouts
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -398,6 +419,13 @@ func (s *SnapshotBackup) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel, diags := withTimeout(ctx, state.Timeouts.Delete, snapshotBackupTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	IDs, err := state.Validate()
 	if err != nil {
 		tflog.Debug(ctx, "error validating snapshot backup IDs", map[string]interface{}{
@@ -431,7 +459,9 @@ func (s *SnapshotBackup) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters/%s/cloudsnapshotbackups/%s", s.HostURL, organizationId, projectId, clusterId, Id)
 	cfg := api.EndpointCfg{Url: url, Method: http.MethodDelete, SuccessStatus: http.StatusAccepted}
-	for attempt := 0; attempt < 30; attempt++ {
+	// Transient errors are retried until the delete timeout expires.
+retry:
+	for attempt := 0; ; attempt++ {
 		_, err = s.ClientV1.ExecuteWithRetry(
 			ctx,
 			cfg,
@@ -461,7 +491,8 @@ func (s *SnapshotBackup) Delete(ctx context.Context, req resource.DeleteRequest,
 		})
 		select {
 		case <-ctx.Done():
-			err = ctx.Err()
+			err = fmt.Errorf("timed out retrying the delete, last error: %s: %w", apiErr.CompleteError(), ctx.Err())
+			break retry
 		case <-time.After(30 * time.Second):
 		}
 	}
@@ -683,15 +714,14 @@ func (s *SnapshotBackup) updateRetention(ctx context.Context, organizationId, pr
 // waitForRestoresComplete blocks until every restore associated with the given
 // snapshot ID has reached a final state. Capella returns HTTP 500 when a
 // snapshot is deleted while one of its restores is still in progress, so
-// callers must drain in-flight restores before issuing DELETE.
+// callers must drain in-flight restores before issuing DELETE. The deadline
+// comes from ctx, which Delete bounds with the resource timeouts.
 func (s *SnapshotBackup) waitForRestoresComplete(ctx context.Context, organizationId, projectId, clusterId, snapshotId string) error {
-	const (
-		pollInterval = 30 * time.Second
-		maxAttempts  = 60
-	)
+	const pollInterval = 30 * time.Second
+
 	url := fmt.Sprintf("%s/v4/organizations/%s/projects/%s/clusters/%s/cloudsnapshotbackups/restores", s.HostURL, organizationId, projectId, clusterId)
 	cfg := api.EndpointCfg{Url: url, Method: http.MethodGet, SuccessStatus: http.StatusOK}
-	for attempt := 0; attempt < maxAttempts; attempt++ {
+	for {
 		restores, err := api.GetPaginated[[]snapshot_backup.SnapshotRestore](ctx, s.ClientV1, s.Token, cfg, "")
 		if err != nil {
 			return err
@@ -708,23 +738,21 @@ func (s *SnapshotBackup) waitForRestoresComplete(ctx context.Context, organizati
 		}
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return fmt.Errorf("restores for snapshot %s did not reach final state: %w", snapshotId, ctx.Err())
 		case <-time.After(pollInterval):
 		}
 	}
-	return fmt.Errorf("restores for snapshot %s did not reach final state within %s", snapshotId, time.Duration(maxAttempts)*pollInterval)
 }
 
 // waitForSnapshotComplete polls the snapshot backup until its progress status
 // reaches a final state. The Capella restore endpoint returns HTTP 500 if the
 // underlying snapshot has not yet completed, so callers that intend to restore
-// must wait first.
+// must wait first. The deadline comes from ctx, which Update bounds with the
+// resource timeouts.
 func (s *SnapshotBackup) waitForSnapshotComplete(ctx context.Context, organizationId, projectId, clusterId, Id string) error {
-	const (
-		pollInterval = 30 * time.Second
-		maxAttempts  = 60
-	)
-	for attempt := 0; attempt < maxAttempts; attempt++ {
+	const pollInterval = 30 * time.Second
+
+	for {
 		backup, err := s.getSnapshotBackup(ctx, organizationId, projectId, clusterId, Id)
 		if err != nil {
 			return err
@@ -738,11 +766,10 @@ func (s *SnapshotBackup) waitForSnapshotComplete(ctx context.Context, organizati
 		}
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return fmt.Errorf("snapshot backup %s did not reach complete state: %w", Id, ctx.Err())
 		case <-time.After(pollInterval):
 		}
 	}
-	return fmt.Errorf("snapshot backup %s did not reach complete state within %s", Id, time.Duration(maxAttempts)*pollInterval)
 }
 
 // isRestoringError reports whether err is the Capella 422 indicating the
@@ -770,11 +797,10 @@ func (s *SnapshotBackup) restoreSnapshotBackup(ctx context.Context, organization
 		}
 	}
 
-	const (
-		maxConflictWait   = 30 * time.Minute
-		conflictPollEvery = 30 * time.Second
-	)
-	deadline := time.Now().Add(maxConflictWait)
+	// Only one restore can run on a cluster at a time, so keep retrying on a
+	// conflict until the other restore finishes or the update timeout expires.
+	const conflictPollEvery = 30 * time.Second
+
 	for {
 		resp, err = s.ClientV1.ExecuteWithRetry(ctx, cfg, requestBody, s.Token, nil)
 		if err == nil {
@@ -784,10 +810,6 @@ func (s *SnapshotBackup) restoreSnapshotBackup(ctx context.Context, organization
 		if !stderrors.As(err, &apiErr) || apiErr.HttpStatusCode != http.StatusConflict {
 			break
 		}
-		if time.Now().After(deadline) {
-			tflog.Debug(ctx, "timed out waiting for in-progress restore to clear", map[string]interface{}{"err": err})
-			break
-		}
 		tflog.Debug(ctx, "another restore in progress on cluster; waiting before retrying", map[string]interface{}{
 			"clusterID": clusterId,
 		})
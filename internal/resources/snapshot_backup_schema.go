@@ -68,5 +68,8 @@ func SnapshotBackupSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Manages snapshot backup resource associated with a Capella cluster.",
 		Attributes:          attrs,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
@@ -0,0 +1,37 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// timeoutsBlock returns the standard timeouts block for resources that wait on
+// long-running operations. Each value is a duration string such as "90m" or "2h".
+func timeoutsBlock() schema.Block {
+	return timeouts.Block(context.Background(), timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// withTimeout bounds ctx by the timeout configured for an operation, such as
+// plan.Timeouts.Create, falling back to defaultTimeout when it is not set.
+// Every request and wait loop run with the returned context shares the deadline.
+func withTimeout(
+	ctx context.Context,
+	timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics),
+	defaultTimeout time.Duration,
+) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	duration, diags := timeout(ctx, defaultTimeout)
+	if diags.HasError() {
+		return ctx, func() {}, diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	return ctx, cancel, diags
+}
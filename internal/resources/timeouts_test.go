@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	providerschema "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema"
+)
+
+// TestTimeoutsStateRoundTrip verifies that every resource with a timeouts block reads the
+// configured timeouts into its model and writes them back to state unchanged.
+func TestTimeoutsStateRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema schema.Schema
+		model  any
+	}{
+		{name: "cluster", schema: ClusterSchema(), model: &providerschema.ClusterWithTimeouts{}},
+		{name: "app_service", schema: AppServiceSchema(), model: &providerschema.AppService{}},
+		{name: "bucket", schema: BucketSchema(), model: &providerschema.BucketWithTimeouts{}},
+		{name: "snapshot_backup", schema: SnapshotBackupSchema(), model: &providerschema.SnapshotBackup{}},
+		{name: "eventing_function", schema: EventingFunctionSchema(), model: &providerschema.EventingFunctionResource{}},
+		{name: "gsi", schema: GsiSchema(), model: &providerschema.GsiDefinition{}},
+		{name: "private_endpoint_service", schema: PrivateEndpointServiceSchema(), model: &providerschema.PrivateEndpointServiceWithTimeouts{}},
+		{name: "network_peer", schema: NetworkPeerSchema(), model: &providerschema.NetworkPeer{}},
+		{name: "replication", schema: ReplicationSchema(), model: &providerschema.Replication{}},
+		{name: "analytics_cluster", schema: AnalyticsClusterSchema(), model: &providerschema.AnalyticsCluster{}},
+		{name: "ai_model", schema: AiModelSchema(), model: &providerschema.AiModel{}},
+		{name: "ai_workflow", schema: AiWorkflowSchema(), model: &providerschema.AiWorkflow{}},
+		{name: "analytics_backup", schema: AnalyticsBackupSchema(), model: &providerschema.AnalyticsBackup{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			objectType := test.schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+
+			timeoutsType, ok := objectType.AttributeTypes["timeouts"].(tftypes.Object)
+			if !ok {
+				t.Fatalf("schema has no timeouts block")
+			}
+			timeoutValues := make(map[string]tftypes.Value, len(timeoutsType.AttributeTypes))
+			for name := range timeoutsType.AttributeTypes {
+				timeoutValues[name] = tftypes.NewValue(tftypes.String, nil)
+			}
+			timeoutValues["create"] = tftypes.NewValue(tftypes.String, "90m")
+			values["timeouts"] = tftypes.NewValue(timeoutsType, timeoutValues)
+
+			state := tfsdk.State{Schema: test.schema, Raw: tftypes.NewValue(objectType, values)}
+			if diags := state.Get(ctx, test.model); diags.HasError() {
+				t.Fatalf("Get: %v", diags)
+			}
+			if diags := state.Set(ctx, test.model); diags.HasError() {
+				t.Fatalf("Set: %v", diags)
+			}
+
+			var got timeouts.Value
+			if diags := state.GetAttribute(ctx, path.Root("timeouts"), &got); diags.HasError() {
+				t.Fatalf("GetAttribute: %v", diags)
+			}
+			create, diags := got.Create(ctx, time.Minute)
+			if diags.HasError() {
+				t.Fatalf("Create: %v", diags)
+			}
+			if create != 90*time.Minute {
+				t.Errorf("create timeout = %s, want 1h30m0s", create)
+			}
+		})
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("bounds the context by the timeout", func(t *testing.T) {
+		timeout := func(context.Context, time.Duration) (time.Duration, diag.Diagnostics) {
+			return 2 * time.Hour, nil
+		}
+
+		ctx, cancel, diags := withTimeout(context.Background(), timeout, time.Minute)
+		defer cancel()
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			t.Fatal("context has no deadline")
+		}
+		if remaining := time.Until(deadline); remaining <= time.Hour || remaining > 2*time.Hour {
+			t.Errorf("deadline in %s, want about 2h", remaining)
+		}
+	})
+
+	t.Run("passes the default to the timeout", func(t *testing.T) {
+		var got time.Duration
+		timeout := func(_ context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+			got = defaultTimeout
+			return defaultTimeout, nil
+		}
+
+		_, cancel, _ := withTimeout(context.Background(), timeout, 42*time.Minute)
+		defer cancel()
+		if got != 42*time.Minute {
+			t.Errorf("default timeout = %s, want 42m0s", got)
+		}
+	})
+
+	t.Run("returns diagnostics without a deadline", func(t *testing.T) {
+		timeout := func(context.Context, time.Duration) (time.Duration, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			diags.AddError("invalid timeout", "time: invalid duration")
+			return 0, diags
+		}
+
+		ctx, cancel, diags := withTimeout(context.Background(), timeout, time.Minute)
+		defer cancel()
+		if !diags.HasError() {
+			t.Fatal("expected diagnostics")
+		}
+		if _, ok := ctx.Deadline(); ok {
+			t.Error("context has a deadline")
+		}
+	})
+}
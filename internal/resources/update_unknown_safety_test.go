@@ -36,6 +36,13 @@ func TestUpdateBodyAttributesResolveUnknownsToState(t *testing.T) {
 				"flush",
 			},
 		},
+		{
+			// UpdateBucketStorageBackendRequest. An unknown storage_backend compares as a
+			// change of backend, so a migration would be started or the bucket recreated.
+			name:       "bucket_storage_backend",
+			attributes: BucketSchema().Attributes,
+			attrNames:  []string{"storage_backend"},
+		},
 		{
 			// PutProjectRequest. An empty description wipes the existing one.
 			name:       "project",
@@ -49,6 +56,48 @@ func TestUpdateBodyAttributesResolveUnknownsToState(t *testing.T) {
 			attributes: AuditLogSettingsSchema().Attributes,
 			attrNames:  []string{"audit_enabled", "enabled_event_ids", "disabled_users"},
 		},
+		{
+			// UpdateColumnarAnalyticsClusterRequest. An empty description wipes the existing one.
+			name:       "analytics_cluster",
+			attributes: AnalyticsClusterSchema().Attributes,
+			attrNames:  []string{"description"},
+		},
+		{
+			// EditColumnarAnalyticsBackupRetentionRequest. A zero retention expires the backup.
+			name:       "analytics_backup",
+			attributes: AnalyticsBackupSchema().Attributes,
+			attrNames:  []string{"retention"},
+		},
+		{
+			// UpsertColumnarAnalyticsBackupScheduleRequest. A zero start time moves the schedule to 0001-01-01.
+			name:       "analytics_backup_schedule",
+			attributes: AnalyticsBackupScheduleSchema().Attributes,
+			attrNames:  []string{"start_time"},
+		},
+		{
+			// UpdateModelRequest. A false enable_batching turns batching off.
+			name:       "ai_model",
+			attributes: AiModelSchema().Attributes,
+			attrNames:  []string{"enable_batching"},
+		},
+		{
+			// UpdateAppServiceAdminUser. access_all_endpoints picks which access request is sent.
+			name:       "app_service_admin_user",
+			attributes: AppServiceAdminUserSchema().Attributes,
+			attrNames:  []string{"access_all_endpoints"},
+		},
+		{
+			// PutAppEndpointAuditLogConfig. The sets replace the configured event IDs and exclusions.
+			name:       "app_endpoint_audit_log_settings",
+			attributes: AppEndpointAuditLogSettingsSchema().Attributes,
+			attrNames:  []string{"enabled_event_ids", "disabled_users", "disabled_roles"},
+		},
+		{
+			// PutAppServiceAuditLogStreaming. disabled_app_endpoints replaces the excluded App Endpoints.
+			name:       "app_service_audit_log_streaming",
+			attributes: AppServiceAuditLogStreamingSchema().Attributes,
+			attrNames:  []string{"disabled_app_endpoints", "streaming_enabled"},
+		},
 	}
 
 	for _, tc := range cases {
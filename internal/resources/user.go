@@ -653,8 +653,8 @@ func (r *User) refreshUser(ctx context.Context, organizationId, userId string) (
 // Unfortunately the terraform import CLI doesn't allow us to pass multiple IDs at this point
 // and hence this workaround has been applied.
 func (r *User) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Names in the import ID are resolved to IDs before they are saved to the id attribute.
+	importStateByName(ctx, r.Data, req, resp, "User", "user_id", "user_email", "user_name")
 }
 
 func (r *User) validateUserAttributesTrimmed(plan providerschema.User) error {
@@ -0,0 +1,395 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+const (
+	// AiModelStateOn is the state of a model that is serving requests.
+	AiModelStateOn = "on"
+
+	// AiModelStateOff is the state of a paused model.
+	AiModelStateOff = "off"
+)
+
+// AiModel defines the Terraform state for a model hosted in Capella AI Services.
+type AiModel struct {
+	// CloudConfig is where and on which compute the model is deployed.
+	CloudConfig *AiModelCloudConfig `tfsdk:"cloud_config"`
+
+	// Jailbreak configures the jailbreak detection model.
+	Jailbreak *AiModelJailbreak `tfsdk:"jailbreak"`
+
+	// Caching configures the caching of requests to the model.
+	Caching *AiModelCaching `tfsdk:"caching"`
+
+	// Audit contains the audit data for the model.
+	Audit types.Object `tfsdk:"audit"`
+
+	// Guardrails are the guardrail categories applied to the model.
+	Guardrails types.Set `tfsdk:"guardrails"`
+
+	// KeywordFiltering are the keywords filtered from the input.
+	KeywordFiltering types.Set `tfsdk:"keyword_filtering"`
+
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// Id is the ID of the model.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the model.
+	Name types.String `tfsdk:"name"`
+
+	// CatalogModelName is the name of the model in the model catalog.
+	CatalogModelName types.String `tfsdk:"catalog_model_name"`
+
+	// Optimization is the optimization profile of the model.
+	Optimization types.String `tfsdk:"optimization"`
+
+	// Quantization is the quantization of the model.
+	Quantization types.String `tfsdk:"quantization"`
+
+	// State is whether the model is on or off.
+	State types.String `tfsdk:"state"`
+
+	// Status is the current status of the model.
+	Status types.String `tfsdk:"status"`
+
+	// ConnectionString is the endpoint URL of the model.
+	ConnectionString types.String `tfsdk:"connection_string"`
+
+	// Dimensions are the vector dimensions of an embedding model.
+	Dimensions types.Int64 `tfsdk:"dimensions"`
+
+	// EnableBatching is whether requests are batched.
+	EnableBatching types.Bool `tfsdk:"enable_batching"`
+
+	// Timeouts configures how long the model may take to be deployed, updated or destroyed.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// AiModelCloudConfig is the cloud configuration of a model.
+type AiModelCloudConfig struct {
+	Compute  AiModelCompute `tfsdk:"compute"`
+	Provider types.String   `tfsdk:"provider"`
+	Region   types.String   `tfsdk:"region"`
+}
+
+// AiModelCompute is the compute a model is deployed on.
+type AiModelCompute struct {
+	Cpu       types.Int64 `tfsdk:"cpu"`
+	GpuMemory types.Int64 `tfsdk:"gpu_memory"`
+}
+
+// AiModelJailbreak configures the jailbreak detection model.
+type AiModelJailbreak struct {
+	ScoreThreshold types.Float64 `tfsdk:"score_threshold"`
+}
+
+// AiModelCaching configures the caching of requests to a model.
+type AiModelCaching struct {
+	Semantic             *AiModelSemanticCaching `tfsdk:"semantic"`
+	DefaultCache         types.String            `tfsdk:"default_cache"`
+	EnableConversational types.Bool              `tfsdk:"enable_conversational"`
+	EnableStandard       types.Bool              `tfsdk:"enable_standard"`
+	ExpiryTtl            types.Int64             `tfsdk:"expiry_ttl"`
+}
+
+// AiModelSemanticCaching configures similarity based caching.
+type AiModelSemanticCaching struct {
+	DistanceMetric types.String  `tfsdk:"distance_metric"`
+	EmbeddingModel types.String  `tfsdk:"embedding_model"`
+	Dimensions     types.Int64   `tfsdk:"dimensions"`
+	ScoreThreshold types.Float64 `tfsdk:"score_threshold"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AiModel) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		Id:             a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// AiModelStateFromStatus maps the status of a model to its on/off state.
+// A model is off once it has been paused, and on in every other status.
+func AiModelStateFromStatus(status string) string {
+	if status == "paused" {
+		return AiModelStateOff
+	}
+	return AiModelStateOn
+}
+
+// NewAiModel creates a new model state object from the model returned by Capella.
+//
+// Capella does not return the guardrails or jailbreak settings, and returns caching with its
+// own defaults filled in, so those are carried over from prior. So are the remaining settings
+// when Capella omits them.
+func NewAiModel(
+	model apigen.GetLanguageModelResponse,
+	organizationId string,
+	prior *AiModel,
+	auditObject basetypes.ObjectValue,
+) *AiModel {
+	state := &AiModel{
+		OrganizationId:   types.StringValue(organizationId),
+		Id:               types.StringNull(),
+		Name:             types.StringNull(),
+		CatalogModelName: types.StringNull(),
+		Optimization:     types.StringNull(),
+		Quantization:     types.StringNull(),
+		Status:           types.StringNull(),
+		ConnectionString: types.StringNull(),
+		Dimensions:       types.Int64Null(),
+		EnableBatching:   types.BoolValue(false),
+		Guardrails:       types.SetNull(types.StringType),
+		KeywordFiltering: types.SetNull(types.StringType),
+		Audit:            auditObject,
+	}
+	if prior != nil {
+		state.Name = prior.Name
+		state.CatalogModelName = prior.CatalogModelName
+		state.CloudConfig = prior.CloudConfig
+		if !prior.Optimization.IsUnknown() {
+			state.Optimization = prior.Optimization
+		}
+		if !prior.Quantization.IsUnknown() {
+			state.Quantization = prior.Quantization
+		}
+		if !prior.Dimensions.IsUnknown() {
+			state.Dimensions = prior.Dimensions
+		}
+		state.Guardrails = prior.Guardrails
+		state.KeywordFiltering = prior.KeywordFiltering
+		state.Jailbreak = prior.Jailbreak
+		state.Caching = prior.Caching
+		if !prior.EnableBatching.IsNull() && !prior.EnableBatching.IsUnknown() {
+			state.EnableBatching = prior.EnableBatching
+		}
+		state.Timeouts = prior.Timeouts
+	}
+
+	m := model.Model
+	if m == nil {
+		return state
+	}
+
+	if m.Id != nil {
+		state.Id = types.StringValue(m.Id.String())
+	}
+	if m.Name != nil {
+		state.Name = types.StringValue(*m.Name)
+	}
+	if m.Status != nil {
+		state.Status = types.StringValue(*m.Status)
+		state.State = types.StringValue(AiModelStateFromStatus(*m.Status))
+	}
+	if m.ConnectionString != nil {
+		state.ConnectionString = types.StringValue(*m.ConnectionString)
+	}
+	if m.CloudConfig != nil {
+		state.CloudConfig = &AiModelCloudConfig{
+			Provider: types.StringValue(string(m.CloudConfig.Provider)),
+			Region:   types.StringValue(m.CloudConfig.Region),
+			Compute: AiModelCompute{
+				Cpu:       types.Int64Value(int64(m.CloudConfig.Compute.Cpu)),
+				GpuMemory: types.Int64Value(int64(m.CloudConfig.Compute.GpuMemory)),
+			},
+		}
+	}
+
+	if config := m.Config; config != nil {
+		if config.CatalogModelName != nil {
+			state.CatalogModelName = types.StringValue(*config.CatalogModelName)
+		}
+		if config.Optimization != nil {
+			state.Optimization = types.StringValue(string(*config.Optimization))
+		}
+		if config.Quantization != nil {
+			state.Quantization = types.StringValue(string(*config.Quantization))
+		}
+		if config.Dimensions != nil {
+			state.Dimensions = types.Int64Value(int64(*config.Dimensions))
+		}
+		if config.EnableBatching != nil {
+			state.EnableBatching = types.BoolValue(*config.EnableBatching)
+		}
+		if config.KeywordFiltering != nil && (len(*config.KeywordFiltering) > 0 || !state.KeywordFiltering.IsNull()) {
+			state.KeywordFiltering = newStringSet(*config.KeywordFiltering)
+		}
+	}
+
+	if state.State.IsNull() && prior != nil {
+		state.State = prior.State
+	}
+
+	return state
+}
+
+// newStringSet converts a list of strings into a set value.
+func newStringSet(values []string) types.Set {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// AiModelAPIKey defines the Terraform state for an API key used to call hosted models.
+type AiModelAPIKey struct {
+	// Audit contains the audit data for the API key.
+	Audit types.Object `tfsdk:"audit"`
+
+	// AllowedCIDRs are the CIDR blocks allowed to use the API key.
+	AllowedCIDRs types.Set `tfsdk:"allowed_cidrs"`
+
+	// AllowedModels are the IDs of the models the API key can call.
+	AllowedModels types.Set `tfsdk:"allowed_models"`
+
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// Id is the ID of the API key.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the API key.
+	Name types.String `tfsdk:"name"`
+
+	// Description is the description of the API key.
+	Description types.String `tfsdk:"description"`
+
+	// Region is the region of the models the API key can call.
+	Region types.String `tfsdk:"region"`
+
+	// Token is the API key secret. It is only returned when the API key is created.
+	Token types.String `tfsdk:"token"`
+
+	// Expiry is the number of days the API key is valid for.
+	Expiry types.Int64 `tfsdk:"expiry"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AiModelAPIKey) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		Id:             a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAiModelAPIKey creates a new model API key state object from the API key returned by Capella.
+// The token is not returned after creation, so it is passed in from prior state.
+//
+// Capella returns "*" when every model in the region is allowed, which is kept null when
+// allowed_models was not configured.
+func NewAiModelAPIKey(
+	apiKey apigen.GetLanguageModelAPIKeyResponse,
+	organizationId, id string,
+	token types.String,
+	prior *AiModelAPIKey,
+	auditObject basetypes.ObjectValue,
+) *AiModelAPIKey {
+	state := &AiModelAPIKey{
+		OrganizationId: types.StringValue(organizationId),
+		Id:             types.StringValue(id),
+		Name:           types.StringPointerValue(apiKey.Name),
+		Description:    types.StringValue(""),
+		Region:         types.StringPointerValue(apiKey.Region),
+		Token:          token,
+		Expiry:         types.Int64Null(),
+		AllowedCIDRs:   types.SetNull(types.StringType),
+		AllowedModels:  types.SetNull(types.StringType),
+		Audit:          auditObject,
+	}
+
+	if apiKey.Description != nil {
+		state.Description = types.StringValue(*apiKey.Description)
+	}
+	if apiKey.Expiry != nil {
+		state.Expiry = types.Int64Value(int64(*apiKey.Expiry))
+	}
+	if apiKey.AllowedCIDRs != nil {
+		state.AllowedCIDRs = newStringSet(*apiKey.AllowedCIDRs)
+	}
+
+	var modelIds []string
+	if apiKey.AllowedModels != nil {
+		for _, model := range *apiKey.AllowedModels {
+			modelIds = append(modelIds, model.Id)
+		}
+	}
+	allModels := len(modelIds) == 0 || (len(modelIds) == 1 && modelIds[0] == "*")
+	if !allModels || (prior != nil && !prior.AllowedModels.IsNull()) {
+		state.AllowedModels = newStringSet(modelIds)
+	}
+
+	return state
+}
+
+// AiModelData defines the Terraform state for the ai_model data source.
+type AiModelData struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// Id is the ID of the model.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the model.
+	Name types.String `tfsdk:"name"`
+
+	// CatalogModelName is the name of the catalog model that is deployed.
+	CatalogModelName types.String `tfsdk:"catalog_model_name"`
+
+	// Status is the current status of the model.
+	Status types.String `tfsdk:"status"`
+
+	// ConnectionString is the endpoint used to call the model.
+	ConnectionString types.String `tfsdk:"connection_string"`
+}
+
+// NewAiModelData creates the ai_model data source state from the model and its connection string.
+func NewAiModelData(model apigen.GetLanguageModelResponse, organizationId, id string, connectionString *string) *AiModelData {
+	state := &AiModelData{
+		OrganizationId:   types.StringValue(organizationId),
+		Id:               types.StringValue(id),
+		Name:             types.StringNull(),
+		CatalogModelName: types.StringNull(),
+		Status:           types.StringNull(),
+		ConnectionString: types.StringPointerValue(connectionString),
+	}
+
+	if model.Model != nil {
+		state.Name = types.StringPointerValue(model.Model.Name)
+		state.Status = types.StringPointerValue(model.Model.Status)
+		if model.Model.Config != nil {
+			state.CatalogModelName = types.StringPointerValue(model.Model.Config.CatalogModelName)
+		}
+		if connectionString == nil {
+			state.ConnectionString = types.StringPointerValue(model.Model.ConnectionString)
+		}
+	}
+
+	return state
+}
@@ -0,0 +1,176 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestAiModelValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AiModel
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AiModel{
+				OrganizationId: basetypes.NewStringValue("100"),
+				Id:             basetypes.NewStringValue("200"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AiModel{
+				Id: basetypes.NewStringValue("id=200,organization_id=100"),
+			},
+		},
+		{
+			name: "[NEGATIVE] organization_id is missing from the import string",
+			input: AiModel{
+				Id: basetypes.NewStringValue("id=200"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[Id])
+		})
+	}
+}
+
+func TestAiModelStateFromStatus(t *testing.T) {
+	assert.Equal(t, AiModelStateOff, AiModelStateFromStatus("paused"))
+	assert.Equal(t, AiModelStateOn, AiModelStateFromStatus("resuming"))
+	assert.Equal(t, AiModelStateOn, AiModelStateFromStatus("healthy"))
+}
+
+func TestNewAiModel(t *testing.T) {
+	var (
+		id           = uuid.New()
+		name         = "embedder"
+		status       = "paused"
+		catalogName  = "nvidia/nv-embedqa-e5-v5"
+		optimization = apigen.ConfigOptimization("throughput")
+		batching     = true
+	)
+
+	model := apigen.GetLanguageModelResponse{}
+	model.Model = &struct {
+		Actions          *[]string                  `json:"actions,omitempty"`
+		Audit            *apigen.CouchbaseAuditData `json:"audit,omitempty"`
+		CloudConfig      *apigen.CloudConfig        `json:"cloudConfig,omitempty"`
+		Config           *apigen.Config             `json:"config,omitempty"`
+		ConnectionString *string                    `json:"connectionString,omitempty"`
+		Id               *uuid.UUID                 `json:"id,omitempty"`
+		Name             *string                    `json:"name,omitempty"`
+		Status           *string                    `json:"status,omitempty"`
+		UsageMetrics     *apigen.UsageMetrics       `json:"usageMetrics,omitempty"`
+	}{
+		Id:     &id,
+		Name:   &name,
+		Status: &status,
+		Config: &apigen.Config{
+			CatalogModelName: &catalogName,
+			Optimization:     &optimization,
+			EnableBatching:   &batching,
+			KeywordFiltering: &[]string{},
+		},
+	}
+
+	t.Run("import keeps what Capella returns", func(t *testing.T) {
+		state := NewAiModel(model, "100", nil, types.ObjectNull(nil))
+
+		assert.Equal(t, types.StringValue(id.String()), state.Id)
+		assert.Equal(t, types.StringValue("embedder"), state.Name)
+		assert.Equal(t, types.StringValue(catalogName), state.CatalogModelName)
+		assert.Equal(t, types.StringValue("throughput"), state.Optimization)
+		assert.True(t, state.Quantization.IsNull())
+		assert.Equal(t, types.BoolValue(true), state.EnableBatching)
+		assert.Equal(t, types.StringValue(AiModelStateOff), state.State)
+		assert.True(t, state.KeywordFiltering.IsNull())
+		assert.Nil(t, state.CloudConfig)
+	})
+
+	t.Run("settings Capella does not return are carried over from prior", func(t *testing.T) {
+		prior := &AiModel{
+			Quantization:     types.StringUnknown(),
+			Dimensions:       types.Int64Value(1024),
+			EnableBatching:   types.BoolValue(false),
+			Guardrails:       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("violence")}),
+			KeywordFiltering: types.SetValueMust(types.StringType, []attr.Value{}),
+			Jailbreak:        &AiModelJailbreak{ScoreThreshold: types.Float64Value(0.5)},
+			Caching:          &AiModelCaching{EnableStandard: types.BoolValue(true)},
+		}
+
+		state := NewAiModel(model, "100", prior, types.ObjectNull(nil))
+
+		assert.True(t, state.Quantization.IsNull())
+		assert.Equal(t, types.Int64Value(1024), state.Dimensions)
+		assert.Equal(t, prior.Guardrails, state.Guardrails)
+		assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{}), state.KeywordFiltering)
+		assert.Equal(t, prior.Jailbreak, state.Jailbreak)
+		assert.Equal(t, prior.Caching, state.Caching)
+	})
+}
+
+func TestNewAiModelAPIKey(t *testing.T) {
+	var (
+		name   = "inference"
+		region = "us-east-1"
+		expiry = float32(30)
+	)
+
+	apiKey := apigen.GetLanguageModelAPIKeyResponse{
+		Name:         &name,
+		Region:       &region,
+		Expiry:       &expiry,
+		AllowedCIDRs: &[]string{"10.0.0.0/16"},
+	}
+
+	t.Run("all models are kept null when allowed_models is not configured", func(t *testing.T) {
+		apiKey.AllowedModels = &[]struct {
+			Id   string `json:"id"`
+			Name string `json:"name"`
+		}{{Id: "*"}}
+
+		state := NewAiModelAPIKey(apiKey, "100", "200", types.StringValue("secret"), nil, types.ObjectNull(nil))
+
+		assert.Equal(t, types.StringValue("200"), state.Id)
+		assert.Equal(t, types.StringValue("secret"), state.Token)
+		assert.Equal(t, types.StringValue(""), state.Description)
+		assert.Equal(t, types.Int64Value(30), state.Expiry)
+		assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/16")}), state.AllowedCIDRs)
+		assert.True(t, state.AllowedModels.IsNull())
+	})
+
+	t.Run("specific models are returned", func(t *testing.T) {
+		apiKey.AllowedModels = &[]struct {
+			Id   string `json:"id"`
+			Name string `json:"name"`
+		}{{Id: "model-1", Name: "embedder"}}
+
+		state := NewAiModelAPIKey(apiKey, "100", "200", types.StringNull(), nil, types.ObjectNull(nil))
+
+		assert.True(t, state.Token.IsNull())
+		assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("model-1")}), state.AllowedModels)
+	})
+}
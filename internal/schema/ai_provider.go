@@ -0,0 +1,186 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+const (
+	// AiProviderTypeAwsS3 is the type of a provider that reads files from an S3 bucket.
+	AiProviderTypeAwsS3 = "awsS3"
+
+	// AiProviderTypeOpenAI is the type of a provider that calls the OpenAI API.
+	AiProviderTypeOpenAI = "openAI"
+
+	// AiProviderTypeAwsBedrock is the type of a provider that calls AWS Bedrock.
+	AiProviderTypeAwsBedrock = "awsBedrock"
+)
+
+// AiProvider defines the Terraform state for an external provider registered with Capella AI Services.
+//
+// The credentials are write-only, so they are never stored in state. They are sent to Capella
+// on create, and again on update whenever CredentialsWoVersion changes.
+type AiProvider struct {
+	// Audit contains the audit data for the provider.
+	Audit types.Object `tfsdk:"audit"`
+
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// Id is the ID of the provider.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the provider.
+	Name types.String `tfsdk:"name"`
+
+	// Type is the type of the provider, one of awsS3, openAI or awsBedrock.
+	Type types.String `tfsdk:"type"`
+
+	// AwsRegion is the AWS region of the S3 bucket. Only used by awsS3 providers.
+	AwsRegion types.String `tfsdk:"aws_region"`
+
+	// Bucket is the name of the S3 bucket. Only used by awsS3 providers.
+	Bucket types.String `tfsdk:"bucket"`
+
+	// FolderPath is the folder in the S3 bucket the files are read from. Only used by awsS3 providers.
+	FolderPath types.String `tfsdk:"folder_path"`
+
+	// ApiKeyWo is the OpenAI API key. Only used by openAI providers.
+	ApiKeyWo types.String `tfsdk:"api_key_wo"`
+
+	// AccessKeyIdWo is the AWS access key ID. Used by awsS3 and awsBedrock providers.
+	AccessKeyIdWo types.String `tfsdk:"access_key_id_wo"`
+
+	// SecretAccessKeyWo is the AWS secret access key. Used by awsS3 and awsBedrock providers.
+	SecretAccessKeyWo types.String `tfsdk:"secret_access_key_wo"`
+
+	// SessionTokenWo is the AWS session token for temporary credentials. Only used by awsS3 providers.
+	SessionTokenWo types.String `tfsdk:"session_token_wo"`
+
+	// CredentialsWoVersion is bumped to send the write-only credentials to Capella again.
+	CredentialsWoVersion types.Int64 `tfsdk:"credentials_wo_version"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AiProvider) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		Id:             a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAiProvider creates a new provider state object from the provider returned by Capella.
+// The credentials version is carried over from prior state, and the credentials are always null.
+func NewAiProvider(
+	provider apigen.GetProviderResponse,
+	organizationId, id string,
+	credentialsWoVersion types.Int64,
+	auditObject basetypes.ObjectValue,
+) *AiProvider {
+	state := &AiProvider{
+		OrganizationId:       types.StringValue(organizationId),
+		Id:                   types.StringValue(id),
+		Name:                 types.StringPointerValue(provider.Name),
+		Type:                 types.StringPointerValue(provider.Type),
+		AwsRegion:            types.StringNull(),
+		Bucket:               types.StringNull(),
+		FolderPath:           types.StringNull(),
+		ApiKeyWo:             types.StringNull(),
+		AccessKeyIdWo:        types.StringNull(),
+		SecretAccessKeyWo:    types.StringNull(),
+		SessionTokenWo:       types.StringNull(),
+		CredentialsWoVersion: credentialsWoVersion,
+		Audit:                auditObject,
+	}
+
+	if provider.Type != nil && *provider.Type == AiProviderTypeAwsS3 && provider.Configuration != nil {
+		if s3, err := provider.Configuration.AsGetS3ConfigurationResponse(); err == nil {
+			state.AwsRegion = types.StringPointerValue(s3.AwsRegion)
+			state.Bucket = types.StringPointerValue(s3.Bucket)
+			// Capella reports the root folder as an empty path, which is left unset in configuration.
+			if s3.FolderPath != nil && *s3.FolderPath != "" {
+				state.FolderPath = types.StringValue(*s3.FolderPath)
+			}
+		}
+	}
+
+	return state
+}
+
+// AiProviders defines the Terraform state for the providers registered in an organization.
+type AiProviders struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProviderType optionally limits the providers to a single type.
+	ProviderType types.String `tfsdk:"provider_type"`
+
+	// Data contains the providers.
+	Data []AiProviderData `tfsdk:"data"`
+}
+
+// AiProviderData is a single provider returned by the ai_providers data source.
+type AiProviderData struct {
+	// Audit contains the audit data for the provider.
+	Audit CouchbaseAuditData `tfsdk:"audit"`
+
+	// Id is the ID of the provider.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the provider.
+	Name types.String `tfsdk:"name"`
+
+	// Type is the type of the provider.
+	Type types.String `tfsdk:"type"`
+
+	// AwsRegion is the AWS region of the S3 bucket, for awsS3 providers.
+	AwsRegion types.String `tfsdk:"aws_region"`
+
+	// Bucket is the name of the S3 bucket, for awsS3 providers.
+	Bucket types.String `tfsdk:"bucket"`
+
+	// FolderPath is the folder in the S3 bucket, for awsS3 providers.
+	FolderPath types.String `tfsdk:"folder_path"`
+}
+
+// AiProviderResponse is a provider in the list returned by Capella. The element type of
+// apigen.ListProvidersResponse.Data is not named by the generated API, so the fields shared
+// with apigen.GetProviderResponse are embedded alongside the provider ID.
+type AiProviderResponse struct {
+	apigen.GetProviderResponse
+
+	// Id is the ID of the provider.
+	Id string `json:"id"`
+}
+
+// NewAiProviderData creates a provider entry for the ai_providers data source.
+func NewAiProviderData(provider AiProviderResponse) AiProviderData {
+	state := NewAiProvider(provider.GetProviderResponse, "", provider.Id, types.Int64Null(), types.ObjectNull(CouchbaseAuditData{}.AttributeTypes()))
+
+	data := AiProviderData{
+		Id:         state.Id,
+		Name:       state.Name,
+		Type:       state.Type,
+		AwsRegion:  state.AwsRegion,
+		Bucket:     state.Bucket,
+		FolderPath: state.FolderPath,
+	}
+	if provider.Audit != nil {
+		data.Audit = NewCouchbaseAuditData(api.CouchbaseAuditData(*provider.Audit))
+	}
+
+	return data
+}
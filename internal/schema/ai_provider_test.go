@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+)
+
+func TestAiProviderValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AiProvider
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AiProvider{
+				OrganizationId: basetypes.NewStringValue("100"),
+				Id:             basetypes.NewStringValue("200"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AiProvider{
+				Id: basetypes.NewStringValue("id=200,organization_id=100"),
+			},
+		},
+		{
+			name: "[NEGATIVE] organization_id is missing from the import string",
+			input: AiProvider{
+				Id: basetypes.NewStringValue("id=200"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[Id])
+		})
+	}
+}
+
+func TestNewAiProvider(t *testing.T) {
+	tests := []struct {
+		name               string
+		body               string
+		expectedType       string
+		expectedRegion     types.String
+		expectedBucket     types.String
+		expectedFolderPath types.String
+	}{
+		{
+			name:               "[POSITIVE] S3 location is read from the configuration",
+			body:               `{"name":"docs","type":"awsS3","configuration":{"awsRegion":"us-east-1","bucket":"docs","folderPath":"pdfs/"}}`,
+			expectedType:       AiProviderTypeAwsS3,
+			expectedRegion:     types.StringValue("us-east-1"),
+			expectedBucket:     types.StringValue("docs"),
+			expectedFolderPath: types.StringValue("pdfs/"),
+		},
+		{
+			name:               "[POSITIVE] empty S3 folder path is left unset",
+			body:               `{"name":"docs","type":"awsS3","configuration":{"awsRegion":"us-east-1","bucket":"docs","folderPath":""}}`,
+			expectedType:       AiProviderTypeAwsS3,
+			expectedRegion:     types.StringValue("us-east-1"),
+			expectedBucket:     types.StringValue("docs"),
+			expectedFolderPath: types.StringNull(),
+		},
+		{
+			name:               "[POSITIVE] OpenAI provider has no S3 location",
+			body:               `{"name":"llm","type":"openAI","configuration":{}}`,
+			expectedType:       AiProviderTypeOpenAI,
+			expectedRegion:     types.StringNull(),
+			expectedBucket:     types.StringNull(),
+			expectedFolderPath: types.StringNull(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var provider AiProviderResponse
+			require.NoError(t, json.Unmarshal([]byte(`{"id":"p-1",`+test.body[1:]), &provider))
+
+			state := NewAiProvider(provider.GetProviderResponse, "100", provider.Id, types.Int64Value(2), types.ObjectNull(CouchbaseAuditData{}.AttributeTypes()))
+
+			assert.Equal(t, "p-1", state.Id.ValueString())
+			assert.Equal(t, "100", state.OrganizationId.ValueString())
+			assert.Equal(t, test.expectedType, state.Type.ValueString())
+			assert.Equal(t, test.expectedRegion, state.AwsRegion)
+			assert.Equal(t, test.expectedBucket, state.Bucket)
+			assert.Equal(t, test.expectedFolderPath, state.FolderPath)
+			assert.Equal(t, types.Int64Value(2), state.CredentialsWoVersion)
+
+			// Credentials are write-only and never stored in state.
+			assert.True(t, state.ApiKeyWo.IsNull())
+			assert.True(t, state.AccessKeyIdWo.IsNull())
+			assert.True(t, state.SecretAccessKeyWo.IsNull())
+			assert.True(t, state.SessionTokenWo.IsNull())
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AiWorkflow defines the Terraform state for a vectorization workflow in Capella AI Services.
+type AiWorkflow struct {
+	// TargetCouchbaseKeyspace is the keyspace whose documents are vectorized.
+	TargetCouchbaseKeyspace *AiWorkflowKeyspace `tfsdk:"target_couchbase_keyspace"`
+
+	// VectorizationConfig configures how the documents are vectorized.
+	VectorizationConfig *AiWorkflowVectorizationConfig `tfsdk:"vectorization_config"`
+
+	// Audit contains the audit data for the workflow.
+	Audit types.Object `tfsdk:"audit"`
+
+	// LastRun is the run started by the most recent change to RunTrigger.
+	LastRun types.Object `tfsdk:"last_run"`
+
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// Id is the ID of the workflow.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the workflow.
+	Name types.String `tfsdk:"name"`
+
+	// RunTrigger starts a run of the workflow whenever it is set or changed.
+	RunTrigger types.String `tfsdk:"run_trigger"`
+
+	// Timeouts configures how long creating, running or deleting the workflow may take.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// AiWorkflowKeyspace is a bucket, scope and collection read by a workflow.
+type AiWorkflowKeyspace struct {
+	Bucket     types.String `tfsdk:"bucket"`
+	Scope      types.String `tfsdk:"scope"`
+	Collection types.String `tfsdk:"collection"`
+}
+
+// AiWorkflowVectorizationConfig configures how a workflow vectorizes documents.
+type AiWorkflowVectorizationConfig struct {
+	// EmbeddingModel is the model that generates the vector embeddings.
+	EmbeddingModel *AiWorkflowEmbeddingModel `tfsdk:"embedding_model"`
+
+	// EmbeddingFieldMappings maps each vector embedding field to the fields it is generated from.
+	EmbeddingFieldMappings map[string]AiWorkflowEmbeddingField `tfsdk:"embedding_field_mappings"`
+
+	// CreateIndexes is whether a vector index is created for every embedding field.
+	CreateIndexes types.Bool `tfsdk:"create_indexes"`
+}
+
+// AiWorkflowEmbeddingField lists the source fields of a vector embedding field.
+type AiWorkflowEmbeddingField struct {
+	SourceFields types.List `tfsdk:"source_fields"`
+}
+
+// AiWorkflowEmbeddingModel is either a model hosted in Capella or an external model.
+type AiWorkflowEmbeddingModel struct {
+	CapellaHostedModel *AiWorkflowCapellaHostedModel `tfsdk:"capella_hosted_model"`
+	External           *AiWorkflowExternalModel      `tfsdk:"external"`
+}
+
+// AiWorkflowCapellaHostedModel is an embedding model hosted in Capella AI Services.
+type AiWorkflowCapellaHostedModel struct {
+	Id                     types.String `tfsdk:"id"`
+	ModelName              types.String `tfsdk:"model_name"`
+	ApiKeyId               types.String `tfsdk:"api_key_id"`
+	ApiKeyToken            types.String `tfsdk:"api_key_token"`
+	PrivateEndpointEnabled types.Bool   `tfsdk:"private_endpoint_enabled"`
+}
+
+// AiWorkflowExternalModel is an external embedding model reached through an OpenAI integration.
+type AiWorkflowExternalModel struct {
+	ModelName  types.String `tfsdk:"model_name"`
+	ProviderId types.String `tfsdk:"provider_id"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AiWorkflow) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		ProjectId:      a.ProjectId,
+		ClusterId:      a.ClusterId,
+		Id:             a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAiWorkflow creates a new workflow state object from the workflow returned by Capella.
+//
+// Capella does not return the API key token of a hosted model, so it is carried over from prior,
+// as are the run trigger and last run, which are not part of the workflow.
+func NewAiWorkflow(
+	workflow apigen.GetWorkflowResponse,
+	organizationId, projectId, clusterId string,
+	prior *AiWorkflow,
+	auditObject basetypes.ObjectValue,
+) (*AiWorkflow, error) {
+	config, err := workflow.Configuration.AsGetVectorizationWorkflowResponse()
+	if err != nil {
+		return nil, fmt.Errorf("workflow %s is not a vectorization workflow: %w", workflow.Id, err)
+	}
+
+	state := &AiWorkflow{
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		ClusterId:      types.StringValue(clusterId),
+		Id:             types.StringValue(workflow.Id),
+		Name:           types.StringValue(workflow.Name),
+		RunTrigger:     types.StringNull(),
+		LastRun:        types.ObjectNull(AiWorkflowRun{}.AttributeTypes()),
+		Audit:          auditObject,
+		TargetCouchbaseKeyspace: &AiWorkflowKeyspace{
+			Bucket:     types.StringValue(config.TargetCouchbaseKeyspace.Bucket),
+			Scope:      types.StringValue(config.TargetCouchbaseKeyspace.Scope),
+			Collection: types.StringValue(config.TargetCouchbaseKeyspace.Collection),
+		},
+	}
+	if prior != nil {
+		state.RunTrigger = prior.RunTrigger
+		state.Timeouts = prior.Timeouts
+		if !prior.LastRun.IsUnknown() {
+			state.LastRun = prior.LastRun
+		}
+	}
+
+	vectorization := &AiWorkflowVectorizationConfig{
+		CreateIndexes:          types.BoolValue(false),
+		EmbeddingFieldMappings: make(map[string]AiWorkflowEmbeddingField, len(config.VectorizationConfig.EmbeddingFieldMappings)),
+	}
+	if config.VectorizationConfig.CreateIndexes != nil {
+		vectorization.CreateIndexes = types.BoolValue(*config.VectorizationConfig.CreateIndexes)
+	}
+	for field, mapping := range config.VectorizationConfig.EmbeddingFieldMappings {
+		sourceFields := types.ListValueMust(types.StringType, []attr.Value{})
+		if mapping.SourceFields != nil {
+			elements := make([]attr.Value, 0, len(*mapping.SourceFields))
+			for _, sourceField := range *mapping.SourceFields {
+				elements = append(elements, types.StringValue(sourceField))
+			}
+			sourceFields = types.ListValueMust(types.StringType, elements)
+		}
+		vectorization.EmbeddingFieldMappings[field] = AiWorkflowEmbeddingField{SourceFields: sourceFields}
+	}
+
+	var priorModel *AiWorkflowEmbeddingModel
+	if prior != nil && prior.VectorizationConfig != nil {
+		priorModel = prior.VectorizationConfig.EmbeddingModel
+	}
+	vectorization.EmbeddingModel, err = newAiWorkflowEmbeddingModel(config.VectorizationConfig.EmbeddingModel, priorModel)
+	if err != nil {
+		return nil, err
+	}
+	state.VectorizationConfig = vectorization
+
+	return state, nil
+}
+
+// newAiWorkflowEmbeddingModel decodes the embedding model, which Capella returns as either
+// a hosted model or an external model.
+func newAiWorkflowEmbeddingModel(
+	model apigen.VectorizationConfig_EmbeddingModel,
+	prior *AiWorkflowEmbeddingModel,
+) (*AiWorkflowEmbeddingModel, error) {
+	hosted, err := model.AsCapellaHostedModel()
+	if err != nil {
+		return nil, fmt.Errorf("could not decode embedding model: %w", err)
+	}
+	if hosted.CapellaHostedModel.Id != "" {
+		state := &AiWorkflowCapellaHostedModel{
+			Id:                     types.StringValue(hosted.CapellaHostedModel.Id),
+			ModelName:              types.StringValue(hosted.CapellaHostedModel.ModelName),
+			ApiKeyId:               types.StringNull(),
+			ApiKeyToken:            types.StringNull(),
+			PrivateEndpointEnabled: types.BoolValue(false),
+		}
+		if prior != nil && prior.CapellaHostedModel != nil {
+			state.ApiKeyId = prior.CapellaHostedModel.ApiKeyId
+			state.ApiKeyToken = prior.CapellaHostedModel.ApiKeyToken
+			state.PrivateEndpointEnabled = prior.CapellaHostedModel.PrivateEndpointEnabled
+		}
+		return &AiWorkflowEmbeddingModel{CapellaHostedModel: state}, nil
+	}
+
+	external, err := model.AsExternalModel()
+	if err != nil {
+		return nil, fmt.Errorf("could not decode embedding model: %w", err)
+	}
+	return &AiWorkflowEmbeddingModel{External: &AiWorkflowExternalModel{
+		ModelName:  types.StringValue(string(external.External.ModelName)),
+		ProviderId: types.StringValue(external.External.OpenAiIntegration.ProviderId),
+	}}, nil
+}
+
+// AiWorkflowRun is a single run of a workflow.
+type AiWorkflowRun struct {
+	// Id is the ID of the run.
+	Id types.String `tfsdk:"id"`
+
+	// Status is the status of the run.
+	Status types.String `tfsdk:"status"`
+
+	// CreatedAt is when the run was started.
+	CreatedAt types.String `tfsdk:"created_at"`
+
+	// CreatedByUserId is the ID of the user that started the run.
+	CreatedByUserId types.String `tfsdk:"created_by_user_id"`
+
+	// TotalFiles is the number of files picked up by the run.
+	TotalFiles types.Int64 `tfsdk:"total_files"`
+
+	// ProcessedFiles is the number of files processed by the run.
+	ProcessedFiles types.Int64 `tfsdk:"processed_files"`
+
+	// ErroredFiles is the number of files that failed to process.
+	ErroredFiles types.Int64 `tfsdk:"errored_files"`
+}
+
+// AttributeTypes returns the attribute types of a workflow run object.
+func (a AiWorkflowRun) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                 types.StringType,
+		"status":             types.StringType,
+		"created_at":         types.StringType,
+		"created_by_user_id": types.StringType,
+		"total_files":        types.Int64Type,
+		"processed_files":    types.Int64Type,
+		"errored_files":      types.Int64Type,
+	}
+}
+
+// NewAiWorkflowRun creates a new workflow run object from the run returned by Capella.
+func NewAiWorkflowRun(run apigen.GetWorkflowRunResponse) AiWorkflowRun {
+	return AiWorkflowRun{
+		Id:              types.StringValue(run.Id),
+		Status:          types.StringValue(string(run.Status)),
+		CreatedAt:       types.StringValue(run.CreatedAt),
+		CreatedByUserId: types.StringValue(run.CreatedByUserID),
+		TotalFiles:      intPointerToInt64(run.TotalFiles),
+		ProcessedFiles:  intPointerToInt64(run.ProcessedFiles),
+		ErroredFiles:    intPointerToInt64(run.ErroredFiles),
+	}
+}
+
+// intPointerToInt64 converts an optional count into an Int64 value, null when it was not returned.
+func intPointerToInt64(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
+// IsAiWorkflowRunFinished reports whether a run has stopped, successfully or not.
+func IsAiWorkflowRunFinished(status apigen.GetWorkflowRunResponseStatus) bool {
+	switch status {
+	case apigen.GetWorkflowRunResponseStatusCompleted,
+		apigen.GetWorkflowRunResponseStatusPartiallyCompleted,
+		apigen.GetWorkflowRunResponseStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// AiWorkflowRuns defines the attributes as received from the V4 Capella Public API
+// when asked to list the runs of a workflow.
+type AiWorkflowRuns struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// WorkflowId is the ID of the workflow.
+	WorkflowId types.String `tfsdk:"workflow_id"`
+
+	// Data contains the runs of the workflow.
+	Data []AiWorkflowRun `tfsdk:"data"`
+}
+
+// AiWorkflowProcessedFiles defines the attributes as received from the V4 Capella Public API
+// when asked to list the files processed by a workflow run.
+type AiWorkflowProcessedFiles struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// WorkflowId is the ID of the workflow.
+	WorkflowId types.String `tfsdk:"workflow_id"`
+
+	// RunId is the ID of the workflow run.
+	RunId types.String `tfsdk:"run_id"`
+
+	// FileStatus filters the files by their processing status.
+	FileStatus types.String `tfsdk:"file_status"`
+
+	// Data contains the files processed by the run.
+	Data []AiWorkflowProcessedFile `tfsdk:"data"`
+}
+
+// AiWorkflowProcessedFile is a file processed by a workflow run.
+type AiWorkflowProcessedFile struct {
+	FileMetadata types.Map    `tfsdk:"file_metadata"`
+	FileName     types.String `tfsdk:"file_name"`
+	FilePath     types.String `tfsdk:"file_path"`
+	FileStatus   types.String `tfsdk:"file_status"`
+	S3Url        types.String `tfsdk:"s3_url"`
+}
+
+// AiWorkflowProcessedFileResponse is a file in the list returned by Capella. It matches the
+// element type of apigen.GetWorkflowRunProcessedFilesResponse.Data, which is not named by the generated API.
+type AiWorkflowProcessedFileResponse = struct {
+	// FileMetadata User-defined S3 metadata associated with the file.
+	FileMetadata *map[string]string `json:"fileMetadata,omitempty"`
+
+	// FileName Name of the file.
+	FileName *string `json:"fileName,omitempty"`
+
+	// FilePath Path of the file.
+	FilePath *string `json:"filePath,omitempty"`
+
+	// FileStatus Status of the file in the workflow run.
+	FileStatus *apigen.GetWorkflowRunProcessedFilesResponseDataFileStatus `json:"fileStatus,omitempty"`
+
+	// S3Url Full S3 URL of the file (e.g., s3://bucket/path/file.pdf).
+	S3Url *string `json:"s3Url,omitempty"`
+}
+
+// NewAiWorkflowProcessedFile creates a new processed file object from the file returned by Capella.
+func NewAiWorkflowProcessedFile(file AiWorkflowProcessedFileResponse) AiWorkflowProcessedFile {
+	processedFile := AiWorkflowProcessedFile{
+		FileMetadata: types.MapNull(types.StringType),
+		FileName:     types.StringPointerValue(file.FileName),
+		FilePath:     types.StringPointerValue(file.FilePath),
+		FileStatus:   types.StringNull(),
+		S3Url:        types.StringPointerValue(file.S3Url),
+	}
+
+	if file.FileMetadata != nil {
+		metadata := make(map[string]attr.Value, len(*file.FileMetadata))
+		for key, value := range *file.FileMetadata {
+			metadata[key] = types.StringValue(value)
+		}
+		processedFile.FileMetadata = types.MapValueMust(types.StringType, metadata)
+	}
+	if file.FileStatus != nil {
+		processedFile.FileStatus = types.StringValue(string(*file.FileStatus))
+	}
+
+	return processedFile
+}
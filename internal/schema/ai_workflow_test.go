@@ -0,0 +1,178 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestAiWorkflowValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AiWorkflow
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AiWorkflow{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				ClusterId:      basetypes.NewStringValue("300"),
+				Id:             basetypes.NewStringValue("400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AiWorkflow{
+				Id: basetypes.NewStringValue("id=400,organization_id=100,project_id=200,cluster_id=300"),
+			},
+		},
+		{
+			name: "[NEGATIVE] cluster_id is missing from the import string",
+			input: AiWorkflow{
+				Id: basetypes.NewStringValue("id=400,organization_id=100,project_id=200"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[ClusterId])
+			assert.Equal(t, "400", IDs[Id])
+		})
+	}
+}
+
+func newTestAiWorkflowResponse(t *testing.T, model apigen.VectorizationConfig_EmbeddingModel) apigen.GetWorkflowResponse {
+	t.Helper()
+
+	createIndexes := true
+	config := apigen.GetVectorizationWorkflowResponse{
+		TargetCouchbaseKeyspace: apigen.CouchbaseKeyspace{Bucket: "docs", Scope: "_default", Collection: "_default"},
+		VectorizationConfig: apigen.VectorizationConfig{
+			CreateIndexes: &createIndexes,
+			EmbeddingFieldMappings: map[string]struct {
+				SourceFields *[]string `json:"sourceFields,omitempty"`
+			}{
+				"text_vector": {SourceFields: &[]string{"title", "body"}},
+			},
+			EmbeddingModel: model,
+		},
+	}
+
+	workflow := apigen.GetWorkflowResponse{Id: "400", Name: "docs"}
+	require.NoError(t, workflow.Configuration.FromGetVectorizationWorkflowResponse(config))
+	return workflow
+}
+
+func TestNewAiWorkflow(t *testing.T) {
+	t.Run("external model", func(t *testing.T) {
+		var external apigen.ExternalModel
+		external.External.ModelName = apigen.TextEmbedding3Small
+		external.External.OpenAiIntegration.ProviderId = "provider"
+		var model apigen.VectorizationConfig_EmbeddingModel
+		require.NoError(t, model.FromExternalModel(external))
+
+		state, err := NewAiWorkflow(newTestAiWorkflowResponse(t, model), "100", "200", "300", nil, types.ObjectNull(nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, types.StringValue("400"), state.Id)
+		assert.Equal(t, types.StringValue("docs"), state.TargetCouchbaseKeyspace.Bucket)
+		assert.Equal(t, types.BoolValue(true), state.VectorizationConfig.CreateIndexes)
+		assert.Equal(t,
+			types.ListValueMust(types.StringType, []attr.Value{types.StringValue("title"), types.StringValue("body")}),
+			state.VectorizationConfig.EmbeddingFieldMappings["text_vector"].SourceFields,
+		)
+		assert.Nil(t, state.VectorizationConfig.EmbeddingModel.CapellaHostedModel)
+		assert.Equal(t, &AiWorkflowExternalModel{
+			ModelName:  types.StringValue("text-embedding-3-small"),
+			ProviderId: types.StringValue("provider"),
+		}, state.VectorizationConfig.EmbeddingModel.External)
+		assert.True(t, state.RunTrigger.IsNull())
+		assert.True(t, state.LastRun.IsNull())
+	})
+
+	t.Run("hosted model keeps the API key and run from prior", func(t *testing.T) {
+		var hosted apigen.CapellaHostedModel
+		hosted.CapellaHostedModel.Id = "model"
+		hosted.CapellaHostedModel.ModelName = "nvidia/nv-embedqa-e5-v5"
+		var model apigen.VectorizationConfig_EmbeddingModel
+		require.NoError(t, model.FromCapellaHostedModel(hosted))
+
+		lastRun, diags := types.ObjectValueFrom(context.Background(), AiWorkflowRun{}.AttributeTypes(), NewAiWorkflowRun(apigen.GetWorkflowRunResponse{
+			Id:     "run",
+			Status: apigen.GetWorkflowRunResponseStatusCompleted,
+		}))
+		require.False(t, diags.HasError())
+
+		prior := &AiWorkflow{
+			RunTrigger: types.StringValue("v1"),
+			LastRun:    lastRun,
+			VectorizationConfig: &AiWorkflowVectorizationConfig{
+				EmbeddingModel: &AiWorkflowEmbeddingModel{
+					CapellaHostedModel: &AiWorkflowCapellaHostedModel{
+						ApiKeyId:               types.StringValue("key"),
+						ApiKeyToken:            types.StringValue("secret"),
+						PrivateEndpointEnabled: types.BoolValue(true),
+					},
+				},
+			},
+		}
+
+		state, err := NewAiWorkflow(newTestAiWorkflowResponse(t, model), "100", "200", "300", prior, types.ObjectNull(nil))
+		require.NoError(t, err)
+
+		assert.Nil(t, state.VectorizationConfig.EmbeddingModel.External)
+		assert.Equal(t, &AiWorkflowCapellaHostedModel{
+			Id:                     types.StringValue("model"),
+			ModelName:              types.StringValue("nvidia/nv-embedqa-e5-v5"),
+			ApiKeyId:               types.StringValue("key"),
+			ApiKeyToken:            types.StringValue("secret"),
+			PrivateEndpointEnabled: types.BoolValue(true),
+		}, state.VectorizationConfig.EmbeddingModel.CapellaHostedModel)
+		assert.Equal(t, types.StringValue("v1"), state.RunTrigger)
+		assert.Equal(t, lastRun, state.LastRun)
+	})
+}
+
+func TestNewAiWorkflowRun(t *testing.T) {
+	processed, errored := 9, 1
+
+	run := NewAiWorkflowRun(apigen.GetWorkflowRunResponse{
+		Id:             "run",
+		Status:         apigen.GetWorkflowRunResponseStatusPartiallyCompleted,
+		ProcessedFiles: &processed,
+		ErroredFiles:   &errored,
+	})
+
+	assert.Equal(t, types.StringValue("partiallyCompleted"), run.Status)
+	assert.Equal(t, types.Int64Value(9), run.ProcessedFiles)
+	assert.Equal(t, types.Int64Value(1), run.ErroredFiles)
+	assert.True(t, run.TotalFiles.IsNull())
+}
+
+func TestIsAiWorkflowRunFinished(t *testing.T) {
+	assert.True(t, IsAiWorkflowRunFinished(apigen.GetWorkflowRunResponseStatusCompleted))
+	assert.True(t, IsAiWorkflowRunFinished(apigen.GetWorkflowRunResponseStatusPartiallyCompleted))
+	assert.True(t, IsAiWorkflowRunFinished(apigen.GetWorkflowRunResponseStatusFailed))
+	assert.False(t, IsAiWorkflowRunFinished(apigen.GetWorkflowRunResponseStatusRunning))
+	assert.False(t, IsAiWorkflowRunFinished(apigen.GetWorkflowRunResponseStatusStopping))
+}
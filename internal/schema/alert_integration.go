@@ -0,0 +1,301 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AlertIntegration defines the Terraform state for an alert integration of a project.
+type AlertIntegration struct {
+	// Config holds the delivery configuration of the integration.
+	Config *AlertIntegrationConfig `tfsdk:"config"`
+
+	// Audit contains the audit data for the integration.
+	Audit types.Object `tfsdk:"audit"`
+
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// Id is the ID of the alert integration.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the alert integration.
+	Name types.String `tfsdk:"name"`
+
+	// Kind is the type of the alert integration.
+	Kind types.String `tfsdk:"kind"`
+
+	// ConfigKey is the key Capella stores the integration config and secrets under.
+	ConfigKey types.String `tfsdk:"config_key"`
+
+	// Status shows whether the integration is healthy or unhealthy.
+	Status types.String `tfsdk:"status"`
+
+	// Enabled is whether the integration is the active integration of the project.
+	Enabled types.Bool `tfsdk:"enabled"`
+
+	// TestOnCreate is a Terraform-only flag. When set, a test alert is delivered before the
+	// integration is created and the create fails if the delivery fails.
+	TestOnCreate types.Bool `tfsdk:"test_on_create"`
+}
+
+// AlertIntegrationConfig holds the kind specific configuration of an alert integration.
+type AlertIntegrationConfig struct {
+	Webhook *AlertIntegrationWebhook `tfsdk:"webhook"`
+}
+
+// AlertIntegrationWebhook is the configuration of a webhook alert integration.
+type AlertIntegrationWebhook struct {
+	// BasicAuth holds the credentials sent with basic authentication.
+	BasicAuth *AlertIntegrationBasicAuth `tfsdk:"basic_auth"`
+
+	// Exclude lists the clusters and app services that do not raise alerts through the integration.
+	Exclude *AlertIntegrationExclude `tfsdk:"exclude"`
+
+	// Headers are the additional headers sent with each alert.
+	Headers types.Map `tfsdk:"headers"`
+
+	// Method is the HTTP method used to deliver alerts.
+	Method types.String `tfsdk:"method"`
+
+	// Token is the bearer token sent with each alert.
+	Token types.String `tfsdk:"token"`
+
+	// Url is the URL alerts are delivered to.
+	Url types.String `tfsdk:"url"`
+}
+
+// AlertIntegrationBasicAuth holds basic authentication credentials for a webhook.
+type AlertIntegrationBasicAuth struct {
+	User     types.String `tfsdk:"user"`
+	Password types.String `tfsdk:"password"`
+}
+
+// AlertIntegrationExclude lists the resources excluded from an alert integration.
+type AlertIntegrationExclude struct {
+	Clusters    types.Set `tfsdk:"clusters"`
+	AppServices types.Set `tfsdk:"app_services"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AlertIntegration) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		ProjectId:      a.ProjectId,
+		Id:             a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAlertIntegration creates a new alert integration state object from the integration
+// returned by Capella.
+//
+// Capella does not return the webhook token or basic auth credentials, and the url and
+// headers may carry secrets too, so those are carried over from prior when it is set.
+func NewAlertIntegration(
+	alert apigen.GetAlertResponse,
+	organizationId, projectId string,
+	prior *AlertIntegrationConfig,
+	testOnCreate types.Bool,
+	auditObject basetypes.ObjectValue,
+) *AlertIntegration {
+	var priorWebhook *AlertIntegrationWebhook
+	if prior != nil {
+		priorWebhook = prior.Webhook
+	}
+
+	webhook := &AlertIntegrationWebhook{
+		Url:     types.StringValue(alert.Config.Webhook.Url),
+		Method:  types.StringValue(string(alert.Config.Webhook.Method)),
+		Headers: newAlertIntegrationHeaders(alert.Config.Webhook.Headers),
+		Token:   types.StringNull(),
+	}
+	if priorWebhook != nil {
+		if !priorWebhook.Url.IsNull() {
+			webhook.Url = priorWebhook.Url
+		}
+		if !priorWebhook.Headers.IsNull() {
+			webhook.Headers = priorWebhook.Headers
+		}
+		webhook.Token = priorWebhook.Token
+		webhook.BasicAuth = priorWebhook.BasicAuth
+		webhook.Exclude = NewAlertIntegrationExclude(alert.Config.Webhook.Exclude, priorWebhook.Exclude)
+	} else {
+		webhook.Exclude = NewAlertIntegrationExclude(alert.Config.Webhook.Exclude, nil)
+	}
+
+	if testOnCreate.IsNull() || testOnCreate.IsUnknown() {
+		testOnCreate = types.BoolValue(false)
+	}
+
+	return &AlertIntegration{
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		Id:             types.StringValue(alert.Id.String()),
+		Name:           types.StringValue(alert.Name),
+		Kind:           types.StringValue(string(alert.Kind)),
+		ConfigKey:      types.StringValue(alert.ConfigKey),
+		Status:         types.StringValue(alert.Status),
+		Enabled:        types.BoolValue(alert.Enabled),
+		Config:         &AlertIntegrationConfig{Webhook: webhook},
+		TestOnCreate:   testOnCreate,
+		Audit:          auditObject,
+	}
+}
+
+// NewAlertIntegrationExclude converts the exclusions returned by Capella. An exclusion
+// with no entries is kept null when prior did not configure one, so an unset exclude
+// block does not show a diff against the empty lists Capella may return.
+func NewAlertIntegrationExclude(exclude *apigen.Exclude, prior *AlertIntegrationExclude) *AlertIntegrationExclude {
+	var clusters, appServices []string
+	if exclude != nil {
+		if exclude.Clusters != nil {
+			clusters = *exclude.Clusters
+		}
+		if exclude.AppServices != nil {
+			appServices = *exclude.AppServices
+		}
+	}
+
+	if prior == nil {
+		if len(clusters) == 0 && len(appServices) == 0 {
+			return nil
+		}
+		prior = &AlertIntegrationExclude{
+			Clusters:    types.SetNull(types.StringType),
+			AppServices: types.SetNull(types.StringType),
+		}
+	}
+
+	return &AlertIntegrationExclude{
+		Clusters:    newAlertIntegrationStringSet(clusters, prior.Clusters),
+		AppServices: newAlertIntegrationStringSet(appServices, prior.AppServices),
+	}
+}
+
+// newAlertIntegrationStringSet converts a list of IDs into a set, keeping a null prior
+// value null when the list is empty.
+func newAlertIntegrationStringSet(values []string, prior types.Set) types.Set {
+	if len(values) == 0 && prior.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// newAlertIntegrationHeaders converts webhook headers into a map, null when there are none.
+func newAlertIntegrationHeaders(headers *map[string]string) types.Map {
+	if headers == nil || len(*headers) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(*headers))
+	for key, value := range *headers {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// AlertIntegrations defines the model for the alert integrations data source.
+type AlertIntegrations struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// Data contains the alert integrations of the project.
+	Data []AlertIntegrationData `tfsdk:"data"`
+}
+
+// AlertIntegrationData is a single entry of the alert integrations data source.
+type AlertIntegrationData struct {
+	// Config holds the delivery configuration of the integration.
+	Config AlertIntegrationDataConfig `tfsdk:"config"`
+
+	// Audit contains the audit data for the integration.
+	Audit types.Object `tfsdk:"audit"`
+
+	// Id is the ID of the alert integration.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the alert integration.
+	Name types.String `tfsdk:"name"`
+
+	// Kind is the type of the alert integration.
+	Kind types.String `tfsdk:"kind"`
+
+	// ConfigKey is the key Capella stores the integration config and secrets under.
+	ConfigKey types.String `tfsdk:"config_key"`
+
+	// Status shows whether the integration is healthy or unhealthy.
+	Status types.String `tfsdk:"status"`
+
+	// Enabled is whether the integration is the active integration of the project.
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+// AlertIntegrationDataConfig holds the kind specific configuration returned for an alert integration.
+type AlertIntegrationDataConfig struct {
+	Webhook AlertIntegrationDataWebhook `tfsdk:"webhook"`
+}
+
+// AlertIntegrationDataWebhook is the webhook configuration returned for an alert integration.
+// Capella does not return the token or basic auth credentials.
+type AlertIntegrationDataWebhook struct {
+	Exclude *AlertIntegrationExclude `tfsdk:"exclude"`
+	Headers types.Map                `tfsdk:"headers"`
+	Method  types.String             `tfsdk:"method"`
+	Url     types.String             `tfsdk:"url"`
+}
+
+// NewAlertIntegrationData creates a new alert integration data object.
+func NewAlertIntegrationData(alert apigen.GetAlertResponse, auditObject basetypes.ObjectValue) AlertIntegrationData {
+	return AlertIntegrationData{
+		Id:        types.StringValue(alert.Id.String()),
+		Name:      types.StringValue(alert.Name),
+		Kind:      types.StringValue(string(alert.Kind)),
+		ConfigKey: types.StringValue(alert.ConfigKey),
+		Status:    types.StringValue(alert.Status),
+		Enabled:   types.BoolValue(alert.Enabled),
+		Config: AlertIntegrationDataConfig{
+			Webhook: AlertIntegrationDataWebhook{
+				Url:     types.StringValue(alert.Config.Webhook.Url),
+				Method:  types.StringValue(string(alert.Config.Webhook.Method)),
+				Headers: newAlertIntegrationHeaders(alert.Config.Webhook.Headers),
+				Exclude: NewAlertIntegrationExclude(alert.Config.Webhook.Exclude, nil),
+			},
+		},
+		Audit: auditObject,
+	}
+}
+
+// Validate is used to verify that the required IDs for the alert integrations data source have been set.
+func (a AlertIntegrations) Validate() (organizationId, projectId string, err error) {
+	if a.OrganizationId.IsNull() {
+		return "", "", errors.ErrOrganizationIdMissing
+	}
+	if a.ProjectId.IsNull() {
+		return "", "", errors.ErrProjectIdMissing
+	}
+	return a.OrganizationId.ValueString(), a.ProjectId.ValueString(), nil
+}
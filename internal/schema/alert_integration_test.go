@@ -0,0 +1,132 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestAlertIntegrationValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AlertIntegration
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AlertIntegration{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				Id:             basetypes.NewStringValue("300"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AlertIntegration{
+				Id: basetypes.NewStringValue("id=300,project_id=200,organization_id=100"),
+			},
+		},
+		{
+			name: "[NEGATIVE] project_id is missing from the import string",
+			input: AlertIntegration{
+				Id: basetypes.NewStringValue("id=300,organization_id=100"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[Id])
+		})
+	}
+}
+
+func TestNewAlertIntegration(t *testing.T) {
+	var (
+		id       = uuid.New()
+		clusters = []string{"cluster-1"}
+		headers  = map[string]string{"X-Team": "data"}
+	)
+
+	alert := apigen.GetAlertResponse{
+		Id:        id,
+		Name:      "pagerduty",
+		Kind:      apigen.GetAlertResponseKindWebhook,
+		ConfigKey: "key",
+		Status:    "healthy",
+		Enabled:   true,
+		Config: apigen.ResponseConfig{
+			Webhook: apigen.ResponseWebhook{
+				Url:     "https://events.example.com",
+				Method:  apigen.ResponseWebhookMethodPOST,
+				Headers: &headers,
+				Exclude: &apigen.Exclude{Clusters: &clusters, AppServices: &[]string{}},
+			},
+		},
+	}
+
+	t.Run("import keeps what Capella returns", func(t *testing.T) {
+		state := NewAlertIntegration(alert, "100", "200", nil, types.BoolNull(), types.ObjectNull(nil))
+
+		assert.Equal(t, types.StringValue(id.String()), state.Id)
+		assert.Equal(t, types.BoolValue(false), state.TestOnCreate)
+		assert.Equal(t, types.StringValue("https://events.example.com"), state.Config.Webhook.Url)
+		assert.Equal(t, types.StringValue("POST"), state.Config.Webhook.Method)
+		assert.True(t, state.Config.Webhook.Token.IsNull())
+		assert.Nil(t, state.Config.Webhook.BasicAuth)
+		assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{"X-Team": types.StringValue("data")}), state.Config.Webhook.Headers)
+		require.NotNil(t, state.Config.Webhook.Exclude)
+		assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("cluster-1")}), state.Config.Webhook.Exclude.Clusters)
+		assert.True(t, state.Config.Webhook.Exclude.AppServices.IsNull())
+	})
+
+	t.Run("secrets are carried over from prior", func(t *testing.T) {
+		prior := &AlertIntegrationConfig{Webhook: &AlertIntegrationWebhook{
+			Url:       types.StringValue("https://events.example.com/routing-key"),
+			Method:    types.StringValue("POST"),
+			Headers:   types.MapNull(types.StringType),
+			Token:     types.StringValue("secret"),
+			BasicAuth: &AlertIntegrationBasicAuth{User: types.StringValue("user"), Password: types.StringValue("password")},
+		}}
+
+		state := NewAlertIntegration(alert, "100", "200", prior, types.BoolValue(true), types.ObjectNull(nil))
+
+		assert.Equal(t, types.BoolValue(true), state.TestOnCreate)
+		assert.Equal(t, types.StringValue("https://events.example.com/routing-key"), state.Config.Webhook.Url)
+		assert.Equal(t, types.StringValue("secret"), state.Config.Webhook.Token)
+		assert.Equal(t, prior.Webhook.BasicAuth, state.Config.Webhook.BasicAuth)
+		assert.False(t, state.Config.Webhook.Headers.IsNull())
+	})
+}
+
+func TestNewAlertIntegrationExclude(t *testing.T) {
+	assert.Nil(t, NewAlertIntegrationExclude(nil, nil))
+	assert.Nil(t, NewAlertIntegrationExclude(&apigen.Exclude{Clusters: &[]string{}}, nil))
+
+	prior := &AlertIntegrationExclude{
+		Clusters:    types.SetValueMust(types.StringType, []attr.Value{}),
+		AppServices: types.SetNull(types.StringType),
+	}
+	exclude := NewAlertIntegrationExclude(nil, prior)
+	require.NotNil(t, exclude)
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{}), exclude.Clusters)
+	assert.True(t, exclude.AppServices.IsNull())
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+)
+
+// AnalyticsAllowList maps the allowed CIDR of an analytics cluster to the Terraform state.
+type AnalyticsAllowList struct {
+	// Cidr represents the trusted CIDR to allow the database connections from.
+	Cidr types.String `tfsdk:"cidr"`
+
+	// Comment is a short description of the allowed CIDR.
+	Comment types.String `tfsdk:"comment"`
+
+	// ExpiresAt is an RFC3339 timestamp determining when the allowed CIDR should expire.
+	ExpiresAt types.String `tfsdk:"expires_at"`
+
+	// Id is a GUID4 identifier of the allowed CIDR.
+	Id types.String `tfsdk:"id"`
+
+	// OrganizationId is the organizationId of the capella tenant.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the projectId of the capella tenant.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// AnalyticsClusterId is the ID of the analytics cluster.
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+
+	// Status is the current status of the allowed CIDR, either 'active' or 'expired'.
+	Status types.String `tfsdk:"status"`
+
+	// Type is whether the allowed CIDR is 'permanent' or 'temporary'.
+	Type types.String `tfsdk:"type"`
+
+	// Audit represents all audit-related fields. It is of types.Object type to avoid conversion error for a nested field.
+	Audit types.Object `tfsdk:"audit"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AnalyticsAllowList) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId:     a.OrganizationId,
+		ProjectId:          a.ProjectId,
+		AnalyticsClusterId: a.AnalyticsClusterId,
+		Id:                 a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
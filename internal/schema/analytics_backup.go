@@ -0,0 +1,211 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AnalyticsBackup defines the Terraform state for a backup of an analytics cluster.
+type AnalyticsBackup struct {
+	// OrganizationId is the organizationId of the capella tenant.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the projectId of the capella tenant.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// AnalyticsClusterId is the ID of the analytics cluster that is backed up.
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+
+	// Id is the ID of the backup.
+	Id types.String `tfsdk:"id"`
+
+	// Retention is the number of hours the backup is retained for.
+	Retention types.Int64 `tfsdk:"retention"`
+
+	// CreatedAt is the time the backup was created.
+	CreatedAt types.String `tfsdk:"created_at"`
+
+	// Expiration is the time the backup expires.
+	Expiration types.String `tfsdk:"expiration"`
+
+	// DatabaseSize is the size of the database at the time of the backup.
+	DatabaseSize types.Int64 `tfsdk:"database_size"`
+
+	// Region is the region the backup is stored in.
+	Region types.String `tfsdk:"region"`
+
+	// Type is the type of the backup.
+	Type types.String `tfsdk:"type"`
+
+	// Progress is the status of the backup.
+	Progress types.Object `tfsdk:"progress"`
+
+	// RestoreTimes is increased to restore the analytics cluster from the backup.
+	RestoreTimes types.Number `tfsdk:"restore_times"`
+
+	// RestoreId is the ID of the latest restore started from Terraform.
+	RestoreId types.String `tfsdk:"restore_id"`
+
+	// RestoreStatus is the status of the latest restore started from Terraform.
+	RestoreStatus types.String `tfsdk:"restore_status"`
+
+	// Timeouts configures how long creating, restoring or deleting the backup may take.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// AnalyticsBackupData defines a single backup in the analytics backups data source.
+type AnalyticsBackupData struct {
+	Id           types.String `tfsdk:"id"`
+	Retention    types.Int64  `tfsdk:"retention"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	Expiration   types.String `tfsdk:"expiration"`
+	DatabaseSize types.Int64  `tfsdk:"database_size"`
+	Region       types.String `tfsdk:"region"`
+	Type         types.String `tfsdk:"type"`
+	Progress     types.Object `tfsdk:"progress"`
+}
+
+// AnalyticsBackups defines the Terraform state for the analytics backups data source.
+type AnalyticsBackups struct {
+	OrganizationId     types.String `tfsdk:"organization_id"`
+	ProjectId          types.String `tfsdk:"project_id"`
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+
+	// Data contains the list of backups.
+	Data []AnalyticsBackupData `tfsdk:"data"`
+}
+
+// AnalyticsRestoreData defines a single restore in the analytics restores data source.
+type AnalyticsRestoreData struct {
+	Id         types.String `tfsdk:"id"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	RestoreEnd types.String `tfsdk:"restore_end"`
+	RestoreTo  types.String `tfsdk:"restore_to"`
+	Snapshot   types.String `tfsdk:"snapshot"`
+	Status     types.String `tfsdk:"status"`
+}
+
+// AnalyticsRestores defines the Terraform state for the analytics restores data source.
+type AnalyticsRestores struct {
+	OrganizationId     types.String `tfsdk:"organization_id"`
+	ProjectId          types.String `tfsdk:"project_id"`
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+
+	// Data contains the list of restores.
+	Data []AnalyticsRestoreData `tfsdk:"data"`
+
+	// Filters filters the restores by status.
+	Filters *Filter `tfsdk:"filter"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AnalyticsBackup) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId:     a.OrganizationId,
+		ProjectId:          a.ProjectId,
+		AnalyticsClusterId: a.AnalyticsClusterId,
+		Id:                 a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAnalyticsBackupProgress converts the progress of a backup to the shared backup Progress type.
+// Missing values are stored as empty strings, as they are for snapshot backups.
+func NewAnalyticsBackupProgress(progress *apigen.ColumnarAnalyticsBackupProgress) Progress {
+	if progress == nil {
+		return Progress{Status: types.StringValue(""), Time: types.StringValue("")}
+	}
+
+	status := ""
+	if progress.Status != nil {
+		status = *progress.Status
+	}
+
+	return Progress{
+		Status: types.StringValue(status),
+		Time:   analyticsTimeValue(progress.Time),
+	}
+}
+
+// NewAnalyticsBackup creates a new analytics backup state object from the backup returned by Capella.
+// The restore attributes are not part of the backup and are left for the caller to set.
+func NewAnalyticsBackup(
+	backup apigen.GetColumnarAnalyticsBackupResponse,
+	organizationId, projectId, analyticsClusterId string,
+	progress basetypes.ObjectValue,
+) AnalyticsBackup {
+	return AnalyticsBackup{
+		OrganizationId:     types.StringValue(organizationId),
+		ProjectId:          types.StringValue(projectId),
+		AnalyticsClusterId: types.StringValue(analyticsClusterId),
+		Id:                 types.StringPointerValue(backup.Id),
+		Retention:          analyticsInt64Value(backup.Retention),
+		CreatedAt:          analyticsTimeValue(backup.CreatedAt),
+		Expiration:         analyticsTimeValue(backup.Expiration),
+		DatabaseSize:       analyticsSizeValue(backup.DatabaseSize),
+		Region:             types.StringPointerValue(backup.Region),
+		Type:               types.StringPointerValue(backup.Type),
+		Progress:           progress,
+	}
+}
+
+// NewAnalyticsBackupData creates a new backup entry of the analytics backups data source.
+func NewAnalyticsBackupData(backup apigen.GetColumnarAnalyticsBackupResponse, progress basetypes.ObjectValue) AnalyticsBackupData {
+	return AnalyticsBackupData{
+		Id:           types.StringPointerValue(backup.Id),
+		Retention:    analyticsInt64Value(backup.Retention),
+		CreatedAt:    analyticsTimeValue(backup.CreatedAt),
+		Expiration:   analyticsTimeValue(backup.Expiration),
+		DatabaseSize: analyticsSizeValue(backup.DatabaseSize),
+		Region:       types.StringPointerValue(backup.Region),
+		Type:         types.StringPointerValue(backup.Type),
+		Progress:     progress,
+	}
+}
+
+// NewAnalyticsRestoreData creates a new restore entry of the analytics restores data source.
+func NewAnalyticsRestoreData(restore apigen.GetColumnarAnalyticsRestoreResponse) AnalyticsRestoreData {
+	return AnalyticsRestoreData{
+		Id:         types.StringPointerValue(restore.Id),
+		CreatedAt:  analyticsTimeValue(restore.CreatedAt),
+		RestoreEnd: analyticsTimeValue(restore.RestoreEnd),
+		RestoreTo:  types.StringPointerValue(restore.RestoreTo),
+		Snapshot:   analyticsTimeValue(restore.Snapshot),
+		Status:     types.StringPointerValue(restore.Status),
+	}
+}
+
+// analyticsTimeValue formats an optional timestamp as RFC3339, returning null when it is missing.
+func analyticsTimeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+func analyticsInt64Value(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
+func analyticsSizeValue(v *uint64) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
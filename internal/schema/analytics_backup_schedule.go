@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AnalyticsBackupSchedule defines the Terraform state for the backup schedule of an analytics cluster.
+type AnalyticsBackupSchedule struct {
+	// OrganizationId is the organizationId of the capella tenant.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the projectId of the capella tenant.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// AnalyticsClusterId is the ID of the analytics cluster.
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+
+	// Interval is the time between backups in hours.
+	Interval types.Int64 `tfsdk:"interval"`
+
+	// Retention is the number of hours a backup is retained for.
+	Retention types.Int64 `tfsdk:"retention"`
+
+	// StartTime is the time of the first backup.
+	StartTime timetypes.RFC3339 `tfsdk:"start_time"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AnalyticsBackupSchedule) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId:     a.OrganizationId,
+		ProjectId:          a.ProjectId,
+		AnalyticsClusterId: a.AnalyticsClusterId,
+	}
+
+	IDs, err := validateSchemaState(state, AnalyticsClusterId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAnalyticsBackupSchedule creates a new analytics backup schedule state object from the
+// schedule returned by Capella.
+func NewAnalyticsBackupSchedule(
+	schedule apigen.GetColumnarAnalyticsBackupScheduleResponse,
+	organizationId, projectId, analyticsClusterId string,
+) *AnalyticsBackupSchedule {
+	startTime := timetypes.NewRFC3339Null()
+	if schedule.StartTime != nil {
+		startTime = timetypes.NewRFC3339TimeValue(*schedule.StartTime)
+	}
+
+	return &AnalyticsBackupSchedule{
+		OrganizationId:     types.StringValue(organizationId),
+		ProjectId:          types.StringValue(projectId),
+		AnalyticsClusterId: types.StringValue(analyticsClusterId),
+		Interval:           analyticsInt64Value(schedule.Interval),
+		Retention:          analyticsInt64Value(schedule.Retention),
+		StartTime:          startTime,
+	}
+}
@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestAnalyticsBackupValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AnalyticsBackup
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AnalyticsBackup{
+				OrganizationId:     basetypes.NewStringValue("100"),
+				ProjectId:          basetypes.NewStringValue("200"),
+				AnalyticsClusterId: basetypes.NewStringValue("300"),
+				Id:                 basetypes.NewStringValue("400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AnalyticsBackup{
+				Id: basetypes.NewStringValue("id=400,analytics_cluster_id=300,project_id=200,organization_id=100"),
+			},
+		},
+		{
+			name: "[NEGATIVE] analytics_cluster_id is missing from the import string",
+			input: AnalyticsBackup{
+				Id: basetypes.NewStringValue("id=400,project_id=200,organization_id=100"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[AnalyticsClusterId])
+			assert.Equal(t, "400", IDs[Id])
+		})
+	}
+}
+
+func TestAnalyticsBackupScheduleValidate(t *testing.T) {
+	input := AnalyticsBackupSchedule{
+		AnalyticsClusterId: basetypes.NewStringValue("analytics_cluster_id=300,project_id=200,organization_id=100"),
+	}
+
+	IDs, err := input.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, "100", IDs[OrganizationId])
+	assert.Equal(t, "200", IDs[ProjectId])
+	assert.Equal(t, "300", IDs[AnalyticsClusterId])
+}
+
+func TestNewAnalyticsBackupData(t *testing.T) {
+	var (
+		id        = "400"
+		retention = 24
+		size      = uint64(2048)
+		createdAt = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	data := NewAnalyticsBackupData(apigen.GetColumnarAnalyticsBackupResponse{
+		Id:           &id,
+		Retention:    &retention,
+		DatabaseSize: &size,
+		CreatedAt:    &createdAt,
+	}, types.ObjectNull(Progress{}.AttributeTypes()))
+
+	assert.Equal(t, types.StringValue("400"), data.Id)
+	assert.Equal(t, types.Int64Value(24), data.Retention)
+	assert.Equal(t, types.Int64Value(2048), data.DatabaseSize)
+	assert.Equal(t, types.StringValue("2026-01-02T03:04:05Z"), data.CreatedAt)
+	assert.True(t, data.Expiration.IsNull())
+	assert.True(t, data.Region.IsNull())
+}
+
+func TestNewAnalyticsBackupProgress(t *testing.T) {
+	assert.Equal(t, Progress{Status: types.StringValue(""), Time: types.StringValue("")}, NewAnalyticsBackupProgress(nil))
+
+	status := "complete"
+	progress := NewAnalyticsBackupProgress(&apigen.ColumnarAnalyticsBackupProgress{Status: &status})
+	assert.Equal(t, types.StringValue("complete"), progress.Status)
+	assert.True(t, progress.Time.IsNull())
+}
+
+func TestNewAnalyticsBackupSchedule(t *testing.T) {
+	var (
+		interval  = 6
+		retention = 168
+		startTime = time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	)
+
+	schedule := NewAnalyticsBackupSchedule(apigen.GetColumnarAnalyticsBackupScheduleResponse{
+		Interval:  &interval,
+		Retention: &retention,
+		StartTime: &startTime,
+	}, "100", "200", "300")
+
+	assert.Equal(t, types.Int64Value(6), schedule.Interval)
+	assert.Equal(t, types.Int64Value(168), schedule.Retention)
+	assert.Equal(t, "2026-01-02T03:00:00Z", schedule.StartTime.ValueString())
+	assert.Equal(t, types.StringValue("300"), schedule.AnalyticsClusterId)
+}
@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AnalyticsCluster defines the Terraform state for a Capella Columnar analytics cluster.
+type AnalyticsCluster struct {
+	// Availability is the availability zone type of the analytics cluster, either 'single' or 'multi'.
+	Availability *Availability `tfsdk:"availability"`
+
+	// Compute is the CPU and RAM configuration of each node.
+	Compute *Compute `tfsdk:"compute"`
+
+	// Support defines the support plan and timezone of the analytics cluster.
+	Support *Support `tfsdk:"support"`
+
+	// Id is the ID of the analytics cluster.
+	Id types.String `tfsdk:"id"`
+
+	// OrganizationId is the organizationId of the capella tenant.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the projectId of the capella tenant.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// Name is the name of the analytics cluster (up to 256 characters).
+	Name types.String `tfsdk:"name"`
+
+	// Description is the description of the analytics cluster (up to 1024 characters).
+	Description types.String `tfsdk:"description"`
+
+	// CloudProvider is the cloud provider the analytics cluster is deployed on, either 'aws' or 'gcp'.
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+
+	// Region is the cloud provider region the analytics cluster is deployed in.
+	Region types.String `tfsdk:"region"`
+
+	// Nodes is the number of nodes in the analytics cluster.
+	Nodes types.Int64 `tfsdk:"nodes"`
+
+	// State is the requested power state of the analytics cluster, either 'on' or 'off'.
+	// It is a Terraform-only attribute that drives the on/off endpoints.
+	State types.String `tfsdk:"state"`
+
+	// CurrentState is the current state of the analytics cluster as reported by Capella.
+	CurrentState types.String `tfsdk:"current_state"`
+
+	// IfMatch is a precondition header that specifies the entity tag of the resource.
+	IfMatch types.String `tfsdk:"if_match"`
+
+	// Etag represents the version of the document.
+	Etag types.String `tfsdk:"etag"`
+
+	// Timeouts configures how long the analytics cluster may take to be created, updated or deleted.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AnalyticsCluster) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		ProjectId:      a.ProjectId,
+		Id:             a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAnalyticsCluster creates a new AnalyticsCluster state object from the analytics cluster returned by Capella.
+// The Terraform-only state and if_match attributes are left for the caller to set.
+func NewAnalyticsCluster(cluster *apigen.GetColumnarAnalyticsClusterResponse, organizationId, projectId, etag string) *AnalyticsCluster {
+	description := ""
+	if cluster.Description != nil {
+		description = *cluster.Description
+	}
+
+	return &AnalyticsCluster{
+		Id:             types.StringValue(cluster.Id.String()),
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		Name:           types.StringValue(cluster.Name),
+		Description:    types.StringValue(description),
+		CloudProvider:  types.StringValue(cluster.CloudProvider),
+		Region:         types.StringValue(cluster.Region),
+		Nodes:          types.Int64Value(int64(cluster.Nodes)),
+		Availability: &Availability{
+			Type: types.StringValue(string(cluster.Availability.Type)),
+		},
+		Compute: &Compute{
+			Cpu: types.Int64Value(int64(cluster.Compute.Cpu)),
+			Ram: types.Int64Value(int64(cluster.Compute.Ram)),
+		},
+		Support: &Support{
+			Plan:     types.StringValue(string(cluster.Support.Plan)),
+			Timezone: types.StringValue(string(cluster.Support.Timezone)),
+		},
+		CurrentState: types.StringValue(string(cluster.CurrentState)),
+		Etag:         types.StringValue(etag),
+	}
+}
+
+// AnalyticsClusterPowerState maps the current state of an analytics cluster to the
+// 'on' or 'off' value of the state attribute. An empty string is returned while the
+// cluster is in a state that is neither, such as deploying or scaling.
+func AnalyticsClusterPowerState(currentState apigen.CurrentColumnarState) string {
+	switch currentState {
+	case apigen.CurrentColumnarStateHealthy, apigen.CurrentColumnarStateTurningOn:
+		return "on"
+	case apigen.CurrentColumnarStateTurnedOff, apigen.CurrentColumnarStateTurningOff:
+		return "off"
+	default:
+		return ""
+	}
+}
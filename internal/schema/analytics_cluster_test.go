@@ -0,0 +1,188 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestAnalyticsClusterValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AnalyticsCluster
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AnalyticsCluster{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				Id:             basetypes.NewStringValue("300"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AnalyticsCluster{
+				Id: basetypes.NewStringValue("id=300,project_id=200,organization_id=100"),
+			},
+		},
+		{
+			name: "[NEGATIVE] project_id is missing from the import string",
+			input: AnalyticsCluster{
+				Id: basetypes.NewStringValue("id=300,organization_id=100"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[Id])
+		})
+	}
+}
+
+func TestAnalyticsAllowListValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AnalyticsAllowList
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AnalyticsAllowList{
+				OrganizationId:     basetypes.NewStringValue("100"),
+				ProjectId:          basetypes.NewStringValue("200"),
+				AnalyticsClusterId: basetypes.NewStringValue("300"),
+				Id:                 basetypes.NewStringValue("400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AnalyticsAllowList{
+				Id: basetypes.NewStringValue("id=400,analytics_cluster_id=300,project_id=200,organization_id=100"),
+			},
+		},
+		{
+			name: "[NEGATIVE] analytics_cluster_id is missing from the import string",
+			input: AnalyticsAllowList{
+				Id: basetypes.NewStringValue("id=400,project_id=200,organization_id=100"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[AnalyticsClusterId])
+			assert.Equal(t, "400", IDs[Id])
+		})
+	}
+}
+
+func TestAnalyticsOnOffScheduleValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AnalyticsOnOffSchedule
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AnalyticsOnOffSchedule{
+				OrganizationId:     basetypes.NewStringValue("100"),
+				ProjectId:          basetypes.NewStringValue("200"),
+				AnalyticsClusterId: basetypes.NewStringValue("300"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AnalyticsOnOffSchedule{
+				AnalyticsClusterId: basetypes.NewStringValue("analytics_cluster_id=300,project_id=200,organization_id=100"),
+			},
+		},
+		{
+			name: "[NEGATIVE] organization_id is missing from the import string",
+			input: AnalyticsOnOffSchedule{
+				AnalyticsClusterId: basetypes.NewStringValue("analytics_cluster_id=300,project_id=200"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[AnalyticsClusterId])
+		})
+	}
+}
+
+func TestAnalyticsClusterPowerState(t *testing.T) {
+	tests := []struct {
+		state    apigen.CurrentColumnarState
+		expected string
+	}{
+		{state: apigen.CurrentColumnarStateHealthy, expected: "on"},
+		{state: apigen.CurrentColumnarStateTurningOn, expected: "on"},
+		{state: apigen.CurrentColumnarStateTurnedOff, expected: "off"},
+		{state: apigen.CurrentColumnarStateTurningOff, expected: "off"},
+		{state: apigen.CurrentColumnarStateDeploying, expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.state), func(t *testing.T) {
+			assert.Equal(t, test.expected, AnalyticsClusterPowerState(test.state))
+		})
+	}
+}
+
+func TestNewAnalyticsOnOffScheduleDefaultsTimeBoundary(t *testing.T) {
+	hour := 9
+	schedule := &apigen.ColumnarAnalyticsOnOffSchedule{
+		Timezone: "US/Pacific",
+		Days: []apigen.Days{
+			{Day: apigen.DaysDayMonday, State: apigen.On},
+			{Day: apigen.DaysDayTuesday, State: apigen.Custom, From: &apigen.OnTimeBoundary{Hour: &hour}},
+		},
+	}
+
+	got := NewAnalyticsOnOffSchedule(schedule, "100", "200", "300")
+
+	assert.Equal(t, types.StringValue("US/Pacific"), got.Timezone)
+	require.Len(t, got.Days, 2)
+	assert.Nil(t, got.Days[0].From)
+	assert.Nil(t, got.Days[1].To)
+	assert.Equal(t, &OnTimeBoundary{Hour: types.Int64Value(9), Minute: types.Int64Value(0)}, got.Days[1].From)
+}
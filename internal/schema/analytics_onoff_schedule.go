@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AnalyticsOnOffSchedule defines the Terraform state for the on/off schedule of an analytics cluster.
+// It uses the same day-wise layout as the operational cluster on/off schedule.
+type AnalyticsOnOffSchedule struct {
+	// OrganizationId is the organizationId of the capella tenant.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the projectId of the capella tenant.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// AnalyticsClusterId is the ID of the analytics cluster.
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+
+	// Timezone for the schedule.
+	Timezone types.String `tfsdk:"timezone"`
+
+	// Days is an array of day-wise schedule to manage the analytics cluster on/off state.
+	Days []DayItem `tfsdk:"days"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AnalyticsOnOffSchedule) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId:     a.OrganizationId,
+		ProjectId:          a.ProjectId,
+		AnalyticsClusterId: a.AnalyticsClusterId,
+	}
+
+	IDs, err := validateSchemaState(state, AnalyticsClusterId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAnalyticsOnOffSchedule creates a new analytics on/off schedule object from the schedule returned by Capella.
+func NewAnalyticsOnOffSchedule(
+	schedule *apigen.ColumnarAnalyticsOnOffSchedule,
+	organizationId, projectId, analyticsClusterId string,
+) *AnalyticsOnOffSchedule {
+	days := make([]DayItem, 0, len(schedule.Days))
+	for _, d := range schedule.Days {
+		days = append(days, DayItem{
+			Day:   types.StringValue(string(d.Day)),
+			State: types.StringValue(string(d.State)),
+			From:  newAnalyticsTimeBoundary(d.From),
+			To:    newAnalyticsTimeBoundary(d.To),
+		})
+	}
+
+	return &AnalyticsOnOffSchedule{
+		OrganizationId:     types.StringValue(organizationId),
+		ProjectId:          types.StringValue(projectId),
+		AnalyticsClusterId: types.StringValue(analyticsClusterId),
+		Timezone:           types.StringValue(string(schedule.Timezone)),
+		Days:               days,
+	}
+}
+
+// newAnalyticsTimeBoundary converts a time boundary of the schedule response. Missing hour
+// and minute values default to 0, as they do in the API.
+func newAnalyticsTimeBoundary(boundary *apigen.OnTimeBoundary) *OnTimeBoundary {
+	if boundary == nil {
+		return nil
+	}
+
+	var hour, minute int64
+	if boundary.Hour != nil {
+		hour = int64(*boundary.Hour)
+	}
+	if boundary.Minute != nil {
+		minute = int64(*boundary.Minute)
+	}
+
+	return &OnTimeBoundary{
+		Hour:   types.Int64Value(hour),
+		Minute: types.Int64Value(minute),
+	}
+}
@@ -0,0 +1,142 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AnalyticsPrivateEndpointService represents the status of private endpoint service on an analytics cluster.
+type AnalyticsPrivateEndpointService struct {
+	// OrganizationId is the ID of the organization to which the analytics cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the analytics cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// AnalyticsClusterId is the ID of the analytics cluster associated with the private endpoint service.
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+
+	// Enabled indicates if private endpoint service is enabled/disabled on the analytics cluster.
+	Enabled types.Bool `tfsdk:"enabled"`
+
+	// Status is the status of the private endpoint service. Possible values are
+	// enabling, enabled, enableFailed, disabling, disabled, disableFailed, idle and unknown.
+	Status types.String `tfsdk:"status"`
+
+	// ServiceName is the name of the private endpoint service.
+	ServiceName types.String `tfsdk:"service_name"`
+
+	// PrivateDns is the private DNS name used to connect to the analytics cluster.
+	PrivateDns types.String `tfsdk:"private_dns"`
+}
+
+// NewAnalyticsPrivateEndpointService creates a new private endpoint service state object
+// from the status returned by Capella.
+func NewAnalyticsPrivateEndpointService(
+	service apigen.GetPrivateEndpointServiceResponse,
+	organizationId, projectId, analyticsClusterId string,
+) *AnalyticsPrivateEndpointService {
+	return &AnalyticsPrivateEndpointService{
+		OrganizationId:     types.StringValue(organizationId),
+		ProjectId:          types.StringValue(projectId),
+		AnalyticsClusterId: types.StringValue(analyticsClusterId),
+		Enabled:            types.BoolValue(service.Enabled),
+		Status:             types.StringValue(string(service.Status)),
+		ServiceName:        types.StringValue(service.ServiceName),
+		PrivateDns:         types.StringValue(service.PrivateDns),
+	}
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AnalyticsPrivateEndpointService) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId:     a.OrganizationId,
+		ProjectId:          a.ProjectId,
+		AnalyticsClusterId: a.AnalyticsClusterId,
+	}
+
+	IDs, err := validateSchemaState(state, AnalyticsClusterId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// AnalyticsPrivateEndpoint represents a private endpoint connection to an analytics cluster.
+type AnalyticsPrivateEndpoint struct {
+	// EndpointId is the id of the private endpoint.
+	EndpointId types.String `tfsdk:"endpoint_id"`
+
+	// Status is the endpoint status. Possible values are failed, linked, pending, pendingAcceptance, rejected and unrecognized.
+	Status types.String `tfsdk:"status"`
+
+	// OrganizationId is the ID of the organization to which the analytics cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the analytics cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// AnalyticsClusterId is the ID of the analytics cluster associated with the private endpoint.
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+}
+
+// NewAnalyticsPrivateEndpoint creates a new private endpoint state object from the connection returned by Capella.
+func NewAnalyticsPrivateEndpoint(
+	endpoint apigen.GetPrivateEndpointServiceConnectionResponse,
+	organizationId, projectId, analyticsClusterId string,
+) *AnalyticsPrivateEndpoint {
+	return &AnalyticsPrivateEndpoint{
+		EndpointId:         types.StringValue(endpoint.EndpointId),
+		Status:             types.StringValue(string(endpoint.Status)),
+		OrganizationId:     types.StringValue(organizationId),
+		ProjectId:          types.StringValue(projectId),
+		AnalyticsClusterId: types.StringValue(analyticsClusterId),
+	}
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AnalyticsPrivateEndpoint) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId:     a.OrganizationId,
+		ProjectId:          a.ProjectId,
+		AnalyticsClusterId: a.AnalyticsClusterId,
+		EndpointId:         a.EndpointId,
+	}
+
+	IDs, err := validateSchemaState(state, EndpointId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// AnalyticsPrivateEndpoints defines the private endpoint connections of an analytics cluster.
+type AnalyticsPrivateEndpoints struct {
+	// OrganizationId is the ID of the organization to which the analytics cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the analytics cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// AnalyticsClusterId is the ID of the analytics cluster.
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+
+	// Data contains the private endpoint connections.
+	Data []AnalyticsPrivateEndpointData `tfsdk:"data"`
+}
+
+// AnalyticsPrivateEndpointData defines a single private endpoint connection to an analytics cluster.
+type AnalyticsPrivateEndpointData struct {
+	// Id is the endpoint id.
+	Id types.String `tfsdk:"id"`
+
+	// Status is the endpoint status. Possible values are failed, linked, pending, pendingAcceptance, rejected and unrecognized.
+	Status types.String `tfsdk:"status"`
+}
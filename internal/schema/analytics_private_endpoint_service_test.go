@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+)
+
+func TestAnalyticsPrivateEndpointServiceValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AnalyticsPrivateEndpointService
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AnalyticsPrivateEndpointService{
+				OrganizationId:     basetypes.NewStringValue("100"),
+				ProjectId:          basetypes.NewStringValue("200"),
+				AnalyticsClusterId: basetypes.NewStringValue("300"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AnalyticsPrivateEndpointService{
+				AnalyticsClusterId: basetypes.NewStringValue("analytics_cluster_id=300,organization_id=100,project_id=200"),
+			},
+		},
+		{
+			name: "[NEGATIVE] project_id is missing from the import string",
+			input: AnalyticsPrivateEndpointService{
+				AnalyticsClusterId: basetypes.NewStringValue("analytics_cluster_id=300,organization_id=100"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[AnalyticsClusterId])
+		})
+	}
+}
+
+func TestAnalyticsPrivateEndpointValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AnalyticsPrivateEndpoint
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AnalyticsPrivateEndpoint{
+				OrganizationId:     basetypes.NewStringValue("100"),
+				ProjectId:          basetypes.NewStringValue("200"),
+				AnalyticsClusterId: basetypes.NewStringValue("300"),
+				EndpointId:         basetypes.NewStringValue("vpce-400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AnalyticsPrivateEndpoint{
+				EndpointId: basetypes.NewStringValue("endpoint_id=vpce-400,organization_id=100,project_id=200,analytics_cluster_id=300"),
+			},
+		},
+		{
+			name: "[NEGATIVE] analytics_cluster_id is missing from the import string",
+			input: AnalyticsPrivateEndpoint{
+				EndpointId: basetypes.NewStringValue("endpoint_id=vpce-400,organization_id=100,project_id=200"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[AnalyticsClusterId])
+			assert.Equal(t, "vpce-400", IDs[EndpointId])
+		})
+	}
+}
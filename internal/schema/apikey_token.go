@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+)
+
+// ApiKeyToken is the ephemeral resource that passes the token of an existing
+// API key to other providers. The token is not stored in their state.
+type ApiKeyToken struct {
+	// OrganizationId is the ID of the organization the API key belongs to.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ApiKeyId is the ID of the API key.
+	ApiKeyId types.String `tfsdk:"api_key_id"`
+
+	// Token is the token of the API key, used to authorize requests made to v4 endpoints.
+	Token types.String `tfsdk:"token"`
+}
+
+// Validate is used to verify that all the fields in the ephemeral resource
+// have been populated.
+func (a *ApiKeyToken) Validate() error {
+	if a.OrganizationId.IsNull() {
+		return errors.ErrOrganizationIdMissing
+	}
+	if a.ApiKeyId.IsNull() {
+		return errors.ErrApiKeyIdMissing
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AppEndpointCollections defines the attributes as received from the V4 Capella Public API
+// when asked for the collections an App Endpoint is syncing.
+type AppEndpointCollections struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// AppEndpointName is the name of the App Endpoint.
+	AppEndpointName types.String `tfsdk:"app_endpoint_name"`
+
+	// Data contains the collections, ordered by scope and then collection name.
+	Data []AppEndpointCollectionData `tfsdk:"data"`
+}
+
+// AppEndpointCollectionData is a single collection synced by an App Endpoint.
+type AppEndpointCollectionData struct {
+	// Scope is the scope the collection belongs to.
+	Scope types.String `tfsdk:"scope"`
+
+	// Name is the name of the collection.
+	Name types.String `tfsdk:"name"`
+
+	// AccessControlFunction is the Javascript function applied to every document update in the collection.
+	AccessControlFunction types.String `tfsdk:"access_control_function"`
+
+	// ImportFilter is the Javascript function selecting the documents the App Endpoint imports.
+	ImportFilter types.String `tfsdk:"import_filter"`
+}
+
+// Validate is used to verify that all the fields in the datasource
+// have been populated.
+func (a *AppEndpointCollections) Validate() error {
+	if a.OrganizationId.IsNull() {
+		return errors.ErrOrganizationIdMissing
+	}
+	if a.ProjectId.IsNull() {
+		return errors.ErrProjectIdMissing
+	}
+	if a.ClusterId.IsNull() {
+		return errors.ErrClusterIdMissing
+	}
+	if a.AppServiceId.IsNull() {
+		return errors.ErrAppServiceIdMissing
+	}
+	if a.AppEndpointName.IsNull() {
+		return errors.ErrAppEndpointNameMissing
+	}
+	return nil
+}
+
+// NewAppEndpointCollectionsData flattens the scopes config of an App Endpoint into one entry
+// per collection. The API returns maps, so the entries are sorted to keep the list stable.
+func NewAppEndpointCollectionsData(scopes apigen.ScopesConfig) []AppEndpointCollectionData {
+	data := make([]AppEndpointCollectionData, 0)
+	for scopeName, scope := range scopes {
+		for collectionName, collection := range scope.Collections {
+			data = append(data, AppEndpointCollectionData{
+				Scope:                 types.StringValue(scopeName),
+				Name:                  types.StringValue(collectionName),
+				AccessControlFunction: types.StringPointerValue(collection.AccessControlFunction),
+				ImportFilter:          types.StringPointerValue(collection.ImportFilter),
+			})
+		}
+	}
+
+	sort.Slice(data, func(i, j int) bool {
+		if data[i].Scope.ValueString() != data[j].Scope.ValueString() {
+			return data[i].Scope.ValueString() < data[j].Scope.ValueString()
+		}
+		return data[i].Name.ValueString() < data[j].Name.ValueString()
+	})
+
+	return data
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestNewAppEndpointCollectionsData(t *testing.T) {
+	accessFunction := "function(doc){channel(doc.channels);}"
+	importFilter := "function(doc){return doc.type == 'hotel';}"
+
+	scopes := apigen.ScopesConfig{
+		"inventory": {
+			Collections: apigen.CollectionsConfig{
+				"hotel":   {AccessControlFunction: &accessFunction, ImportFilter: &importFilter},
+				"airline": {},
+			},
+		},
+		"_default": {
+			Collections: apigen.CollectionsConfig{
+				"_default": {AccessControlFunction: &accessFunction},
+			},
+		},
+	}
+
+	assert.Equal(t, []AppEndpointCollectionData{
+		{Scope: types.StringValue("_default"), Name: types.StringValue("_default"), AccessControlFunction: types.StringValue(accessFunction), ImportFilter: types.StringNull()},
+		{Scope: types.StringValue("inventory"), Name: types.StringValue("airline"), AccessControlFunction: types.StringNull(), ImportFilter: types.StringNull()},
+		{Scope: types.StringValue("inventory"), Name: types.StringValue("hotel"), AccessControlFunction: types.StringValue(accessFunction), ImportFilter: types.StringValue(importFilter)},
+	}, NewAppEndpointCollectionsData(scopes))
+}
+
+func TestNewAppEndpointCollectionsDataEmpty(t *testing.T) {
+	assert.Equal(t, []AppEndpointCollectionData{}, NewAppEndpointCollectionsData(nil))
+}
+
+func TestAppEndpointCollectionsValidate(t *testing.T) {
+	valid := AppEndpointCollections{
+		OrganizationId:  types.StringValue("org"),
+		ProjectId:       types.StringValue("project"),
+		ClusterId:       types.StringValue("cluster"),
+		AppServiceId:    types.StringValue("appService"),
+		AppEndpointName: types.StringValue("endpoint"),
+	}
+
+	tests := []struct {
+		name        string
+		mutate      func(*AppEndpointCollections)
+		expectedErr error
+	}{
+		{
+			name:   "[POSITIVE] all IDs set",
+			mutate: func(*AppEndpointCollections) {},
+		},
+		{
+			name:        "[NEGATIVE] missing app service ID",
+			mutate:      func(a *AppEndpointCollections) { a.AppServiceId = types.StringNull() },
+			expectedErr: errors.ErrAppServiceIdMissing,
+		},
+		{
+			name:        "[NEGATIVE] missing app endpoint name",
+			mutate:      func(a *AppEndpointCollections) { a.AppEndpointName = types.StringNull() },
+			expectedErr: errors.ErrAppEndpointNameMissing,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			collections := valid
+			test.mutate(&collections)
+
+			err := collections.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
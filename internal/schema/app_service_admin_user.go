@@ -0,0 +1,170 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AppServiceAdminUser defines the Terraform state for an admin user of the App Endpoints of an App Service.
+type AppServiceAdminUser struct {
+	// Audit contains the audit data for the admin user.
+	Audit types.Object `tfsdk:"audit"`
+
+	// Endpoints are the names of the App Endpoints the user can administer.
+	Endpoints types.Set `tfsdk:"endpoints"`
+
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// Id is the ID of the admin user.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the admin user.
+	Name types.String `tfsdk:"name"`
+
+	// Password is the password of the admin user. It is not returned by Capella.
+	Password types.String `tfsdk:"password"`
+
+	// AccessAllEndpoints is whether the user can administer every App Endpoint of the App Service.
+	AccessAllEndpoints types.Bool `tfsdk:"access_all_endpoints"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AppServiceAdminUser) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		ProjectId:      a.ProjectId,
+		ClusterId:      a.ClusterId,
+		AppServiceId:   a.AppServiceId,
+		Id:             a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAppServiceAdminUser creates a new admin user state object from the user returned by Capella.
+// The password is not returned, so it is carried over from prior.
+//
+// Capella may list every App Endpoint for a user with access to all of them, so endpoints are
+// kept null in that case. A user also gets access to the other App Endpoints of the same bucket,
+// so the prior endpoints are kept as long as Capella still returns all of them.
+func NewAppServiceAdminUser(
+	user apigen.AppServiceAdminUser,
+	organizationId, projectId, clusterId, appServiceId, id string,
+	prior *AppServiceAdminUser,
+	auditObject basetypes.ObjectValue,
+) *AppServiceAdminUser {
+	password := types.StringNull()
+	if prior != nil {
+		password = prior.Password
+	}
+
+	state := &AppServiceAdminUser{
+		OrganizationId:     types.StringValue(organizationId),
+		ProjectId:          types.StringValue(projectId),
+		ClusterId:          types.StringValue(clusterId),
+		AppServiceId:       types.StringValue(appServiceId),
+		Id:                 types.StringValue(id),
+		Name:               types.StringPointerValue(user.Name),
+		Password:           password,
+		AccessAllEndpoints: types.BoolValue(false),
+		Endpoints:          types.SetNull(types.StringType),
+		Audit:              auditObject,
+	}
+
+	if user.AccessAllEndpoints != nil {
+		state.AccessAllEndpoints = types.BoolValue(*user.AccessAllEndpoints)
+	}
+	if !state.AccessAllEndpoints.ValueBool() && user.Endpoints != nil {
+		state.Endpoints = newStringSet(*user.Endpoints)
+		if prior != nil && containsAllStrings(*user.Endpoints, prior.Endpoints) {
+			state.Endpoints = prior.Endpoints
+		}
+	}
+
+	return state
+}
+
+// containsAllStrings reports whether values contains every element of a known, non-null set.
+func containsAllStrings(values []string, set types.Set) bool {
+	if set.IsNull() || set.IsUnknown() {
+		return false
+	}
+
+	found := make(map[string]bool, len(values))
+	for _, value := range values {
+		found[value] = true
+	}
+	for _, element := range set.Elements() {
+		value, ok := element.(types.String)
+		if !ok || !found[value.ValueString()] {
+			return false
+		}
+	}
+	return true
+}
+
+// AppEndpointAdminUsers defines the attributes as received from the V4 Capella Public API
+// when asked to list the admin users of an App Endpoint.
+type AppEndpointAdminUsers struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// AppEndpointName is the name of the App Endpoint.
+	AppEndpointName types.String `tfsdk:"app_endpoint_name"`
+
+	// Data contains the admin users of the App Endpoint.
+	Data []AppServiceAdminUserData `tfsdk:"data"`
+}
+
+// AppServiceAdminUserData is a single admin user in a list.
+type AppServiceAdminUserData struct {
+	Audit              types.Object `tfsdk:"audit"`
+	Endpoints          types.Set    `tfsdk:"endpoints"`
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	AccessAllEndpoints types.Bool   `tfsdk:"access_all_endpoints"`
+}
+
+// NewAppServiceAdminUserData creates a new admin user data object from the user returned by Capella.
+func NewAppServiceAdminUserData(user apigen.AppServiceAdminUser, auditObject basetypes.ObjectValue) AppServiceAdminUserData {
+	data := AppServiceAdminUserData{
+		Id:                 types.StringPointerValue(user.Id),
+		Name:               types.StringPointerValue(user.Name),
+		AccessAllEndpoints: types.BoolPointerValue(user.AccessAllEndpoints),
+		Endpoints:          types.SetNull(types.StringType),
+		Audit:              auditObject,
+	}
+	if user.Endpoints != nil {
+		data.Endpoints = newStringSet(*user.Endpoints)
+	}
+	return data
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestAppServiceAdminUserValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AppServiceAdminUser
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AppServiceAdminUser{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				ClusterId:      basetypes.NewStringValue("300"),
+				AppServiceId:   basetypes.NewStringValue("400"),
+				Id:             basetypes.NewStringValue("500"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AppServiceAdminUser{
+				Id: basetypes.NewStringValue("id=500,organization_id=100,project_id=200,cluster_id=300,app_service_id=400"),
+			},
+		},
+		{
+			name: "[NEGATIVE] app_service_id is missing from the import string",
+			input: AppServiceAdminUser{
+				Id: basetypes.NewStringValue("id=500,organization_id=100,project_id=200,cluster_id=300"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[ClusterId])
+			assert.Equal(t, "400", IDs[AppServiceId])
+			assert.Equal(t, "500", IDs[Id])
+		})
+	}
+}
+
+func TestNewAppServiceAdminUser(t *testing.T) {
+	name := "admin"
+	audit := types.ObjectNull(CouchbaseAuditData{}.AttributeTypes())
+
+	t.Run("access to all endpoints keeps endpoints null", func(t *testing.T) {
+		accessAll := true
+		user := apigen.AppServiceAdminUser{
+			Name:               &name,
+			AccessAllEndpoints: &accessAll,
+			Endpoints:          &[]string{"one", "two"},
+		}
+
+		state := NewAppServiceAdminUser(user, "100", "200", "300", "400", "500", nil, audit)
+
+		assert.True(t, state.AccessAllEndpoints.ValueBool())
+		assert.True(t, state.Endpoints.IsNull())
+		assert.True(t, state.Password.IsNull())
+		assert.Equal(t, "admin", state.Name.ValueString())
+	})
+
+	t.Run("prior endpoints and password are kept", func(t *testing.T) {
+		accessAll := false
+		user := apigen.AppServiceAdminUser{
+			Name:               &name,
+			AccessAllEndpoints: &accessAll,
+			Endpoints:          &[]string{"one", "two"},
+		}
+		prior := &AppServiceAdminUser{
+			Password:  types.StringValue("secret"),
+			Endpoints: newStringSet([]string{"one"}),
+		}
+
+		state := NewAppServiceAdminUser(user, "100", "200", "300", "400", "500", prior, audit)
+
+		assert.Equal(t, "secret", state.Password.ValueString())
+		assert.Equal(t, prior.Endpoints, state.Endpoints)
+	})
+
+	t.Run("endpoints are replaced when a prior endpoint is missing", func(t *testing.T) {
+		accessAll := false
+		user := apigen.AppServiceAdminUser{
+			Name:               &name,
+			AccessAllEndpoints: &accessAll,
+			Endpoints:          &[]string{"two"},
+		}
+		prior := &AppServiceAdminUser{
+			Endpoints: newStringSet([]string{"one"}),
+		}
+
+		state := NewAppServiceAdminUser(user, "100", "200", "300", "400", "500", prior, audit)
+
+		assert.Equal(t, newStringSet([]string{"two"}), state.Endpoints)
+	})
+}
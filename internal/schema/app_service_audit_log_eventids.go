@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+)
+
+// AppServiceAuditLogEventIDs defines the attributes as received from the V4 Capella Public API
+// when asked for the audit log events of an App Endpoint.
+type AppServiceAuditLogEventIDs struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// AppEndpointName is the name of the App Endpoint.
+	AppEndpointName types.String `tfsdk:"app_endpoint_name"`
+
+	Data []AppServiceAuditLogEventID `tfsdk:"data"`
+}
+
+// AppServiceAuditLogEventID is a single App Service audit log event.
+type AppServiceAuditLogEventID struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Validate is used to verify that all the fields in the datasource
+// have been populated.
+func (a *AppServiceAuditLogEventIDs) Validate() error {
+	if a.OrganizationId.IsNull() {
+		return errors.ErrOrganizationIdMissing
+	}
+	if a.ProjectId.IsNull() {
+		return errors.ErrProjectIdMissing
+	}
+	if a.ClusterId.IsNull() {
+		return errors.ErrClusterIdMissing
+	}
+	if a.AppServiceId.IsNull() {
+		return errors.ErrAppServiceIdMissing
+	}
+	if a.AppEndpointName.IsNull() {
+		return errors.ErrAppEndpointNameMissing
+	}
+	return nil
+}
+
+// NewAppServiceAuditLogEventIDs converts the map of event IDs to event details returned by Capella
+// into a list of events sorted by ID. Details which are not an object are ignored.
+func NewAppServiceAuditLogEventIDs(events map[string]interface{}) ([]AppServiceAuditLogEventID, error) {
+	data := make([]AppServiceAuditLogEventID, 0, len(events))
+	for key, details := range events {
+		id, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid audit log event id %q: %w", key, err)
+		}
+
+		event := AppServiceAuditLogEventID{
+			Id:          types.Int64Value(id),
+			Name:        types.StringNull(),
+			Description: types.StringNull(),
+		}
+		if detailsMap, ok := details.(map[string]interface{}); ok {
+			if name, ok := detailsMap["name"].(string); ok {
+				event.Name = types.StringValue(name)
+			}
+			if description, ok := detailsMap["description"].(string); ok {
+				event.Description = types.StringValue(description)
+			}
+		}
+
+		data = append(data, event)
+	}
+
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].Id.ValueInt64() < data[j].Id.ValueInt64()
+	})
+
+	return data, nil
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAppServiceAuditLogEventIDs(t *testing.T) {
+	events := map[string]interface{}{
+		"53281": map[string]interface{}{"name": "Public HTTP request", "description": "A public HTTP request was made"},
+		"53280": map[string]interface{}{"name": "Admin HTTP request"},
+		"53282": "unexpected",
+	}
+
+	data, err := NewAppServiceAuditLogEventIDs(events)
+	require.NoError(t, err)
+
+	assert.Equal(t, []AppServiceAuditLogEventID{
+		{Id: types.Int64Value(53280), Name: types.StringValue("Admin HTTP request"), Description: types.StringNull()},
+		{Id: types.Int64Value(53281), Name: types.StringValue("Public HTTP request"), Description: types.StringValue("A public HTTP request was made")},
+		{Id: types.Int64Value(53282), Name: types.StringNull(), Description: types.StringNull()},
+	}, data)
+}
+
+func TestNewAppServiceAuditLogEventIDsInvalidId(t *testing.T) {
+	_, err := NewAppServiceAuditLogEventIDs(map[string]interface{}{"abc": map[string]interface{}{}})
+	assert.ErrorContains(t, err, `invalid audit log event id "abc"`)
+}
@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AppServiceAuditLogExport defines the Terraform state for an audit log export job of an App Service.
+type AppServiceAuditLogExport struct {
+	// Audit contains the audit data for the export job.
+	Audit types.Object `tfsdk:"audit"`
+
+	// Id is the ID of the export job.
+	Id types.String `tfsdk:"id"`
+
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// Start is the start date and time of the exported audit logs.
+	Start types.String `tfsdk:"start"`
+
+	// End is the end date and time of the exported audit logs.
+	End types.String `tfsdk:"end"`
+
+	// Status is the status of the export job.
+	Status types.String `tfsdk:"status"`
+
+	// DownloadId is the ID used to download the exported audit logs.
+	DownloadId types.String `tfsdk:"download_id"`
+
+	// DownloadExpires is when the download of the exported audit logs expires.
+	DownloadExpires types.String `tfsdk:"download_expires"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AppServiceAuditLogExport) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		ProjectId:      a.ProjectId,
+		ClusterId:      a.ClusterId,
+		AppServiceId:   a.AppServiceId,
+		Id:             a.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAppServiceAuditLogExport creates a new App Service audit log export state object.
+// Capella does not return the time window of an export, so start and end are carried over.
+func NewAppServiceAuditLogExport(
+	export apigen.GetAuditExportDocResponse,
+	organizationId, projectId, clusterId, appServiceId, id string,
+	start, end types.String,
+	auditObject basetypes.ObjectValue,
+) *AppServiceAuditLogExport {
+	return &AppServiceAuditLogExport{
+		Id:              types.StringValue(id),
+		OrganizationId:  types.StringValue(organizationId),
+		ProjectId:       types.StringValue(projectId),
+		ClusterId:       types.StringValue(clusterId),
+		AppServiceId:    types.StringValue(appServiceId),
+		Start:           start,
+		End:             end,
+		Status:          types.StringPointerValue(export.Status),
+		DownloadId:      types.StringPointerValue(export.DownloadId),
+		DownloadExpires: analyticsTimeValue(export.DownloadExpires),
+		Audit:           auditObject,
+	}
+}
+
+// AppServiceAuditLogExports defines the attributes as received from the V4 Capella Public API
+// when asked to list the audit log export jobs of an App Service.
+type AppServiceAuditLogExports struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// Data contains the audit log export jobs of the App Service.
+	Data []AppServiceAuditLogExportData `tfsdk:"data"`
+}
+
+// AppServiceAuditLogExportData is a single audit log export job in a list.
+type AppServiceAuditLogExportData struct {
+	Audit           types.Object `tfsdk:"audit"`
+	Id              types.String `tfsdk:"id"`
+	Status          types.String `tfsdk:"status"`
+	DownloadId      types.String `tfsdk:"download_id"`
+	DownloadExpires types.String `tfsdk:"download_expires"`
+}
+
+// NewAppServiceAuditLogExportData creates a new audit log export data object from the export job returned by Capella.
+func NewAppServiceAuditLogExportData(export apigen.GetAuditExportDocResponse, auditObject basetypes.ObjectValue) AppServiceAuditLogExportData {
+	return AppServiceAuditLogExportData{
+		Id:              types.StringPointerValue(export.Id),
+		Status:          types.StringPointerValue(export.Status),
+		DownloadId:      types.StringPointerValue(export.DownloadId),
+		DownloadExpires: analyticsTimeValue(export.DownloadExpires),
+		Audit:           auditObject,
+	}
+}
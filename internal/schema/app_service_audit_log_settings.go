@@ -0,0 +1,160 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AppServiceAuditLogSettings defines the Terraform state for the audit log settings of an App Service.
+type AppServiceAuditLogSettings struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// AuditEnabled is whether audit logging is enabled on the App Service.
+	AuditEnabled types.Bool `tfsdk:"audit_enabled"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AppServiceAuditLogSettings) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		ProjectId:      a.ProjectId,
+		ClusterId:      a.ClusterId,
+		AppServiceId:   a.AppServiceId,
+	}
+
+	IDs, err := validateSchemaState(state, AppServiceId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAppServiceAuditLogSettings creates a new App Service audit log settings state object.
+func NewAppServiceAuditLogSettings(settings apigen.CreateAppServiceAuditLogRequest, organizationId, projectId, clusterId, appServiceId string) *AppServiceAuditLogSettings {
+	return &AppServiceAuditLogSettings{
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		ClusterId:      types.StringValue(clusterId),
+		AppServiceId:   types.StringValue(appServiceId),
+		AuditEnabled:   types.BoolValue(settings.AuditEnabled),
+	}
+}
+
+// AppEndpointAuditLogSettings defines the Terraform state for the audit log settings of an App Endpoint.
+type AppEndpointAuditLogSettings struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// AppEndpointName is the name of the App Endpoint.
+	AppEndpointName types.String `tfsdk:"app_endpoint_name"`
+
+	// AuditEnabled is whether audit logging is enabled on the App Endpoint.
+	AuditEnabled types.Bool `tfsdk:"audit_enabled"`
+
+	// EnabledEventIds are the IDs of the audit events logged for the App Endpoint.
+	EnabledEventIds types.Set `tfsdk:"enabled_event_ids"`
+
+	// DisabledUsers are the users whose activity is not audited.
+	DisabledUsers types.Set `tfsdk:"disabled_users"`
+
+	// DisabledRoles are the roles whose activity is not audited.
+	DisabledRoles types.Set `tfsdk:"disabled_roles"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AppEndpointAuditLogSettings) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId:  a.OrganizationId,
+		ProjectId:       a.ProjectId,
+		ClusterId:       a.ClusterId,
+		AppServiceId:    a.AppServiceId,
+		AppEndpointName: a.AppEndpointName,
+	}
+
+	IDs, err := validateSchemaState(state, AppEndpointName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAppEndpointAuditLogSettings creates a new App Endpoint audit log settings state object.
+func NewAppEndpointAuditLogSettings(
+	ctx context.Context,
+	settings apigen.GetAppEndpointAuditLogResponse,
+	organizationId, projectId, clusterId, appServiceId, appEndpointName string,
+) (*AppEndpointAuditLogSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	state := &AppEndpointAuditLogSettings{
+		OrganizationId:  types.StringValue(organizationId),
+		ProjectId:       types.StringValue(projectId),
+		ClusterId:       types.StringValue(clusterId),
+		AppServiceId:    types.StringValue(appServiceId),
+		AppEndpointName: types.StringValue(appEndpointName),
+		AuditEnabled:    types.BoolValue(settings.AuditEnabled != nil && *settings.AuditEnabled),
+	}
+
+	eventIds := make([]int64, 0)
+	if settings.EnabledEventIds != nil {
+		for _, event := range *settings.EnabledEventIds {
+			if event.Id != nil {
+				eventIds = append(eventIds, int64(*event.Id))
+			}
+		}
+	}
+	var d diag.Diagnostics
+	state.EnabledEventIds, d = types.SetValueFrom(ctx, types.Int64Type, eventIds)
+	diags.Append(d...)
+
+	state.DisabledUsers, d = newDisabledUserRoleSet(ctx, settings.DisabledUsers)
+	diags.Append(d...)
+
+	state.DisabledRoles, d = newDisabledUserRoleSet(ctx, settings.DisabledRoles)
+	diags.Append(d...)
+
+	return state, diags
+}
+
+// newDisabledUserRoleSet converts the disabled users or roles returned by Capella into a set.
+func newDisabledUserRoleSet(ctx context.Context, userRoles *apigen.DisabledUserRoles) (types.Set, diag.Diagnostics) {
+	elements := make([]AuditSettingsDisabledUser, 0)
+	if userRoles != nil {
+		for _, userRole := range *userRoles {
+			elements = append(elements, AuditSettingsDisabledUser{
+				Domain: types.StringValue(userRole.Domain),
+				Name:   types.StringValue(userRole.Name),
+			})
+		}
+	}
+
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AuditSettingsDisabledUser{}.AttributeTypes()}, elements)
+}
@@ -0,0 +1,140 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestAppServiceAuditLogSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AppServiceAuditLogSettings
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AppServiceAuditLogSettings{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				ClusterId:      basetypes.NewStringValue("300"),
+				AppServiceId:   basetypes.NewStringValue("400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AppServiceAuditLogSettings{
+				AppServiceId: basetypes.NewStringValue("app_service_id=400,organization_id=100,project_id=200,cluster_id=300"),
+			},
+		},
+		{
+			name: "[NEGATIVE] project_id is missing from the import string",
+			input: AppServiceAuditLogSettings{
+				AppServiceId: basetypes.NewStringValue("app_service_id=400,organization_id=100,cluster_id=300"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[ClusterId])
+			assert.Equal(t, "400", IDs[AppServiceId])
+		})
+	}
+}
+
+func TestAppEndpointAuditLogSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AppEndpointAuditLogSettings
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AppEndpointAuditLogSettings{
+				OrganizationId:  basetypes.NewStringValue("100"),
+				ProjectId:       basetypes.NewStringValue("200"),
+				ClusterId:       basetypes.NewStringValue("300"),
+				AppServiceId:    basetypes.NewStringValue("400"),
+				AppEndpointName: basetypes.NewStringValue("endpoint"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AppEndpointAuditLogSettings{
+				AppEndpointName: basetypes.NewStringValue("app_endpoint_name=endpoint,app_service_id=400,organization_id=100,project_id=200,cluster_id=300"),
+			},
+		},
+		{
+			name: "[NEGATIVE] app_service_id is missing from the import string",
+			input: AppEndpointAuditLogSettings{
+				AppEndpointName: basetypes.NewStringValue("app_endpoint_name=endpoint,organization_id=100,project_id=200,cluster_id=300"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[ClusterId])
+			assert.Equal(t, "400", IDs[AppServiceId])
+			assert.Equal(t, "endpoint", IDs[AppEndpointName])
+		})
+	}
+}
+
+func TestNewAppEndpointAuditLogSettings(t *testing.T) {
+	enabled := true
+	eventId := 53280
+	settings := apigen.GetAppEndpointAuditLogResponse{
+		AuditEnabled: &enabled,
+		EnabledEventIds: &[]struct {
+			Id *int `json:"id,omitempty"`
+		}{{Id: &eventId}, {Id: nil}},
+		DisabledUsers: &apigen.DisabledUserRoles{{Domain: "sgw", Name: "alice"}},
+	}
+
+	state, diags := NewAppEndpointAuditLogSettings(context.Background(), settings, "100", "200", "300", "400", "endpoint")
+	require.False(t, diags.HasError())
+
+	assert.True(t, state.AuditEnabled.ValueBool())
+	assert.Equal(t, "endpoint", state.AppEndpointName.ValueString())
+
+	var eventIds []int64
+	require.False(t, state.EnabledEventIds.ElementsAs(context.Background(), &eventIds, false).HasError())
+	assert.Equal(t, []int64{53280}, eventIds)
+
+	var users []AuditSettingsDisabledUser
+	require.False(t, state.DisabledUsers.ElementsAs(context.Background(), &users, false).HasError())
+	assert.Equal(t, []AuditSettingsDisabledUser{{Domain: types.StringValue("sgw"), Name: types.StringValue("alice")}}, users)
+
+	// A missing list is an empty set rather than null, so an unconfigured attribute does not drift.
+	assert.False(t, state.DisabledRoles.IsNull())
+	assert.Empty(t, state.DisabledRoles.Elements())
+}
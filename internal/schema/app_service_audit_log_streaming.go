@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AppServiceAuditLogStreaming defines the Terraform state for the audit log streaming of an App Service.
+type AppServiceAuditLogStreaming struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// OutputType is the log collector type (datadog, dynatrace, elastic, generic_http, loki, splunk, sumologic).
+	OutputType types.String `tfsdk:"output_type"`
+
+	// Credentials contains the credentials for the configured log collector. It is not returned by Capella.
+	Credentials types.Object `tfsdk:"credentials"`
+
+	// DisabledAppEndpoints are the App Endpoints excluded from audit log streaming.
+	DisabledAppEndpoints types.Set `tfsdk:"disabled_app_endpoints"`
+
+	// StreamingEnabled is whether audit logs are streamed. Setting it to false pauses streaming.
+	StreamingEnabled types.Bool `tfsdk:"streaming_enabled"`
+
+	// LogStreamingState is the current state of audit log streaming.
+	LogStreamingState types.String `tfsdk:"log_streaming_state"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AppServiceAuditLogStreaming) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		ProjectId:      a.ProjectId,
+		ClusterId:      a.ClusterId,
+		AppServiceId:   a.AppServiceId,
+	}
+
+	IDs, err := validateSchemaState(state, AppServiceId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewAppServiceAuditLogStreaming creates a new App Service audit log streaming state object.
+// The credentials are not returned by Capella, so they are carried over from the plan or state.
+func NewAppServiceAuditLogStreaming(
+	streaming apigen.GetAuditLogStreamingResponse,
+	organizationId, projectId, clusterId, appServiceId string,
+	existingCredentials types.Object,
+) *AppServiceAuditLogStreaming {
+	state := &AppServiceAuditLogStreaming{
+		OrganizationId:       types.StringValue(organizationId),
+		ProjectId:            types.StringValue(projectId),
+		ClusterId:            types.StringValue(clusterId),
+		AppServiceId:         types.StringValue(appServiceId),
+		OutputType:           types.StringPointerValue(streaming.OutputType),
+		Credentials:          existingCredentials,
+		DisabledAppEndpoints: newStringSet(nil),
+		StreamingEnabled:     types.BoolValue(streaming.StreamingEnabled != nil && *streaming.StreamingEnabled),
+		LogStreamingState:    types.StringPointerValue(streaming.LogStreamingState),
+	}
+
+	if streaming.DisabledAppEndpoints != nil {
+		state.DisabledAppEndpoints = newStringSet(*streaming.DisabledAppEndpoints)
+	}
+
+	return state
+}
+
+// AsLogStreamingCredentials converts the Credentials types.Object into a LogStreamingCredentials struct.
+// Returns nil if the object is null or unknown.
+func (a *AppServiceAuditLogStreaming) AsLogStreamingCredentials(ctx context.Context) (*LogStreamingCredentials, diag.Diagnostics) {
+	if a.Credentials.IsNull() || a.Credentials.IsUnknown() {
+		return nil, nil
+	}
+	var creds LogStreamingCredentials
+	diags := a.Credentials.As(ctx, &creds, basetypes.ObjectAsOptions{})
+	return &creds, diags
+}
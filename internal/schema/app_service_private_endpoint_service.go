@@ -0,0 +1,135 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// AppServicePrivateEndpointService represents the status of private endpoint service on an App Service.
+type AppServicePrivateEndpointService struct {
+	// OrganizationId is the ID of the organization to which the App Service belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the App Service belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster the App Service is linked to.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service associated with the private endpoint service.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// Enabled indicates if private endpoint service is enabled/disabled on the App Service.
+	Enabled types.Bool `tfsdk:"enabled"`
+
+	// State is the status of the private endpoint service. Possible values are
+	// enabling, enabled, disabling, disabled and failed.
+	State types.String `tfsdk:"state"`
+}
+
+// NewAppServicePrivateEndpointService creates a new private endpoint service state object
+// from the status returned by Capella. The service is considered enabled when Capella
+// reports enabled as its target state, or its state when there is no target state.
+func NewAppServicePrivateEndpointService(
+	status apigen.GetAppServicePrivateEndpointStateResponse,
+	organizationId, projectId, clusterId, appServiceId string,
+) *AppServicePrivateEndpointService {
+	state := &AppServicePrivateEndpointService{
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		ClusterId:      types.StringValue(clusterId),
+		AppServiceId:   types.StringValue(appServiceId),
+		Enabled:        types.BoolValue(false),
+		State:          types.StringNull(),
+	}
+
+	if status.State != nil {
+		state.State = types.StringValue(string(*status.State))
+		state.Enabled = types.BoolValue(*status.State == apigen.GetAppServicePrivateEndpointStateResponseStateEnabled)
+	}
+	if status.TargetState != nil {
+		state.Enabled = types.BoolValue(*status.TargetState == apigen.GetAppServicePrivateEndpointStateResponseTargetStateEnabled)
+	}
+
+	return state
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AppServicePrivateEndpointService) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		ProjectId:      a.ProjectId,
+		ClusterId:      a.ClusterId,
+		AppServiceId:   a.AppServiceId,
+	}
+
+	IDs, err := validateSchemaState(state, AppServiceId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// AppServicePrivateEndpoint represents a private endpoint of an App Service.
+type AppServicePrivateEndpoint struct {
+	// EndpointId is the id of the private endpoint.
+	EndpointId types.String `tfsdk:"endpoint_id"`
+
+	// Status is the endpoint status. Possible values are failed, linked, pending, pendingAcceptance, rejected and unrecognized.
+	Status types.String `tfsdk:"status"`
+
+	// ServiceName is the name of the private endpoint service.
+	ServiceName types.String `tfsdk:"service_name"`
+
+	// OrganizationId is the ID of the organization to which the App Service belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the App Service belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster the App Service is linked to.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// AppServiceId is the ID of the App Service associated with the private endpoint.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+}
+
+// NewAppServicePrivateEndpoint creates a new private endpoint state object from the endpoint returned by Capella.
+func NewAppServicePrivateEndpoint(
+	endpoint apigen.PrivateEndpoint,
+	organizationId, projectId, clusterId, appServiceId string,
+) *AppServicePrivateEndpoint {
+	return &AppServicePrivateEndpoint{
+		EndpointId:     types.StringValue(endpoint.Id),
+		Status:         types.StringValue(string(endpoint.Status)),
+		ServiceName:    types.StringPointerValue(endpoint.ServiceName),
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		ClusterId:      types.StringValue(clusterId),
+		AppServiceId:   types.StringValue(appServiceId),
+	}
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (a *AppServicePrivateEndpoint) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: a.OrganizationId,
+		ProjectId:      a.ProjectId,
+		ClusterId:      a.ClusterId,
+		AppServiceId:   a.AppServiceId,
+		EndpointId:     a.EndpointId,
+	}
+
+	IDs, err := validateSchemaState(state, EndpointId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestAppServicePrivateEndpointServiceValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       AppServicePrivateEndpointService
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: AppServicePrivateEndpointService{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				ClusterId:      basetypes.NewStringValue("300"),
+				AppServiceId:   basetypes.NewStringValue("400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: AppServicePrivateEndpointService{
+				AppServiceId: basetypes.NewStringValue("app_service_id=400,organization_id=100,project_id=200,cluster_id=300"),
+			},
+		},
+		{
+			name: "[NEGATIVE] cluster_id is missing from the import string",
+			input: AppServicePrivateEndpointService{
+				AppServiceId: basetypes.NewStringValue("app_service_id=400,organization_id=100,project_id=200"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[ClusterId])
+			assert.Equal(t, "400", IDs[AppServiceId])
+		})
+	}
+}
+
+func TestNewAppServicePrivateEndpointService(t *testing.T) {
+	tests := []struct {
+		name            string
+		state           apigen.GetAppServicePrivateEndpointStateResponseState
+		targetState     apigen.GetAppServicePrivateEndpointStateResponseTargetState
+		expectedEnabled bool
+		expectedState   string
+	}{
+		{
+			name:            "enabled",
+			state:           apigen.GetAppServicePrivateEndpointStateResponseStateEnabled,
+			targetState:     apigen.GetAppServicePrivateEndpointStateResponseTargetStateEnabled,
+			expectedEnabled: true,
+			expectedState:   "enabled",
+		},
+		{
+			name:            "disabling is not enabled",
+			state:           apigen.GetAppServicePrivateEndpointStateResponseStateDisabling,
+			targetState:     apigen.GetAppServicePrivateEndpointStateResponseTargetStateDisabled,
+			expectedEnabled: false,
+			expectedState:   "disabling",
+		},
+		{
+			name:            "failed enablement is enabled",
+			state:           apigen.GetAppServicePrivateEndpointStateResponseStateFailed,
+			targetState:     apigen.GetAppServicePrivateEndpointStateResponseTargetStateEnabled,
+			expectedEnabled: true,
+			expectedState:   "failed",
+		},
+		{
+			name:            "state is used without a target state",
+			state:           apigen.GetAppServicePrivateEndpointStateResponseStateEnabled,
+			expectedEnabled: true,
+			expectedState:   "enabled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := apigen.GetAppServicePrivateEndpointStateResponse{State: &test.state}
+			if test.targetState != "" {
+				status.TargetState = &test.targetState
+			}
+
+			state := NewAppServicePrivateEndpointService(status, "100", "200", "300", "400")
+
+			assert.Equal(t, test.expectedEnabled, state.Enabled.ValueBool())
+			assert.Equal(t, test.expectedState, state.State.ValueString())
+			assert.Equal(t, "400", state.AppServiceId.ValueString())
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/appservice"
@@ -66,6 +67,9 @@ type AppService struct {
 
 	// Nodes is the number of nodes configured for the app service.
 	Nodes types.Int64 `tfsdk:"nodes"`
+
+	// Timeouts configures how long the app service may take to be created, updated or deleted.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // AppServiceCompute depicts the couchbase compute, following are the supported compute combinations
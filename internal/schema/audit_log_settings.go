@@ -3,6 +3,7 @@ package schema
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -26,6 +27,13 @@ type AuditSettingsDisabledUser struct {
 	Name types.String `tfsdk:"name"`
 }
 
+func (a AuditSettingsDisabledUser) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"domain": types.StringType,
+		"name":   types.StringType,
+	}
+}
+
 // Validate is used to verify that IDs have been properly imported.
 func (c *ClusterAuditSettings) Validate() (map[Attr]string, error) {
 	state := map[Attr]basetypes.StringValue{
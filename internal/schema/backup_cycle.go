@@ -0,0 +1,120 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// BackupCycles defines the Terraform state for the backup cycles of a bucket.
+type BackupCycles struct {
+	// OrganizationId is the ID of the organization to which the Capella cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the Capella cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster to which the bucket belongs.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// BucketId is the ID of the bucket.
+	BucketId types.String `tfsdk:"bucket_id"`
+
+	// Data contains the backup cycles of the bucket.
+	Data []BackupCycle `tfsdk:"data"`
+}
+
+// BackupCycle summarises a backup cycle: a full backup and the incremental backups taken after it.
+type BackupCycle struct {
+	// CycleId is the ID of the backup cycle.
+	CycleId types.String `tfsdk:"cycle_id"`
+
+	// CreatedAt is the RFC3339 timestamp at which the first backup of the cycle was created.
+	CreatedAt types.String `tfsdk:"created_at"`
+
+	// LastBackupAt is the RFC3339 timestamp at which the latest backup of the cycle was created.
+	LastBackupAt types.String `tfsdk:"last_backup_at"`
+
+	// BackupCount is the number of backups in the cycle.
+	BackupCount types.Int64 `tfsdk:"backup_count"`
+
+	// SizeInMb is the total size of the backups in the cycle in megabytes.
+	SizeInMb types.Float64 `tfsdk:"size_in_mb"`
+}
+
+// NewBackupCycle creates a new BackupCycle from a cycle and the backups it contains.
+func NewBackupCycle(cycle apigen.GetCycleResponse, backups []apigen.GetBackupByIDResponse) BackupCycle {
+	var sizeInMb float64
+	for _, backup := range backups {
+		sizeInMb += float64(backup.Stats.SizeInMb)
+	}
+
+	return BackupCycle{
+		CycleId:      types.StringValue(cycle.CycleID.String()),
+		CreatedAt:    types.StringValue(cycle.CreatedAt.Format(time.RFC3339)),
+		LastBackupAt: types.StringValue(LastBackupTime(cycle, backups).Format(time.RFC3339)),
+		BackupCount:  types.Int64Value(int64(len(backups))),
+		SizeInMb:     types.Float64Value(sizeInMb),
+	}
+}
+
+// LastBackupTime returns the creation time of the latest backup in a cycle,
+// falling back to the creation time of the cycle when no backup has a date.
+func LastBackupTime(cycle apigen.GetCycleResponse, backups []apigen.GetBackupByIDResponse) time.Time {
+	last := cycle.CreatedAt
+	for _, backup := range backups {
+		if backup.Date != nil && backup.Date.After(last) {
+			last = *backup.Date
+		}
+	}
+	return last
+}
+
+// BackupCycleRetention defines the Terraform state for deleting the backup cycles
+// of a bucket once they are older than a configured age.
+type BackupCycleRetention struct {
+	// OrganizationId is the ID of the organization to which the Capella cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the Capella cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster to which the bucket belongs.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// BucketId is the ID of the bucket.
+	BucketId types.String `tfsdk:"bucket_id"`
+
+	// MaxAgeDays is the number of days after its latest backup that a cycle is deleted.
+	MaxAgeDays types.Int64 `tfsdk:"max_age_days"`
+
+	// ExpiredCycleIds are the IDs of the cycles that are older than max_age_days
+	// and will be deleted on the next apply.
+	ExpiredCycleIds types.Set `tfsdk:"expired_cycle_ids"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (b *BackupCycleRetention) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: b.OrganizationId,
+		ProjectId:      b.ProjectId,
+		ClusterId:      b.ClusterId,
+		BucketId:       b.BucketId,
+	}
+
+	IDs, err := validateSchemaState(state, BucketId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate resource state: %w", err)
+	}
+
+	return IDs, nil
+}
+
+// NewExpiredCycleIds creates the set of expired cycle IDs stored in the Terraform state.
+func NewExpiredCycleIds(cycleIds []string) types.Set {
+	return newStringSet(cycleIds)
+}
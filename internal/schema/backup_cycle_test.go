@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestNewBackupCycle(t *testing.T) {
+	cycleId := uuid.New()
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	firstBackup := createdAt.Add(time.Hour)
+	lastBackup := createdAt.Add(48 * time.Hour)
+
+	cycle := apigen.GetCycleResponse{CycleID: cycleId, CreatedAt: createdAt}
+	backups := []apigen.GetBackupByIDResponse{
+		{Date: &lastBackup, Stats: apigen.BackupStats{SizeInMb: 1.5}},
+		{Date: &firstBackup, Stats: apigen.BackupStats{SizeInMb: 10}},
+		{Stats: apigen.BackupStats{SizeInMb: 0.5}},
+	}
+
+	state := NewBackupCycle(cycle, backups)
+
+	assert.Equal(t, cycleId.String(), state.CycleId.ValueString())
+	assert.Equal(t, "2026-01-01T00:00:00Z", state.CreatedAt.ValueString())
+	assert.Equal(t, "2026-01-03T00:00:00Z", state.LastBackupAt.ValueString())
+	assert.Equal(t, int64(3), state.BackupCount.ValueInt64())
+	assert.Equal(t, 12.0, state.SizeInMb.ValueFloat64())
+}
+
+func TestLastBackupTimeWithoutBackups(t *testing.T) {
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, createdAt, LastBackupTime(apigen.GetCycleResponse{CreatedAt: createdAt}, nil))
+}
+
+func TestBackupCycleRetentionValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       BackupCycleRetention
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: BackupCycleRetention{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				ClusterId:      basetypes.NewStringValue("300"),
+				BucketId:       basetypes.NewStringValue("YnVja2V0=="),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: BackupCycleRetention{
+				BucketId: basetypes.NewStringValue("bucket_id=YnVja2V0==,organization_id=100,project_id=200,cluster_id=300"),
+			},
+		},
+		{
+			name: "[NEGATIVE] cluster_id is missing from the import string",
+			input: BackupCycleRetention{
+				BucketId: basetypes.NewStringValue("bucket_id=YnVja2V0==,organization_id=100,project_id=200"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[ClusterId])
+			assert.Equal(t, "YnVja2V0==", IDs[BucketId])
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -124,6 +125,23 @@ type Bucket struct {
 	Vbuckets types.Int64 `tfsdk:"vbuckets"`
 }
 
+// BucketWithTimeouts is the plan and state of the bucket resource. Bucket is shared with the
+// free-tier bucket resource, so the timeouts block only the bucket resource has is added here.
+type BucketWithTimeouts struct {
+	Bucket
+
+	// Timeouts configures how long the bucket may take to be created, updated or deleted.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// OneBucketWithTimeouts is the state of the bucket resource built from a bucket read from Capella.
+type OneBucketWithTimeouts struct {
+	OneBucket
+
+	// Timeouts configures how long the bucket may take to be created, updated or deleted.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 // Stats has the bucket stats that are related to memory and disk consumption.
 type Stats struct {
 	// ItemCount: Number of documents in the bucket.
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,7 +23,8 @@ import (
 	customvalidator "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/schema/validator"
 )
 
-// SchemaAttribute is a type constraint for supported attribute types across resources and datasources
+// SchemaAttribute is a type constraint for supported attribute types across resources, datasources
+// and ephemeral resources
 type SchemaAttribute interface {
 	*resourceschema.StringAttribute | *resourceschema.Int64Attribute | *resourceschema.BoolAttribute |
 		*resourceschema.SetAttribute | *resourceschema.Float64Attribute | *resourceschema.NumberAttribute |
@@ -32,12 +34,13 @@ type SchemaAttribute interface {
 		*datasourceschema.BoolAttribute | *datasourceschema.SetAttribute | *datasourceschema.Float64Attribute |
 		*datasourceschema.NumberAttribute | *datasourceschema.ListAttribute | *datasourceschema.MapAttribute |
 		*datasourceschema.SingleNestedAttribute | *datasourceschema.ObjectAttribute | *datasourceschema.SetNestedAttribute |
-		*datasourceschema.ListNestedAttribute | *datasourceschema.MapNestedAttribute
+		*datasourceschema.ListNestedAttribute | *datasourceschema.MapNestedAttribute |
+		*ephemeralschema.StringAttribute
 }
 
 // SchemaAttributeMap is a type constraint for attribute maps
 type SchemaAttributeMap interface {
-	map[string]resourceschema.Attribute | map[string]datasourceschema.Attribute
+	map[string]resourceschema.Attribute | map[string]datasourceschema.Attribute | map[string]ephemeralschema.Attribute
 }
 
 // SchemaBuilder provides methods for building resource and data source schemas with OpenAPI integration.
@@ -100,7 +103,7 @@ func WithOpenAPIDescription[T SchemaAttribute](b *SchemaBuilder, attr T, fieldNa
 }
 
 // AddAttr adds an attribute with automatic description to the attributes map.
-// Works for both resource and datasource schemas.
+// Works for resource, datasource and ephemeral resource schemas.
 //
 // Description is automatically loaded from the OpenAPI spec:
 // 1. Path parameters (organization_id, project_id, etc.) from components.parameters
@@ -184,6 +187,12 @@ func AddAttr[M SchemaAttributeMap, T SchemaAttribute](
 			panic("failed to convert attribute to datasourceschema.Attribute")
 		}
 		(*m)[fieldName] = result
+	case *map[string]ephemeralschema.Attribute:
+		result, ok := any(attr).(ephemeralschema.Attribute)
+		if !ok {
+			panic("failed to convert attribute to ephemeralschema.Attribute")
+		}
+		(*m)[fieldName] = result
 	default:
 		panic("unsupported attribute map type")
 	}
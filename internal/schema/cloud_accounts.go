@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// CloudAccounts defines the Terraform state for the cloud accounts Capella deploys clusters in.
+type CloudAccounts struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// AwsCapellaAccount is the ID of the AWS account used by Capella.
+	AwsCapellaAccount types.String `tfsdk:"aws_capella_account"`
+
+	// AzureCapellaSubscription is the name of the Azure subscription used by Capella.
+	AzureCapellaSubscription types.String `tfsdk:"azure_capella_subscription"`
+
+	// GcpCapellaProject is the name of the GCP project used by Capella.
+	GcpCapellaProject types.String `tfsdk:"gcp_capella_project"`
+}
+
+// NewCloudAccounts creates a new CloudAccounts data source state from the API response.
+func NewCloudAccounts(accounts apigen.CloudAccounts, organizationId string) CloudAccounts {
+	return CloudAccounts{
+		OrganizationId:           types.StringValue(organizationId),
+		AwsCapellaAccount:        types.StringValue(accounts.AwsCapellaAccount),
+		AzureCapellaSubscription: types.StringValue(accounts.AzureCapellaSubscription),
+		GcpCapellaProject:        types.StringValue(accounts.GcpCapellaProject),
+	}
+}
@@ -0,0 +1,22 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestNewCloudAccounts(t *testing.T) {
+	state := NewCloudAccounts(apigen.CloudAccounts{
+		AwsCapellaAccount:        "123456789012",
+		AzureCapellaSubscription: "capella-subscription",
+		GcpCapellaProject:        "capella-project",
+	}, "100")
+
+	assert.Equal(t, "100", state.OrganizationId.ValueString())
+	assert.Equal(t, "123456789012", state.AwsCapellaAccount.ValueString())
+	assert.Equal(t, "capella-subscription", state.AzureCapellaSubscription.ValueString())
+	assert.Equal(t, "capella-project", state.GcpCapellaProject.ValueString())
+}
@@ -9,6 +9,7 @@ import (
 	clusterapi "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/cluster"
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -171,6 +172,15 @@ type Cluster struct {
 	ServiceGroups []ServiceGroup `tfsdk:"service_groups"`
 }
 
+// ClusterWithTimeouts is the Terraform state of the cluster resource. Cluster is shared
+// with the cluster data source, so the timeouts block only the resource has is added here.
+type ClusterWithTimeouts struct {
+	Cluster
+
+	// Timeouts configures how long the cluster may take to be created, updated or deleted.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 // removePatch removes the patch version from the provided cluster server version.
 func removePatch(version string) string {
 	// Split the version string by '.'
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	clusterapi "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/cluster"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+)
+
+// ClusterClone maps the couchbase-capella_cluster_clone resource schema data.
+// It describes a cluster which was created from a cloud snapshot backup of another cluster.
+type ClusterClone struct {
+	// Id is the ID of the cloned cluster.
+	Id types.String `tfsdk:"id"`
+
+	// OrganizationId is the ID of the organization to which the Capella cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the Capella cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// SourceSnapshotId is the ID of the cloud snapshot backup the cluster was cloned from.
+	SourceSnapshotId types.String `tfsdk:"source_snapshot_id"`
+
+	// RestoreId is the ID of the restore which populated the cloned cluster from the snapshot.
+	RestoreId types.String `tfsdk:"restore_id"`
+
+	// Name is the name of the cloned cluster (up to 256 characters).
+	Name types.String `tfsdk:"name"`
+
+	// Description is the description of the cloned cluster (up to 1024 characters).
+	Description types.String `tfsdk:"description"`
+
+	// CloudProvider is the cloud provider where the cloned cluster is hosted.
+	CloudProvider *CloudProvider `tfsdk:"cloud_provider"`
+
+	// Availability is the availability zone type of the cloned cluster.
+	Availability *Availability `tfsdk:"availability"`
+
+	// Support is the support plan and timezone of the cloned cluster.
+	Support *Support `tfsdk:"support"`
+
+	// Zones is the cloud services provider availability zones of the cloned cluster.
+	Zones types.Set `tfsdk:"zones"`
+
+	// CurrentState is the current state of the cloned cluster.
+	CurrentState types.String `tfsdk:"current_state"`
+
+	// ConnectionString is the Capella database endpoint for client connections.
+	ConnectionString types.String `tfsdk:"connection_string"`
+
+	// Audit represents all audit-related fields.
+	Audit types.Object `tfsdk:"audit"`
+}
+
+// NewClusterClone creates a new cluster clone state object from the cloned cluster returned by Capella.
+// The source snapshot and restore IDs are not returned by the cluster endpoint, so they are carried
+// over from the existing state.
+func NewClusterClone(
+	cluster *clusterapi.GetClusterResponse,
+	organizationId, projectId string,
+	sourceSnapshotId, restoreId types.String,
+	zones types.Set,
+	auditObject basetypes.ObjectValue,
+) *ClusterClone {
+	clone := ClusterClone{
+		Id:               types.StringValue(cluster.Id.String()),
+		OrganizationId:   types.StringValue(organizationId),
+		ProjectId:        types.StringValue(projectId),
+		SourceSnapshotId: sourceSnapshotId,
+		RestoreId:        restoreId,
+		Name:             types.StringValue(cluster.Name),
+		Description:      types.StringValue(cluster.Description),
+		CloudProvider: &CloudProvider{
+			Cidr:   types.StringValue(cluster.CloudProvider.Cidr),
+			Region: types.StringValue(cluster.CloudProvider.Region),
+			Type:   types.StringValue(string(cluster.CloudProvider.Type)),
+		},
+		Availability: &Availability{
+			Type: types.StringValue(string(cluster.Availability.Type)),
+		},
+		Support: &Support{
+			Plan:     types.StringValue(string(cluster.Support.Plan)),
+			Timezone: types.StringValue(string(cluster.Support.Timezone)),
+		},
+		Zones:            zones,
+		CurrentState:     types.StringValue(string(cluster.CurrentState)),
+		ConnectionString: types.StringValue(cluster.ConnectionString),
+		Audit:            auditObject,
+	}
+
+	if len(cluster.Zones) > 0 {
+		clone.Zones = newStringSet(cluster.Zones)
+	}
+
+	return &clone
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (c *ClusterClone) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId:   c.OrganizationId,
+		ProjectId:        c.ProjectId,
+		Id:               c.Id,
+		SourceSnapshotId: c.SourceSnapshotId,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
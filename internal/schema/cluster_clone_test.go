@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clusterapi "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/cluster"
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+)
+
+func TestClusterCloneValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       ClusterClone
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: ClusterClone{
+				OrganizationId:   basetypes.NewStringValue("100"),
+				ProjectId:        basetypes.NewStringValue("200"),
+				Id:               basetypes.NewStringValue("300"),
+				SourceSnapshotId: basetypes.NewStringValue("400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: ClusterClone{
+				Id: basetypes.NewStringValue("id=300,organization_id=100,project_id=200,source_snapshot_id=400"),
+			},
+		},
+		{
+			name: "[NEGATIVE] source_snapshot_id is missing from the import string",
+			input: ClusterClone{
+				Id: basetypes.NewStringValue("id=300,organization_id=100,project_id=200"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[Id])
+			assert.Equal(t, "400", IDs[SourceSnapshotId])
+		})
+	}
+}
+
+func TestNewClusterClone(t *testing.T) {
+	clusterId := uuid.New()
+	cluster := &clusterapi.GetClusterResponse{
+		Id:               clusterId,
+		Name:             "staging",
+		Description:      "refreshed from production",
+		CurrentState:     clusterapi.Healthy,
+		ConnectionString: "couchbases://cb.example.com",
+		CloudProvider: clusterapi.CloudProvider{
+			Cidr:   "10.1.0.0/23",
+			Region: "us-east-1",
+			Type:   clusterapi.Aws,
+		},
+		Availability: clusterapi.Availability{Type: "single"},
+		Support:      clusterapi.Support{Plan: "developer pro", Timezone: "PT"},
+	}
+	audit := types.ObjectNull(CouchbaseAuditData{}.AttributeTypes())
+
+	clone := NewClusterClone(cluster, "100", "200", types.StringValue("400"), types.StringValue("500"), types.SetNull(types.StringType), audit)
+
+	assert.Equal(t, clusterId.String(), clone.Id.ValueString())
+	assert.Equal(t, "400", clone.SourceSnapshotId.ValueString())
+	assert.Equal(t, "500", clone.RestoreId.ValueString())
+	assert.Equal(t, "staging", clone.Name.ValueString())
+	assert.Equal(t, "10.1.0.0/23", clone.CloudProvider.Cidr.ValueString())
+	assert.Equal(t, "developer pro", clone.Support.Plan.ValueString())
+	assert.Equal(t, "healthy", clone.CurrentState.ValueString())
+	assert.True(t, clone.Zones.IsNull())
+
+	cluster.Zones = []string{"use1-az1"}
+	clone = NewClusterClone(cluster, "100", "200", types.StringValue("400"), types.StringNull(), types.SetNull(types.StringType), audit)
+
+	assert.Equal(t, newStringSet([]string{"use1-az1"}), clone.Zones)
+	assert.True(t, clone.RestoreId.IsNull())
+}
@@ -0,0 +1,201 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+)
+
+// Cmek defines the Terraform state for customer-managed encryption key metadata.
+type Cmek struct {
+	// Config identifies the key in the cloud provider's key management service.
+	Config *CmekConfig `tfsdk:"config"`
+
+	// Audit contains the audit data for the key metadata.
+	Audit types.Object `tfsdk:"audit"`
+
+	// Id is the ID of the key metadata.
+	Id types.String `tfsdk:"id"`
+
+	// OrganizationId is the ID of the organization the key belongs to.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// Name is the name of the key.
+	Name types.String `tfsdk:"name"`
+
+	// Description is the description of the key.
+	Description types.String `tfsdk:"description"`
+
+	// Rotate is a Terraform-only counter. Increasing it rotates the key to the current config.
+	Rotate types.Number `tfsdk:"rotate"`
+}
+
+// CmekConfig holds exactly one of the cloud provider specific key configurations.
+type CmekConfig struct {
+	AWSConfig   *CmekAWSConfig   `tfsdk:"aws_config"`
+	GCPConfig   *CmekGCPConfig   `tfsdk:"gcp_config"`
+	AzureConfig *CmekAzureConfig `tfsdk:"azure_config"`
+}
+
+// CmekAWSConfig identifies an AWS KMS key.
+type CmekAWSConfig struct {
+	// Arn is the Amazon Resource Name of the KMS key.
+	Arn types.String `tfsdk:"arn"`
+}
+
+// CmekGCPConfig identifies a GCP Cloud KMS key.
+type CmekGCPConfig struct {
+	// ResourceName is the full resource name of the Cloud KMS key.
+	ResourceName types.String `tfsdk:"resource_name"`
+}
+
+// CmekAzureConfig identifies an Azure Key Vault key.
+type CmekAzureConfig struct {
+	// KeyLocation is the URL of the key.
+	KeyLocation types.String `tfsdk:"key_location"`
+
+	// Region is the region of the key.
+	Region types.String `tfsdk:"region"`
+}
+
+// cmekConfigUnion is the JSON shape of the oneOf config returned by the CMEK endpoints.
+// Only the fields of the matching cloud provider are set.
+type cmekConfigUnion struct {
+	Arn          *string `json:"arn,omitempty"`
+	ResourceName *string `json:"resourceName,omitempty"`
+	KeyLocation  *string `json:"keyLocation,omitempty"`
+	Region       *string `json:"region,omitempty"`
+}
+
+// NewCmekConfig converts the raw JSON of a CMEK config union into a CmekConfig.
+// The caller passes the output of the generated union's MarshalJSON.
+func NewCmekConfig(raw []byte) (*CmekConfig, error) {
+	var union cmekConfigUnion
+	if err := json.Unmarshal(raw, &union); err != nil {
+		return nil, fmt.Errorf("could not decode CMEK config: %w", err)
+	}
+
+	switch {
+	case union.Arn != nil:
+		return &CmekConfig{AWSConfig: &CmekAWSConfig{Arn: types.StringValue(*union.Arn)}}, nil
+	case union.ResourceName != nil:
+		return &CmekConfig{GCPConfig: &CmekGCPConfig{ResourceName: types.StringValue(*union.ResourceName)}}, nil
+	case union.KeyLocation != nil:
+		return &CmekConfig{AzureConfig: &CmekAzureConfig{
+			KeyLocation: types.StringValue(*union.KeyLocation),
+			Region:      types.StringPointerValue(union.Region),
+		}}, nil
+	default:
+		return nil, fmt.Errorf("CMEK config does not match any supported cloud provider")
+	}
+}
+
+// NewCmek creates a new Cmek state object from the key metadata returned by Capella.
+func NewCmek(
+	id, organizationId, name, description string,
+	config *CmekConfig,
+	rotate types.Number,
+	auditObject basetypes.ObjectValue,
+) *Cmek {
+	return &Cmek{
+		Id:             types.StringValue(id),
+		OrganizationId: types.StringValue(organizationId),
+		Name:           types.StringValue(name),
+		Description:    types.StringValue(description),
+		Config:         config,
+		Rotate:         rotate,
+		Audit:          auditObject,
+	}
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (c *Cmek) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: c.OrganizationId,
+		Id:             c.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// CmekClusterAssociation defines the Terraform state for the association of a CMEK with a cluster.
+type CmekClusterAssociation struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster the key is associated with.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// CmekId is the ID of the key metadata.
+	CmekId types.String `tfsdk:"cmek_id"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (c *CmekClusterAssociation) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: c.OrganizationId,
+		ProjectId:      c.ProjectId,
+		ClusterId:      c.ClusterId,
+		CmekId:         c.CmekId,
+	}
+
+	IDs, err := validateSchemaState(state, ClusterId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// CmekHistory defines the model for the CMEK rotation history data source.
+type CmekHistory struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// CmekId is the ID of the key metadata.
+	CmekId types.String `tfsdk:"cmek_id"`
+
+	// Data contains the keys the metadata has pointed to, newest rotation first as returned by Capella.
+	Data []CmekHistoryEntry `tfsdk:"data"`
+}
+
+// CmekHistoryEntry is a single key in the rotation history.
+type CmekHistoryEntry struct {
+	// Config identifies the key in the cloud provider's key management service.
+	Config *CmekConfig `tfsdk:"config"`
+
+	// Key is the name of the key.
+	Key types.String `tfsdk:"key"`
+
+	// AssociatedAt is when the key was associated.
+	AssociatedAt types.String `tfsdk:"associated_at"`
+
+	// AssociatedBy is the user who associated the key.
+	AssociatedBy types.String `tfsdk:"associated_by"`
+
+	// Active is whether the key is currently in use.
+	Active types.Bool `tfsdk:"active"`
+}
+
+// Validate is used to verify that the required IDs for the CMEK history data source have been set.
+func (c CmekHistory) Validate() (organizationId, cmekId string, err error) {
+	if c.OrganizationId.IsNull() {
+		return "", "", errors.ErrOrganizationIdMissing
+	}
+	if c.CmekId.IsNull() {
+		return "", "", fmt.Errorf("%w: cmek_id", errors.ErrValidatingResource)
+	}
+	return c.OrganizationId.ValueString(), c.CmekId.ValueString(), nil
+}
@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+)
+
+func TestNewCmekConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		expected    *CmekConfig
+		expectedErr bool
+	}{
+		{
+			name:     "aws",
+			raw:      `{"arn":"arn:aws:kms:us-east-1:123:key/abc"}`,
+			expected: &CmekConfig{AWSConfig: &CmekAWSConfig{Arn: types.StringValue("arn:aws:kms:us-east-1:123:key/abc")}},
+		},
+		{
+			name:     "gcp",
+			raw:      `{"resourceName":"projects/p/locations/l/keyRings/r/cryptoKeys/k"}`,
+			expected: &CmekConfig{GCPConfig: &CmekGCPConfig{ResourceName: types.StringValue("projects/p/locations/l/keyRings/r/cryptoKeys/k")}},
+		},
+		{
+			name: "azure",
+			raw:  `{"keyLocation":"https://vault.azure.net/keys/k","region":"eastus"}`,
+			expected: &CmekConfig{AzureConfig: &CmekAzureConfig{
+				KeyLocation: types.StringValue("https://vault.azure.net/keys/k"),
+				Region:      types.StringValue("eastus"),
+			}},
+		},
+		{
+			name:        "unknown provider",
+			raw:         `{"foo":"bar"}`,
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := NewCmekConfig([]byte(test.raw))
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, config)
+		})
+	}
+}
+
+func TestCmekClusterAssociationValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       CmekClusterAssociation
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: CmekClusterAssociation{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				ClusterId:      basetypes.NewStringValue("300"),
+				CmekId:         basetypes.NewStringValue("400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: CmekClusterAssociation{
+				ClusterId: basetypes.NewStringValue("cluster_id=300,cmek_id=400,project_id=200,organization_id=100"),
+			},
+		},
+		{
+			name: "[NEGATIVE] cmek_id is missing from the import string",
+			input: CmekClusterAssociation{
+				ClusterId: basetypes.NewStringValue("cluster_id=300,project_id=200,organization_id=100"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[ClusterId])
+			assert.Equal(t, "400", IDs[CmekId])
+		})
+	}
+}
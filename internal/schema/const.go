@@ -3,18 +3,21 @@ package schema
 type Attr string
 
 const (
-	OrganizationId  Attr = "organizationId"
-	ProjectId       Attr = "projectId"
-	ClusterId       Attr = "clusterId"
-	BucketId        Attr = "bucketId"
-	BucketName      Attr = "bucketName"
-	Id              Attr = "id"
-	ScopeName       Attr = "scopeName"
-	CollectionName  Attr = "collectionName"
-	AppServiceId    Attr = "appServiceId"
-	AppEndpointName Attr = "appEndpointName"
-	EndpointId      Attr = "endpointId"
-	IndexName       Attr = "indexName"
-	ProviderId      Attr = "providerId"
-	FunctionName    Attr = "functionName"
+	OrganizationId     Attr = "organizationId"
+	ProjectId          Attr = "projectId"
+	ClusterId          Attr = "clusterId"
+	BucketId           Attr = "bucketId"
+	BucketName         Attr = "bucketName"
+	Id                 Attr = "id"
+	ScopeName          Attr = "scopeName"
+	CollectionName     Attr = "collectionName"
+	AppServiceId       Attr = "appServiceId"
+	AppEndpointName    Attr = "appEndpointName"
+	EndpointId         Attr = "endpointId"
+	IndexName          Attr = "indexName"
+	ProviderId         Attr = "providerId"
+	FunctionName       Attr = "functionName"
+	CmekId             Attr = "cmekId"
+	AnalyticsClusterId Attr = "analyticsClusterId"
+	SourceSnapshotId   Attr = "sourceSnapshotId"
 )
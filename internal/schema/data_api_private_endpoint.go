@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// DataAPIPrivateEndpoint maps the couchbase-capella_data_api_private_endpoint resource schema data.
+type DataAPIPrivateEndpoint struct {
+	// EndpointId is the id of the private endpoint.
+	EndpointId types.String `tfsdk:"endpoint_id"`
+
+	// Status is the endpoint status. Possible values are failed, linked, pending, pendingAcceptance, rejected and unrecognized.
+	Status types.String `tfsdk:"status"`
+
+	// ServiceName is the name of the private endpoint service.
+	ServiceName types.String `tfsdk:"service_name"`
+
+	// OrganizationId is the ID of the organization to which the Capella cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the Capella cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster whose Data API the private endpoint is associated with.
+	ClusterId types.String `tfsdk:"cluster_id"`
+}
+
+// NewDataAPIPrivateEndpoint creates a new Data API private endpoint state object from the endpoint returned by Capella.
+func NewDataAPIPrivateEndpoint(endpoint apigen.PrivateEndpoint, organizationId, projectId, clusterId string) *DataAPIPrivateEndpoint {
+	return &DataAPIPrivateEndpoint{
+		EndpointId:     types.StringValue(endpoint.Id),
+		Status:         types.StringValue(string(endpoint.Status)),
+		ServiceName:    types.StringPointerValue(endpoint.ServiceName),
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		ClusterId:      types.StringValue(clusterId),
+	}
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (d *DataAPIPrivateEndpoint) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: d.OrganizationId,
+		ProjectId:      d.ProjectId,
+		ClusterId:      d.ClusterId,
+		EndpointId:     d.EndpointId,
+	}
+
+	IDs, err := validateSchemaState(state, EndpointId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
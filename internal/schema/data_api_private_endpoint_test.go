@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestDataAPIPrivateEndpointValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       DataAPIPrivateEndpoint
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: DataAPIPrivateEndpoint{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				ClusterId:      basetypes.NewStringValue("300"),
+				EndpointId:     basetypes.NewStringValue("vpce-400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: DataAPIPrivateEndpoint{
+				EndpointId: basetypes.NewStringValue("endpoint_id=vpce-400,organization_id=100,project_id=200,cluster_id=300"),
+			},
+		},
+		{
+			name: "[NEGATIVE] cluster_id is missing from the import string",
+			input: DataAPIPrivateEndpoint{
+				EndpointId: basetypes.NewStringValue("endpoint_id=vpce-400,organization_id=100,project_id=200"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[ClusterId])
+			assert.Equal(t, "vpce-400", IDs[EndpointId])
+		})
+	}
+}
+
+func TestNewDataAPIPrivateEndpoint(t *testing.T) {
+	serviceName := "com.amazonaws.vpce.us-east-1.vpce-svc-1234"
+
+	state := NewDataAPIPrivateEndpoint(apigen.PrivateEndpoint{
+		Id:          "vpce-400",
+		ServiceName: &serviceName,
+		Status:      apigen.PrivateEndpointStatusLinked,
+	}, "100", "200", "300")
+
+	assert.Equal(t, "vpce-400", state.EndpointId.ValueString())
+	assert.Equal(t, "linked", state.Status.ValueString())
+	assert.Equal(t, serviceName, state.ServiceName.ValueString())
+	assert.Equal(t, "300", state.ClusterId.ValueString())
+
+	state = NewDataAPIPrivateEndpoint(apigen.PrivateEndpoint{
+		Id:     "vpce-400",
+		Status: apigen.PrivateEndpointStatusPendingAcceptance,
+	}, "100", "200", "300")
+
+	assert.True(t, state.ServiceName.IsNull())
+}
@@ -28,6 +28,13 @@ type DatabaseCredential struct {
 	// The password should contain 8+ characters, at least 1 lower, 1 upper, 1 numerical and 1 special character.
 	Password types.String `tfsdk:"password"`
 
+	// PasswordWo is a write-only password, only available from the configuration.
+	// When it is set, the password is never stored in state.
+	PasswordWo types.String `tfsdk:"password_wo"`
+
+	// PasswordWoVersion is changed to send the write-only password to Capella again.
+	PasswordWoVersion types.Int64 `tfsdk:"password_wo_version"`
+
 	// OrganizationId is the ID of the organization to which the Capella cluster belongs.
 	// The database credential will be created for the cluster.
 	OrganizationId types.String `tfsdk:"organization_id"`
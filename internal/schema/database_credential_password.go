@@ -0,0 +1,12 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DatabaseCredentialPassword is the ephemeral resource that generates a password
+// for a database credential. The password is never stored in state.
+type DatabaseCredentialPassword struct {
+	// Password is the generated password.
+	Password types.String `tfsdk:"password"`
+}
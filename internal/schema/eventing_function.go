@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -82,6 +83,10 @@ type EventingFunctionResource struct {
 	// Enum: deployed, undeployed, paused, resumed. It is a write-only control input: the GET
 	// response reports the read-only Status, which is mapped back onto State across refreshes.
 	State types.String `tfsdk:"state"`
+
+	// Timeouts configures how long create, update and delete may wait for the function to
+	// reach its target state.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // EventingFunctionKeyspace identifies the bucket, scope and collection of an event source or
@@ -309,8 +314,8 @@ func newEventingFunctionKeyspaceObject(ctx context.Context, keyspace eventingapi
 }
 
 // NewEventingFunctionResource converts an eventing function API response into the Terraform schema.
-// prior carries forward values that the GET response does not return: the State action verb
-// and any URL binding authentication secrets (matched by alias).
+// prior carries forward values that the GET response does not return: the State action verb,
+// the configured timeouts and any URL binding authentication secrets (matched by alias).
 func NewEventingFunctionResource(
 	ctx context.Context,
 	resp *eventingapi.GetEventingFunctionResponse,
@@ -356,6 +361,7 @@ func NewEventingFunctionResource(
 	}
 
 	if prior != nil {
+		fn.Timeouts = prior.Timeouts
 		if err := carryForwardURLSecrets(ctx, fn.Bindings, prior.Bindings); err != nil {
 			return nil, err
 		}
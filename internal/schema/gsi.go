@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -46,6 +47,9 @@ type GsiDefinition struct {
 	With *WithOptions `tfsdk:"with"`
 
 	BuildIndexes types.Set `tfsdk:"build_indexes"`
+
+	// Timeouts configures how long index DDL and build monitoring may take.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // WithOptions represents the attributes of the WITH clause.
@@ -0,0 +1,181 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// MtlsCertificate defines the Terraform state for a client CA certificate trusted by a cluster for mTLS.
+type MtlsCertificate struct {
+	// Audit contains the audit data for the certificate.
+	Audit types.Object `tfsdk:"audit"`
+
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// Id is the ID of the certificate.
+	Id types.String `tfsdk:"id"`
+
+	// Name is the name of the certificate.
+	Name types.String `tfsdk:"name"`
+
+	// Certificate is the PEM encoded certificate.
+	Certificate types.String `tfsdk:"certificate"`
+
+	// Intermediates are the PEM encoded intermediate certificates.
+	Intermediates types.String `tfsdk:"intermediates"`
+
+	// Status is the status of the certificate on the cluster.
+	Status types.String `tfsdk:"status"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (m *MtlsCertificate) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: m.OrganizationId,
+		ProjectId:      m.ProjectId,
+		ClusterId:      m.ClusterId,
+		Id:             m.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewMtlsCertificate creates a new mTLS certificate state object from the certificate returned by Capella.
+//
+// While an update is being applied Capella reports the new certificate as the intended state,
+// so that is used in preference to the loaded one. A returned PEM that only differs from prior
+// in surrounding whitespace keeps the prior value, so the configured text does not show a diff.
+func NewMtlsCertificate(
+	certificate apigen.MtlsCertificate,
+	organizationId, projectId, clusterId string,
+	prior *MtlsCertificate,
+	auditObject basetypes.ObjectValue,
+) *MtlsCertificate {
+	data := certificate.CertificateData
+	if certificate.IntendedState != nil {
+		data = *certificate.IntendedState
+	}
+
+	state := &MtlsCertificate{
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		ClusterId:      types.StringValue(clusterId),
+		Id:             types.StringValue(certificate.Id),
+		Name:           types.StringValue(data.Name),
+		Certificate:    types.StringValue(data.Certificate),
+		Intermediates:  types.StringPointerValue(data.Intermediates),
+		Status:         types.StringValue(string(certificate.Status)),
+		Audit:          auditObject,
+	}
+	if state.Intermediates.ValueString() == "" {
+		state.Intermediates = types.StringNull()
+	}
+
+	if prior != nil {
+		state.Certificate = samePEM(state.Certificate, prior.Certificate)
+		state.Intermediates = samePEM(state.Intermediates, prior.Intermediates)
+	}
+
+	return state
+}
+
+// samePEM returns prior when it holds the same PEM text as current, ignoring surrounding whitespace.
+func samePEM(current, prior types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() || current.IsNull() {
+		return current
+	}
+	if strings.TrimSpace(current.ValueString()) == strings.TrimSpace(prior.ValueString()) {
+		return prior
+	}
+	return current
+}
+
+// MtlsConfiguration defines the Terraform state for the mTLS configuration of a cluster.
+type MtlsConfiguration struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// State is whether client certificates are disabled, accepted or required.
+	State types.String `tfsdk:"state"`
+
+	// Prefixes map values in a client certificate to Couchbase usernames, in order of precedence.
+	Prefixes []MtlsPrefix `tfsdk:"prefixes"`
+}
+
+// MtlsPrefix maps a value extracted from a client certificate to a Couchbase username.
+type MtlsPrefix struct {
+	// Path is the path in the certificate to read the username from.
+	Path types.String `tfsdk:"path"`
+
+	// Prefix is stripped from the start of the extracted value.
+	Prefix types.String `tfsdk:"prefix"`
+
+	// Delimiter ends the username within the extracted value.
+	Delimiter types.String `tfsdk:"delimiter"`
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (m *MtlsConfiguration) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: m.OrganizationId,
+		ProjectId:      m.ProjectId,
+		ClusterId:      m.ClusterId,
+	}
+
+	IDs, err := validateSchemaState(state, ClusterId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewMtlsConfiguration creates a new mTLS configuration state object from the configuration returned by Capella.
+func NewMtlsConfiguration(
+	config apigen.GetMtlsConfigurationResponse,
+	organizationId, projectId, clusterId string,
+) *MtlsConfiguration {
+	state := &MtlsConfiguration{
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		ClusterId:      types.StringValue(clusterId),
+		State:          types.StringValue(string(config.State)),
+	}
+
+	if config.Prefixes != nil && len(*config.Prefixes) > 0 {
+		state.Prefixes = make([]MtlsPrefix, 0, len(*config.Prefixes))
+		for _, prefix := range *config.Prefixes {
+			state.Prefixes = append(state.Prefixes, MtlsPrefix{
+				Path:      types.StringValue(string(prefix.Path)),
+				Prefix:    types.StringPointerValue(prefix.Prefix),
+				Delimiter: types.StringPointerValue(prefix.Delimiter),
+			})
+		}
+	}
+
+	return state
+}
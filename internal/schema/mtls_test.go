@@ -0,0 +1,134 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestMtlsCertificateValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       MtlsCertificate
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: MtlsCertificate{
+				OrganizationId: basetypes.NewStringValue("100"),
+				ProjectId:      basetypes.NewStringValue("200"),
+				ClusterId:      basetypes.NewStringValue("300"),
+				Id:             basetypes.NewStringValue("400"),
+			},
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: MtlsCertificate{
+				Id: basetypes.NewStringValue("id=400,cluster_id=300,project_id=200,organization_id=100"),
+			},
+		},
+		{
+			name: "[NEGATIVE] cluster_id is missing from the import string",
+			input: MtlsCertificate{
+				Id: basetypes.NewStringValue("id=400,project_id=200,organization_id=100"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+			assert.Equal(t, "200", IDs[ProjectId])
+			assert.Equal(t, "300", IDs[ClusterId])
+			assert.Equal(t, "400", IDs[Id])
+		})
+	}
+}
+
+func TestMtlsConfigurationValidate(t *testing.T) {
+	input := MtlsConfiguration{
+		ClusterId: basetypes.NewStringValue("cluster_id=300,project_id=200,organization_id=100"),
+	}
+
+	IDs, err := input.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, "100", IDs[OrganizationId])
+	assert.Equal(t, "200", IDs[ProjectId])
+	assert.Equal(t, "300", IDs[ClusterId])
+}
+
+func TestNewMtlsCertificate(t *testing.T) {
+	const (
+		oldPEM = "-----BEGIN CERTIFICATE-----\nold\n-----END CERTIFICATE-----\n"
+		newPEM = "-----BEGIN CERTIFICATE-----\nnew\n-----END CERTIFICATE-----\n"
+	)
+
+	empty := ""
+	certificate := apigen.MtlsCertificate{
+		Id:              "400",
+		Status:          apigen.MtlsCertificateStatusLoaded,
+		CertificateData: apigen.MtlsCertificateData{Name: "client-ca", Certificate: oldPEM, Intermediates: &empty},
+	}
+
+	t.Run("loaded certificate", func(t *testing.T) {
+		state := NewMtlsCertificate(certificate, "100", "200", "300", nil, types.ObjectNull(nil))
+
+		assert.Equal(t, types.StringValue("400"), state.Id)
+		assert.Equal(t, types.StringValue("client-ca"), state.Name)
+		assert.Equal(t, types.StringValue(oldPEM), state.Certificate)
+		assert.True(t, state.Intermediates.IsNull())
+		assert.Equal(t, types.StringValue("loaded"), state.Status)
+	})
+
+	t.Run("intended state is preferred while an update is applied", func(t *testing.T) {
+		updating := certificate
+		updating.Status = apigen.MtlsCertificateStatusAwaitingUpdate
+		updating.IntendedState = &apigen.MtlsCertificateData{Name: "client-ca-2", Certificate: newPEM}
+
+		state := NewMtlsCertificate(updating, "100", "200", "300", nil, types.ObjectNull(nil))
+
+		assert.Equal(t, types.StringValue("client-ca-2"), state.Name)
+		assert.Equal(t, types.StringValue(newPEM), state.Certificate)
+	})
+
+	t.Run("whitespace differences keep the configured PEM", func(t *testing.T) {
+		prior := &MtlsCertificate{
+			Certificate:   types.StringValue("\n" + oldPEM + "\n\n"),
+			Intermediates: types.StringNull(),
+		}
+
+		state := NewMtlsCertificate(certificate, "100", "200", "300", prior, types.ObjectNull(nil))
+
+		assert.Equal(t, prior.Certificate, state.Certificate)
+	})
+}
+
+func TestNewMtlsConfiguration(t *testing.T) {
+	delimiter := "@"
+	state := NewMtlsConfiguration(apigen.GetMtlsConfigurationResponse{
+		State:    apigen.GetMtlsConfigurationResponseStateHybrid,
+		Prefixes: &[]apigen.MtlsPrefix{{Path: apigen.SanEmail, Delimiter: &delimiter}},
+	}, "100", "200", "300")
+
+	assert.Equal(t, types.StringValue("hybrid"), state.State)
+	require.Len(t, state.Prefixes, 1)
+	assert.Equal(t, types.StringValue("san.email"), state.Prefixes[0].Path)
+	assert.Equal(t, types.StringValue("@"), state.Prefixes[0].Delimiter)
+	assert.True(t, state.Prefixes[0].Prefix.IsNull())
+
+	assert.Nil(t, NewMtlsConfiguration(apigen.GetMtlsConfigurationResponse{State: apigen.GetMtlsConfigurationResponseStateDisable}, "100", "200", "300").Prefixes)
+}
@@ -7,6 +7,7 @@ import (
 	network_peer_api "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/api/network_peer"
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -45,6 +46,9 @@ type NetworkPeer struct {
 	Status types.Object `tfsdk:"status"`
 
 	Audit types.Object `tfsdk:"audit"`
+
+	// Timeouts configures how long the network peer may take to be created or deleted.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // PeeringStatus communicates the state of the VPC peering relationship. It is the state and reasoning for VPC peer.
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// OrganizationConfiguration maps the organization-wide configuration settings.
+type OrganizationConfiguration struct {
+	// OrganizationId is the ID of the organization the configuration belongs to.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// Subdomain is the name of the subdomain for the organization.
+	Subdomain types.String `tfsdk:"subdomain"`
+}
+
+// NewOrganizationConfiguration creates a new OrganizationConfiguration from the organization response.
+func NewOrganizationConfiguration(org apigen.GetOrganizationResponse, organizationId string) *OrganizationConfiguration {
+	var subdomain string
+	if org.Subdomain != nil {
+		subdomain = *org.Subdomain
+	}
+
+	return &OrganizationConfiguration{
+		OrganizationId: types.StringValue(organizationId),
+		Subdomain:      types.StringValue(subdomain),
+	}
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (o *OrganizationConfiguration) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: o.OrganizationId,
+	}
+
+	IDs, err := validateSchemaState(state, OrganizationId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate resource state: %w", err)
+	}
+
+	return IDs, nil
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestOrganizationConfigurationValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       OrganizationConfiguration
+		expectedErr error
+	}{
+		{
+			name: "[POSITIVE] organization ID is passed via terraform apply or import",
+			input: OrganizationConfiguration{
+				OrganizationId: basetypes.NewStringValue("100"),
+				Subdomain:      basetypes.NewStringValue("acme"),
+			},
+		},
+		{
+			name: "[NEGATIVE] organization ID is empty",
+			input: OrganizationConfiguration{
+				OrganizationId: basetypes.NewStringValue(""),
+			},
+			expectedErr: errors.ErrIdMissing,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "100", IDs[OrganizationId])
+		})
+	}
+}
+
+func TestNewOrganizationConfiguration(t *testing.T) {
+	subdomain := "acme"
+
+	state := NewOrganizationConfiguration(apigen.GetOrganizationResponse{Subdomain: &subdomain}, "100")
+
+	assert.Equal(t, "100", state.OrganizationId.ValueString())
+	assert.Equal(t, "acme", state.Subdomain.ValueString())
+
+	state = NewOrganizationConfiguration(apigen.GetOrganizationResponse{}, "100")
+
+	assert.Equal(t, "", state.Subdomain.ValueString())
+}
@@ -64,3 +64,117 @@ type GCPCommandRequest struct {
 	// Command is the GCP command.
 	Command types.String `tfsdk:"command"`
 }
+
+// AppServiceAWSCommandRequest represents the AWS cli to create a private endpoint to an App Service.
+type AppServiceAWSCommandRequest struct {
+	// ClusterId is the ID of the cluster the App Service is linked to.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// ProjectId is the ID of the project to which the App Service belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// OrganizationId is the ID of the organization to which the App Service belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// AppServiceId is the ID of the App Service associated with the private endpoint.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// VpcID The ID of your virtual network.
+	VpcID types.String `tfsdk:"vpc_id"`
+
+	// SubnetIDs is a list of subnet ids.
+	SubnetIDs []types.String `tfsdk:"subnet_ids"`
+
+	// Command is the AWS command.
+	Command types.String `tfsdk:"command"`
+}
+
+// AppServiceAzureCommandRequest represents the Azure script to create a private endpoint to an App Service.
+type AppServiceAzureCommandRequest struct {
+	// ClusterId is the ID of the cluster the App Service is linked to.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// ProjectId is the ID of the project to which the App Service belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// OrganizationId is the ID of the organization to which the App Service belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// AppServiceId is the ID of the App Service associated with the private endpoint.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// The name of your resource group.
+	ResourceGroupName types.String `tfsdk:"resource_group_name"`
+
+	// The virtual network and subnet name.
+	VirtualNetwork types.String `tfsdk:"virtual_network"`
+
+	// Command is the Azure script.
+	Command types.String `tfsdk:"command"`
+}
+
+// AppServiceGCPCommandRequest represents the GCP script to create a private endpoint to an App Service.
+type AppServiceGCPCommandRequest struct {
+	// ClusterId is the ID of the cluster the App Service is linked to.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// ProjectId is the ID of the project to which the App Service belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// OrganizationId is the ID of the organization to which the App Service belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// AppServiceId is the ID of the App Service associated with the private endpoint.
+	AppServiceId types.String `tfsdk:"app_service_id"`
+
+	// VpcNetworkID The ID of your virtual network.
+	VpcNetworkID types.String `tfsdk:"vpc_network_id"`
+
+	// SubnetIDs is a list of subnet ids.
+	SubnetIDs []types.String `tfsdk:"subnet_ids"`
+
+	// Command is the GCP command.
+	Command types.String `tfsdk:"command"`
+}
+
+// DataAPIAWSCommandRequest represents the AWS cli to create a private endpoint to the Data API of a cluster.
+type DataAPIAWSCommandRequest struct {
+	// ClusterId is the ID of the cluster whose Data API the private endpoint connects to.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// ProjectId is the ID of the project to which the Capella cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// OrganizationId is the ID of the organization to which the Capella cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// VpcID The ID of your virtual network.
+	VpcID types.String `tfsdk:"vpc_id"`
+
+	// SubnetIDs is a list of subnet ids.
+	SubnetIDs []types.String `tfsdk:"subnet_ids"`
+
+	// Command is the AWS command.
+	Command types.String `tfsdk:"command"`
+}
+
+// AnalyticsAWSCommandRequest represents the AWS cli to create a private endpoint to an analytics cluster.
+type AnalyticsAWSCommandRequest struct {
+	// AnalyticsClusterId is the ID of the analytics cluster associated with the private endpoint.
+	AnalyticsClusterId types.String `tfsdk:"analytics_cluster_id"`
+
+	// ProjectId is the ID of the project to which the analytics cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// OrganizationId is the ID of the organization to which the analytics cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// VpcID The ID of your virtual network.
+	VpcID types.String `tfsdk:"vpc_id"`
+
+	// SubnetIDs is a list of subnet ids.
+	SubnetIDs []types.String `tfsdk:"subnet_ids"`
+
+	// Command is the AWS command.
+	Command types.String `tfsdk:"command"`
+}
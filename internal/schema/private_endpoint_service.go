@@ -3,6 +3,7 @@ package schema
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -40,6 +41,16 @@ type PrivateEndpointService struct {
 	ServiceName types.String `tfsdk:"service_name"`
 }
 
+// PrivateEndpointServiceWithTimeouts is the Terraform state of the private endpoint service
+// resource. PrivateEndpointService is shared with the data source, so the timeouts block only
+// the resource has is added here.
+type PrivateEndpointServiceWithTimeouts struct {
+	PrivateEndpointService
+
+	// Timeouts configures how long enabling or disabling the service may take.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 // Validate is used to verify that IDs have been properly imported.
 func (p *PrivateEndpointService) Validate() (map[Attr]string, error) {
 	state := map[Attr]basetypes.StringValue{
@@ -0,0 +1,379 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// Replication defines the Terraform state for an XDCR replication originating from a Capella cluster.
+type Replication struct {
+	// Filter contains the document filters applied to the replication.
+	Filter *ReplicationFilter `tfsdk:"filter"`
+
+	// Target identifies the cluster and bucket the replication writes to.
+	Target *ReplicationTarget `tfsdk:"target"`
+
+	// Audit contains the creation metadata of the replication.
+	Audit types.Object `tfsdk:"audit"`
+
+	// Id is the ID of the replication.
+	Id types.String `tfsdk:"id"`
+
+	// OrganizationId is the ID of the organization to which the source cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the source cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the source cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// SourceBucket is the ID of the source bucket.
+	SourceBucket types.String `tfsdk:"source_bucket"`
+
+	// Direction is either oneWay or twoWay.
+	Direction types.String `tfsdk:"direction"`
+
+	// Priority is the resource allocation of the replication (low, medium or high).
+	Priority types.String `tfsdk:"priority"`
+
+	// Status is the current status of the replication as reported by Capella.
+	Status types.String `tfsdk:"status"`
+
+	// Error is the error message if the replication has failed.
+	Error types.String `tfsdk:"error"`
+
+	// ReverseReplicationId is the ID of the replication from target to source for twoWay replications.
+	ReverseReplicationId types.String `tfsdk:"reverse_replication_id"`
+
+	// Mappings defines the source to target scope and collection mappings.
+	Mappings []ReplicationMapping `tfsdk:"mappings"`
+
+	// NetworkUsageLimit is the network usage limit in MiB per second. 0 means unlimited.
+	NetworkUsageLimit types.Int64 `tfsdk:"network_usage_limit"`
+
+	// ChangesLeft is the number of remaining mutations to be replicated.
+	ChangesLeft types.Int64 `tfsdk:"changes_left"`
+
+	// Paused controls whether the replication is paused or running.
+	Paused types.Bool `tfsdk:"paused"`
+
+	// Timeouts configures how long the replication may take to be created or updated.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ReplicationTarget identifies the destination of a replication.
+type ReplicationTarget struct {
+	// Cluster is the ID of the target Capella cluster, or the cluster reference name for external clusters.
+	Cluster types.String `tfsdk:"cluster"`
+
+	// Bucket is the ID of the target Capella bucket, or the bucket name for external clusters.
+	Bucket types.String `tfsdk:"bucket"`
+
+	// Type tells if the target cluster is capella or external.
+	Type types.String `tfsdk:"type"`
+}
+
+// ReplicationMapping maps a source scope (and optionally its collections) to a target scope.
+type ReplicationMapping struct {
+	// SourceScope is the name of the scope on the source bucket.
+	SourceScope types.String `tfsdk:"source_scope"`
+
+	// TargetScope is the name of the scope on the target bucket.
+	TargetScope types.String `tfsdk:"target_scope"`
+
+	// Collections maps individual collections. If empty, all collections in the scope are replicated.
+	Collections []ReplicationCollectionMapping `tfsdk:"collections"`
+}
+
+// ReplicationCollectionMapping maps a source collection to a target collection.
+type ReplicationCollectionMapping struct {
+	// SourceCollection is the name of the collection on the source scope.
+	SourceCollection types.String `tfsdk:"source_collection"`
+
+	// TargetCollection is the name of the collection on the target scope.
+	TargetCollection types.String `tfsdk:"target_collection"`
+}
+
+// ReplicationFilter contains the settings used to filter replicated documents.
+type ReplicationFilter struct {
+	// DocumentExcludeOptions controls which kinds of mutations are excluded from the replication.
+	DocumentExcludeOptions *ReplicationDocumentExcludeOptions `tfsdk:"document_exclude_options"`
+
+	// Expressions contains the filter expression used to match documents.
+	Expressions *ReplicationFilterExpressions `tfsdk:"expressions"`
+}
+
+// ReplicationDocumentExcludeOptions controls which kinds of mutations are excluded from the replication.
+type ReplicationDocumentExcludeOptions struct {
+	// Binary filters out binary documents when true.
+	Binary types.Bool `tfsdk:"binary"`
+
+	// Deletion filters out deletions when true.
+	Deletion types.Bool `tfsdk:"deletion"`
+
+	// Expiration filters out expirations when true.
+	Expiration types.Bool `tfsdk:"expiration"`
+
+	// Ttl removes the TTL value from replicated documents when true.
+	Ttl types.Bool `tfsdk:"ttl"`
+}
+
+// ReplicationFilterExpressions contains the filter expression used to match documents.
+type ReplicationFilterExpressions struct {
+	// RegEx is the filter expression to match documents.
+	RegEx types.String `tfsdk:"regex"`
+
+	// SkipRestream applies an updated filter to new mutations only when true.
+	// It is write-only in the API and is preserved from the plan.
+	SkipRestream types.Bool `tfsdk:"skip_restream"`
+}
+
+// ReplicationAudit contains the creation metadata of a replication.
+type ReplicationAudit struct {
+	// CreatedAt is the RFC3339 timestamp of when the replication was created.
+	CreatedAt types.String `tfsdk:"created_at"`
+
+	// CreatedBy is the user who created the replication.
+	CreatedBy types.String `tfsdk:"created_by"`
+}
+
+// AttributeTypes returns the attribute types of the replication audit object.
+func (r ReplicationAudit) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"created_at": types.StringType,
+		"created_by": types.StringType,
+	}
+}
+
+// NewReplicationAudit creates a ReplicationAudit from the API audit data.
+func NewReplicationAudit(audit apigen.ReplicationAuditData) ReplicationAudit {
+	return ReplicationAudit{
+		CreatedAt: types.StringValue(audit.CreatedAt.String()),
+		CreatedBy: types.StringValue(audit.CreatedBy),
+	}
+}
+
+// Validate is used to verify that IDs have been properly imported.
+func (r *Replication) Validate() (map[Attr]string, error) {
+	state := map[Attr]basetypes.StringValue{
+		OrganizationId: r.OrganizationId,
+		ProjectId:      r.ProjectId,
+		ClusterId:      r.ClusterId,
+		Id:             r.Id,
+	}
+
+	IDs, err := validateSchemaState(state)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errors.ErrValidatingResource, err)
+	}
+
+	return IDs, nil
+}
+
+// NewReplication creates a new replication state from the API response.
+// Values that the API does not echo back (the target identifiers supplied by the user
+// and skip_restream) are carried over from prior when it is set.
+func NewReplication(
+	replication *apigen.GetReplicationResponse,
+	organizationId, projectId, clusterId string,
+	prior *Replication,
+	auditObject basetypes.ObjectValue,
+) *Replication {
+	newReplication := Replication{
+		Id:                   types.StringValue(replication.Id),
+		OrganizationId:       types.StringValue(organizationId),
+		ProjectId:            types.StringValue(projectId),
+		ClusterId:            types.StringValue(clusterId),
+		SourceBucket:         types.StringValue(replication.Source.Bucket.Id),
+		Direction:            types.StringValue(string(replication.Direction)),
+		Status:               types.StringValue(string(replication.Status)),
+		ChangesLeft:          types.Int64Value(int64(replication.ChangesLeft)),
+		Paused:               types.BoolValue(IsReplicationPaused(replication.Status)),
+		Error:                types.StringPointerValue(replication.Error),
+		Priority:             types.StringNull(),
+		NetworkUsageLimit:    types.Int64Null(),
+		ReverseReplicationId: types.StringNull(),
+		Audit:                auditObject,
+		Target: &ReplicationTarget{
+			Cluster: types.StringValue(replication.Target.Cluster.Id),
+			Bucket:  types.StringValue(replication.Target.Bucket.Id),
+			Type:    types.StringValue(string(replication.Target.Type)),
+		},
+	}
+
+	if replication.Priority != nil {
+		newReplication.Priority = types.StringValue(string(*replication.Priority))
+	}
+	if replication.NetworkUsageLimit != nil {
+		newReplication.NetworkUsageLimit = types.Int64Value(int64(*replication.NetworkUsageLimit))
+	}
+	if replication.Mappings != nil {
+		newReplication.Mappings = newReplicationMappings(*replication.Mappings)
+	}
+
+	var priorFilter *ReplicationFilter
+	if prior != nil {
+		if prior.Target != nil && !prior.Target.Cluster.IsNull() {
+			newReplication.Target = prior.Target
+		}
+		newReplication.ReverseReplicationId = prior.ReverseReplicationId
+		newReplication.Timeouts = prior.Timeouts
+		priorFilter = prior.Filter
+	}
+
+	if replication.Filter != nil {
+		newReplication.Filter = newReplicationFilter(replication.Filter, priorFilter)
+	}
+
+	return &newReplication
+}
+
+// IsReplicationPaused reports whether the replication status is a paused or pausing state.
+func IsReplicationPaused(status apigen.GetReplicationResponseStatus) bool {
+	return status == apigen.GetReplicationResponseStatusPaused || status == apigen.GetReplicationResponseStatusPausing
+}
+
+func newReplicationMappings(mappings apigen.Mappings) []ReplicationMapping {
+	if len(mappings) == 0 {
+		return nil
+	}
+	newMappings := make([]ReplicationMapping, 0, len(mappings))
+	for _, m := range mappings {
+		mapping := ReplicationMapping{
+			SourceScope: types.StringValue(m.SourceScope),
+			TargetScope: types.StringValue(m.TargetScope),
+		}
+		if m.Collections != nil {
+			for _, c := range *m.Collections {
+				mapping.Collections = append(mapping.Collections, ReplicationCollectionMapping{
+					SourceCollection: types.StringValue(c.SourceCollection),
+					TargetCollection: types.StringValue(c.TargetCollection),
+				})
+			}
+		}
+		newMappings = append(newMappings, mapping)
+	}
+	return newMappings
+}
+
+func allFalse(values ...*bool) bool {
+	for _, v := range values {
+		if v != nil && *v {
+			return false
+		}
+	}
+	return true
+}
+
+// newReplicationFilter converts the filter reported by Capella into state. Capella reports
+// an all-default filter even when none was configured, so a part of the filter is only
+// surfaced when it was configured before or it actually filters something. This keeps an
+// unfiltered replication, or one imported without a filter, free of a perpetual diff.
+func newReplicationFilter(filter *apigen.GetFilter, prior *ReplicationFilter) *ReplicationFilter {
+	newFilter := ReplicationFilter{}
+
+	if opts := filter.DocumentExcludeOptions; opts != nil &&
+		((prior != nil && prior.DocumentExcludeOptions != nil) || !allFalse(opts.Binary, opts.Deletion, opts.Expiration, opts.Ttl)) {
+		newFilter.DocumentExcludeOptions = &ReplicationDocumentExcludeOptions{
+			Binary:     types.BoolValue(opts.Binary != nil && *opts.Binary),
+			Deletion:   types.BoolValue(opts.Deletion != nil && *opts.Deletion),
+			Expiration: types.BoolValue(opts.Expiration != nil && *opts.Expiration),
+			Ttl:        types.BoolValue(opts.Ttl != nil && *opts.Ttl),
+		}
+	}
+
+	if expr := filter.Expressions; expr != nil {
+		hasRegEx := expr.RegEx != nil && *expr.RegEx != ""
+		if (prior != nil && prior.Expressions != nil) || hasRegEx {
+			// skip_restream only applies to the update that changed the expression and is
+			// not reported back, so the configured value is carried over.
+			skipRestream := types.BoolValue(false)
+			if prior != nil && prior.Expressions != nil && !prior.Expressions.SkipRestream.IsNull() {
+				skipRestream = prior.Expressions.SkipRestream
+			}
+			newFilter.Expressions = &ReplicationFilterExpressions{
+				RegEx:        types.StringNull(),
+				SkipRestream: skipRestream,
+			}
+			if hasRegEx {
+				newFilter.Expressions.RegEx = types.StringValue(*expr.RegEx)
+			}
+		}
+	}
+
+	if prior == nil && newFilter.DocumentExcludeOptions == nil && newFilter.Expressions == nil {
+		return nil
+	}
+	return &newFilter
+}
+
+// Replications defines the model for the replications data source.
+type Replications struct {
+	// OrganizationId is the ID of the organization.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId optionally restricts the listing to replications of a single cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// Data contains the list of replications.
+	Data []ReplicationSummary `tfsdk:"data"`
+}
+
+// ReplicationSummary is a single entry of the replications data source.
+type ReplicationSummary struct {
+	// Audit contains the creation metadata of the replication.
+	Audit types.Object `tfsdk:"audit"`
+
+	// Id is the ID of the replication.
+	Id types.String `tfsdk:"id"`
+
+	// SourceCluster is the name of the source cluster.
+	SourceCluster types.String `tfsdk:"source_cluster"`
+
+	// TargetCluster is the name of the target cluster.
+	TargetCluster types.String `tfsdk:"target_cluster"`
+
+	// Direction is either oneWay or twoWay.
+	Direction types.String `tfsdk:"direction"`
+
+	// Status is the current status of the replication.
+	Status types.String `tfsdk:"status"`
+}
+
+// NewReplicationSummary creates a replications data source entry from the API response.
+func NewReplicationSummary(summary apigen.ReplicationSummary, auditObject basetypes.ObjectValue) ReplicationSummary {
+	newSummary := ReplicationSummary{
+		Audit:         auditObject,
+		Id:            types.StringValue(summary.Id),
+		SourceCluster: types.StringValue(summary.SourceCluster),
+		TargetCluster: types.StringValue(summary.TargetCluster),
+		Direction:     types.StringNull(),
+		Status:        types.StringValue(string(summary.Status)),
+	}
+	if summary.Direction != nil {
+		newSummary.Direction = types.StringValue(string(*summary.Direction))
+	}
+	return newSummary
+}
+
+// Validate is used to verify that the required IDs for the replications data source have been set.
+func (r Replications) Validate() (organizationId, projectId string, err error) {
+	if r.OrganizationId.IsNull() {
+		return "", "", errors.ErrOrganizationIdMissing
+	}
+	if r.ProjectId.IsNull() {
+		return "", "", errors.ErrProjectIdMissing
+	}
+	return r.OrganizationId.ValueString(), r.ProjectId.ValueString(), nil
+}
@@ -0,0 +1,143 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestReplicationSchemaValidate(t *testing.T) {
+	type test struct {
+		expectedErr            error
+		name                   string
+		expectedOrganizationId string
+		expectedProjectId      string
+		expectedClusterId      string
+		expectedId             string
+		input                  Replication
+	}
+
+	tests := []test{
+		{
+			name: "[POSITIVE] IDs are passed via terraform apply",
+			input: Replication{
+				Id:             basetypes.NewStringValue("100"),
+				ClusterId:      basetypes.NewStringValue("200"),
+				ProjectId:      basetypes.NewStringValue("300"),
+				OrganizationId: basetypes.NewStringValue("400"),
+			},
+			expectedId:             "100",
+			expectedClusterId:      "200",
+			expectedProjectId:      "300",
+			expectedOrganizationId: "400",
+		},
+		{
+			name: "[POSITIVE] IDs are passed via terraform import",
+			input: Replication{
+				Id: basetypes.NewStringValue("id=100,cluster_id=200,project_id=300,organization_id=400"),
+			},
+			expectedId:             "100",
+			expectedClusterId:      "200",
+			expectedProjectId:      "300",
+			expectedOrganizationId: "400",
+		},
+		{
+			name: "[NEGATIVE] only replication ID is passed via terraform import",
+			input: Replication{
+				Id: basetypes.NewStringValue("100"),
+			},
+			expectedErr: errors.ErrInvalidImport,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			IDs, err := test.input.Validate()
+
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedId, IDs[Id])
+			assert.Equal(t, test.expectedClusterId, IDs[ClusterId])
+			assert.Equal(t, test.expectedProjectId, IDs[ProjectId])
+			assert.Equal(t, test.expectedOrganizationId, IDs[OrganizationId])
+		})
+	}
+}
+
+func TestNewReplicationFilter(t *testing.T) {
+	const defaultFilter = `{"documentExcludeOptions":{"binary":false,"deletion":false,"expiration":false,"ttl":false},"expressions":{"regEx":""}}`
+
+	tests := []struct {
+		name     string
+		filter   string
+		prior    *ReplicationFilter
+		expected *ReplicationFilter
+	}{
+		{
+			name:     "default filter without a configured filter is omitted",
+			filter:   defaultFilter,
+			expected: nil,
+		},
+		{
+			name:   "default filter is kept when a filter was configured",
+			filter: defaultFilter,
+			prior: &ReplicationFilter{
+				DocumentExcludeOptions: &ReplicationDocumentExcludeOptions{},
+			},
+			expected: &ReplicationFilter{
+				DocumentExcludeOptions: &ReplicationDocumentExcludeOptions{
+					Binary:     types.BoolValue(false),
+					Deletion:   types.BoolValue(false),
+					Expiration: types.BoolValue(false),
+					Ttl:        types.BoolValue(false),
+				},
+			},
+		},
+		{
+			name:   "regular expression is surfaced on import",
+			filter: `{"documentExcludeOptions":{"binary":false},"expressions":{"regEx":"^a"}}`,
+			expected: &ReplicationFilter{
+				Expressions: &ReplicationFilterExpressions{
+					RegEx:        types.StringValue("^a"),
+					SkipRestream: types.BoolValue(false),
+				},
+			},
+		},
+		{
+			name:   "skip_restream is carried over from the prior state",
+			filter: `{"expressions":{"regEx":"^a"}}`,
+			prior: &ReplicationFilter{
+				Expressions: &ReplicationFilterExpressions{
+					RegEx:        types.StringValue("^a"),
+					SkipRestream: types.BoolValue(true),
+				},
+			},
+			expected: &ReplicationFilter{
+				Expressions: &ReplicationFilterExpressions{
+					RegEx:        types.StringValue("^a"),
+					SkipRestream: types.BoolValue(true),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var filter apigen.GetFilter
+			require.NoError(t, json.Unmarshal([]byte(test.filter), &filter))
+
+			assert.Equal(t, test.expected, newReplicationFilter(&filter, test.prior))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -48,6 +49,7 @@ type SnapshotBackup struct {
 	Type                         types.String   `tfsdk:"type"`
 	RestoreTimes                 types.Number   `tfsdk:"restore_times"`
 	CrossRegionRestorePreference []types.String `tfsdk:"cross_region_restore_preference"`
+	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
 }
 
 type SnapshotBackupData struct {
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+// SnapshotBackupRegions defines the Terraform state for the regions cloud snapshot
+// backups of a cluster can be copied to.
+type SnapshotBackupRegions struct {
+	// OrganizationId is the ID of the organization to which the Capella cluster belongs.
+	OrganizationId types.String `tfsdk:"organization_id"`
+
+	// ProjectId is the ID of the project to which the Capella cluster belongs.
+	ProjectId types.String `tfsdk:"project_id"`
+
+	// ClusterId is the ID of the cluster.
+	ClusterId types.String `tfsdk:"cluster_id"`
+
+	// Regions are the geographic regions available to the cloud provider of the cluster.
+	Regions types.Set `tfsdk:"regions"`
+}
+
+// NewSnapshotBackupRegions creates a new SnapshotBackupRegions data source state from the API response.
+func NewSnapshotBackupRegions(regions apigen.CloudSnapshotGeographicRegions, organizationId, projectId, clusterId string) SnapshotBackupRegions {
+	return SnapshotBackupRegions{
+		OrganizationId: types.StringValue(organizationId),
+		ProjectId:      types.StringValue(projectId),
+		ClusterId:      types.StringValue(clusterId),
+		Regions:        newStringSet(regions),
+	}
+}
@@ -0,0 +1,21 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apigen "github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/generated/api"
+)
+
+func TestNewSnapshotBackupRegions(t *testing.T) {
+	state := NewSnapshotBackupRegions(apigen.CloudSnapshotGeographicRegions{"us-east-1", "eu-west-1"}, "100", "200", "300")
+
+	assert.Equal(t, "300", state.ClusterId.ValueString())
+	assert.True(t, newStringSet([]string{"eu-west-1", "us-east-1"}).Equal(state.Regions))
+
+	state = NewSnapshotBackupRegions(apigen.CloudSnapshotGeographicRegions{}, "100", "200", "300")
+
+	assert.False(t, state.Regions.IsNull())
+	assert.Empty(t, state.Regions.Elements())
+}
@@ -11,23 +11,70 @@ import (
 
 var (
 	importIds = map[string]Attr{
-		"organization_id":   OrganizationId,
-		"project_id":        ProjectId,
-		"cluster_id":        ClusterId,
-		"bucket_id":         BucketId,
-		"id":                Id,
-		"bucket_name":       BucketName,
-		"scope_name":        ScopeName,
-		"collection_name":   CollectionName,
-		"index_name":        IndexName,
-		"app_service_id":    AppServiceId,
-		"app_endpoint_name": AppEndpointName,
-		"endpoint_id":       EndpointId,
-		"provider_id":       ProviderId,
-		"function_name":     FunctionName,
+		"organization_id":      OrganizationId,
+		"project_id":           ProjectId,
+		"cluster_id":           ClusterId,
+		"bucket_id":            BucketId,
+		"id":                   Id,
+		"bucket_name":          BucketName,
+		"scope_name":           ScopeName,
+		"collection_name":      CollectionName,
+		"index_name":           IndexName,
+		"app_service_id":       AppServiceId,
+		"app_endpoint_name":    AppEndpointName,
+		"endpoint_id":          EndpointId,
+		"provider_id":          ProviderId,
+		"function_name":        FunctionName,
+		"cmek_id":              CmekId,
+		"analytics_cluster_id": AnalyticsClusterId,
+		"source_snapshot_id":   SourceSnapshotId,
+	}
+
+	// importNames are the keys that can be given instead of an ID to import a resource
+	// by name. The resources resolve them to IDs before the import string is split.
+	importNames = map[string]bool{
+		"project_name":             true,
+		"cluster_name":             true,
+		"app_service_name":         true,
+		"database_credential_name": true,
+		"user_email":               true,
+		"user_name":                true,
+	}
+
+	// importIdOrder is the order in which BuildImportString writes the IDs, from the
+	// most specific ID to the organization, as in the import examples.
+	importIdOrder = []string{
+		"id",
+		"user_email",
+		"user_name",
+		"database_credential_name",
+		"function_name",
+		"index_name",
+		"collection_name",
+		"scope_name",
+		"bucket_name",
+		"bucket_id",
+		"app_endpoint_name",
+		"endpoint_id",
+		"provider_id",
+		"cmek_id",
+		"source_snapshot_id",
+		"analytics_cluster_id",
+		"app_service_name",
+		"app_service_id",
+		"cluster_name",
+		"cluster_id",
+		"project_name",
+		"project_id",
+		"organization_id",
 	}
 )
 
+const (
+	importIdDelimiter     = ","
+	importEqualsDelimiter = "="
+)
+
 // validateSchemaState validates that the IDs passed in as variadic
 // parameters were successfully imported.
 func validateSchemaState(state map[Attr]basetypes.StringValue, importAttr ...Attr) (map[Attr]string, error) {
@@ -84,12 +131,7 @@ func checkForImportString(organizationId basetypes.StringValue) bool {
 // Note: The import string is passed in the following format:
 // "id=100,cluster_id=200,project_id=300,organization_id=400".
 func splitImportString(importString string, keyParams []Attr) (map[Attr]string, error) {
-	const (
-		idDelimiter     = ","
-		equalsDelimiter = "="
-	)
-
-	pairs := strings.Split(importString, idDelimiter)
+	pairs := strings.Split(importString, importIdDelimiter)
 	if len(pairs) != len(keyParams) {
 		return nil, fmt.Errorf("error parsing terraform import: %w", errors.ErrInvalidImport)
 	}
@@ -101,7 +143,7 @@ func splitImportString(importString string, keyParams []Attr) (map[Attr]string,
 	// since bucketIDs are suffixed with `==`
 	IDs := make(map[Attr]string)
 	for _, pair := range pairs {
-		keyValue := strings.SplitN(pair, equalsDelimiter, 2)
+		keyValue := strings.SplitN(pair, importEqualsDelimiter, 2)
 		if len(keyValue) < 2 {
 			return nil, fmt.Errorf("error parsing terraform import: %w", errors.ErrInvalidImport)
 		}
@@ -115,6 +157,67 @@ func splitImportString(importString string, keyParams []Attr) (map[Attr]string,
 	return IDs, nil
 }
 
+// isImportKey reports whether key is an ID or a name accepted in a terraform import string.
+func isImportKey(key string) bool {
+	_, ok := importIds[key]
+	return ok || importNames[key]
+}
+
+// BuildImportString joins the given IDs, keyed by their attribute names, into a
+// terraform import string such as "id=100,cluster_id=200,project_id=300,organization_id=400".
+func BuildImportString(ids map[string]string) (string, error) {
+	if len(ids) == 0 {
+		return "", fmt.Errorf("error building terraform import: %w: no IDs given", errors.ErrInvalidImport)
+	}
+
+	for key, value := range ids {
+		if !isImportKey(key) {
+			return "", fmt.Errorf("error building terraform import: %w: unknown ID %q", errors.ErrInvalidImport, key)
+		}
+		if value == "" {
+			return "", fmt.Errorf("error building terraform import: %w: %s is empty", errors.ErrInvalidImport, key)
+		}
+		if strings.Contains(value, importIdDelimiter) {
+			return "", fmt.Errorf("error building terraform import: %w: %s contains %q", errors.ErrInvalidImport, key, importIdDelimiter)
+		}
+	}
+
+	pairs := make([]string, 0, len(ids))
+	for _, key := range importIdOrder {
+		if value, ok := ids[key]; ok {
+			pairs = append(pairs, key+importEqualsDelimiter+value)
+		}
+	}
+
+	return strings.Join(pairs, importIdDelimiter), nil
+}
+
+// ParseImportString splits a terraform import string into its IDs, keyed by their
+// attribute names. It is the inverse of BuildImportString.
+func ParseImportString(importString string) (map[string]string, error) {
+	pairs := strings.Split(importString, importIdDelimiter)
+	ids := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		// Split by the first equals sign only, since bucket IDs are suffixed with `==`.
+		key, value, ok := strings.Cut(pair, importEqualsDelimiter)
+		if !ok {
+			return nil, fmt.Errorf("error parsing terraform import: %w: %q is not a key=value pair", errors.ErrInvalidImport, pair)
+		}
+		if !isImportKey(key) {
+			return nil, fmt.Errorf("error parsing terraform import: %w: unknown ID %q", errors.ErrInvalidImport, key)
+		}
+		if _, duplicate := ids[key]; duplicate {
+			return nil, fmt.Errorf("error parsing terraform import: %w: %s is given more than once", errors.ErrInvalidImport, key)
+		}
+		if value == "" {
+			return nil, fmt.Errorf("error parsing terraform import: %w: %s is empty", errors.ErrInvalidImport, key)
+		}
+		ids[key] = value
+	}
+
+	return ids, nil
+}
+
 // checkKeysAndValues is used to validate that an ID map
 // has been populated with the expected ID keys and that the
 // associated values are not empty.
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/couchbasecloud/terraform-provider-couchbase-capella/internal/errors"
@@ -117,3 +119,71 @@ func TestSplitImportStringError(t *testing.T) {
 		})
 	}
 }
+
+func TestImportIdOrderCoversImportIds(t *testing.T) {
+	keys := slices.Collect(maps.Keys(importIds))
+	for name := range importNames {
+		keys = append(keys, name)
+	}
+	assert.ElementsMatch(t, keys, importIdOrder)
+}
+
+func TestBuildImportString(t *testing.T) {
+	tests := []struct {
+		name        string
+		ids         map[string]string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "[POSITIVE] orders IDs from the most specific",
+			ids:      map[string]string{"organization_id": "400", "cluster_id": "200", "id": "100", "project_id": "300"},
+			expected: "id=100,cluster_id=200,project_id=300,organization_id=400",
+		},
+		{
+			name:     "[POSITIVE] keeps bucket ID padding",
+			ids:      map[string]string{"bucket_id": "dGVzdA==", "organization_id": "400"},
+			expected: "bucket_id=dGVzdA==,organization_id=400",
+		},
+		{
+			name:     "[POSITIVE] names next to their IDs",
+			ids:      map[string]string{"organization_id": "400", "cluster_name": "orders", "project_name": "prod"},
+			expected: "cluster_name=orders,project_name=prod,organization_id=400",
+		},
+		{name: "[NEGATIVE] no IDs", ids: map[string]string{}, expectedErr: true},
+		{name: "[NEGATIVE] unknown ID", ids: map[string]string{"user_id": "100"}, expectedErr: true},
+		{name: "[NEGATIVE] empty ID", ids: map[string]string{"id": ""}, expectedErr: true},
+		{name: "[NEGATIVE] ID with delimiter", ids: map[string]string{"id": "1,2"}, expectedErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			importString, err := BuildImportString(test.ids)
+			if test.expectedErr {
+				assert.ErrorIs(t, err, errors.ErrInvalidImport)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, importString)
+
+			ids, err := ParseImportString(importString)
+			assert.NoError(t, err)
+			assert.Equal(t, test.ids, ids)
+		})
+	}
+}
+
+func TestParseImportStringError(t *testing.T) {
+	for _, importString := range []string{
+		"",
+		"id",
+		"id=100,user_id=200",
+		"id=100,id=200",
+		"id=,organization_id=400",
+	} {
+		t.Run(importString, func(t *testing.T) {
+			_, err := ParseImportString(importString)
+			assert.ErrorIs(t, err, errors.ErrInvalidImport)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = (*pemCertificateValidator)(nil)
+
+// PEMCertificate returns a string validator that rejects a value which is not exactly
+// one PEM encoded X.509 certificate. The check is skipped when the value is null or unknown.
+func PEMCertificate() validator.String {
+	return &pemCertificateValidator{single: true}
+}
+
+// PEMCertificateChain returns a string validator that rejects a value which is not one
+// or more concatenated PEM encoded X.509 certificates, such as a chain of intermediates.
+// The check is skipped when the value is null or unknown.
+func PEMCertificateChain() validator.String {
+	return &pemCertificateValidator{}
+}
+
+type pemCertificateValidator struct {
+	single bool
+}
+
+func (v *pemCertificateValidator) Description(_ context.Context) string {
+	return v.MarkdownDescription(context.Background())
+}
+
+func (v *pemCertificateValidator) MarkdownDescription(_ context.Context) string {
+	if v.single {
+		return "value must be a single PEM encoded X.509 certificate"
+	}
+	return "value must be one or more PEM encoded X.509 certificates"
+}
+
+func (v *pemCertificateValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.parse([]byte(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PEM Certificate",
+			fmt.Sprintf("%s: %s.", v.MarkdownDescription(context.Background()), err),
+		)
+	}
+}
+
+// parse decodes every PEM block in data and checks that each is a parsable certificate.
+func (v *pemCertificateValidator) parse(data []byte) error {
+	count := 0
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		count++
+
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("PEM block %d has type %q, expected \"CERTIFICATE\"", count, block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("PEM block %d could not be parsed: %w", count, err)
+		}
+	}
+
+	switch {
+	case count == 0:
+		return fmt.Errorf("no PEM block found")
+	case len(bytes.TrimSpace(data)) > 0:
+		return fmt.Errorf("unexpected content after PEM block %d", count)
+	case v.single && count > 1:
+		return fmt.Errorf("found %d certificates", count)
+	}
+	return nil
+}
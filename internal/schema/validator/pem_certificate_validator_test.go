@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testCertificatePEM returns a freshly generated self-signed certificate in PEM form.
+func testCertificatePEM(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestPEMCertificate(t *testing.T) {
+	cert := testCertificatePEM(t)
+
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:      "single certificate",
+			validator: PEMCertificate(),
+			value:     types.StringValue(cert),
+		},
+		{
+			name:      "two certificates are rejected for a single certificate",
+			validator: PEMCertificate(),
+			value:     types.StringValue(cert + cert),
+			wantError: true,
+		},
+		{
+			name:      "two certificates are accepted for a chain",
+			validator: PEMCertificateChain(),
+			value:     types.StringValue(cert + cert),
+		},
+		{
+			name:      "not PEM",
+			validator: PEMCertificate(),
+			value:     types.StringValue("not a certificate"),
+			wantError: true,
+		},
+		{
+			name:      "private key block",
+			validator: PEMCertificateChain(),
+			value:     types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}))),
+			wantError: true,
+		},
+		{
+			name:      "certificate block with invalid content",
+			validator: PEMCertificate(),
+			value:     types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")}))),
+			wantError: true,
+		},
+		{
+			name:      "trailing content",
+			validator: PEMCertificate(),
+			value:     types.StringValue(cert + "trailing"),
+			wantError: true,
+		},
+		{
+			name:      "null value is skipped",
+			validator: PEMCertificate(),
+			value:     types.StringNull(),
+		},
+		{
+			name:      "unknown value is skipped",
+			validator: PEMCertificate(),
+			value:     types.StringUnknown(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			tc.validator.ValidateString(
+				context.Background(),
+				validator.StringRequest{ConfigValue: tc.value},
+				resp,
+			)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantError {
+				t.Errorf("HasError() = %v, want %v (diags: %v)", got, tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}